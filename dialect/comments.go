@@ -0,0 +1,93 @@
+package dialect
+
+import (
+	"strconv"
+	"strings"
+)
+
+// HintText returns the text of an optimizer hint token, that is the
+// hint with the comment chars and the leading plus sign removed
+func HintText(t Token) string {
+
+	s := t.Value
+	switch {
+	case strings.HasPrefix(s, "/*+"):
+		s = strings.TrimSuffix(s[3:], "*/")
+	case strings.HasPrefix(s, "--+"):
+		s = s[3:]
+	}
+
+	return strings.TrimSpace(s)
+}
+
+// ExecutableComment returns the text of an executable comment token
+// along with the minimum server version required for the text to be
+// executed. The version is encoded as in the comment (major * 10000 +
+// minor * 100 + patch, so "/*!50700 ... */" is version 50700) and is
+// 0 when the comment does not specify a version
+func ExecutableComment(t Token) (string, int) {
+
+	s := t.Value
+	switch {
+	case strings.HasPrefix(s, "/*!"):
+		s = s[3:]
+	case strings.HasPrefix(s, "/*M!"):
+		s = s[4:]
+	default:
+		return "", 0
+	}
+	s = strings.TrimSuffix(s, "*/")
+
+	n := 0
+	for n < len(s) && n < 6 && isDigit(s[n]) {
+		n++
+	}
+	version, _ := strconv.Atoi(s[:n])
+
+	return strings.TrimSpace(s[n:]), version
+}
+
+// isComment returns a boolean indicating if the supplied string is a
+// complete line comment or block comment per the comment chars of the
+// supplied dialect
+func isComment(d DbDialect, s string) bool {
+
+	for _, p := range d.LineCommentChars() {
+		if strings.HasPrefix(s, p) {
+			return !strings.ContainsAny(s, "\r\n")
+		}
+	}
+
+	open, close := d.BlockCommentChars()
+	if open == "" || !strings.HasPrefix(s, open) {
+		return false
+	}
+
+	return blockCommentLength(s, open, close, d.NestedComments()) == len(s) &&
+		len(s) >= len(open)+len(close)
+}
+
+// blockCommentLength returns the length of the block comment at the
+// start of the supplied string, or -1 if the comment (or a comment
+// nested in it) is not terminated
+func blockCommentLength(s, open, close string, nested bool) int {
+
+	depth := 0
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], open) && (nested || depth == 0):
+			depth++
+			i += len(open)
+		case strings.HasPrefix(s[i:], close):
+			depth--
+			i += len(close)
+			if depth == 0 {
+				return i
+			}
+		default:
+			i++
+		}
+	}
+
+	return -1
+}
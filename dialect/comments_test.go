@@ -0,0 +1,154 @@
+package dialect
+
+import "testing"
+
+func TestIsComment(t *testing.T) {
+
+	tests := []struct {
+		d        DbDialect
+		comments []string
+		others   []string
+	}{
+		{NewStandardSQLDialect(),
+			[]string{"-- x", "--", "/* x */", "/* a /* b */ c */"},
+			[]string{"# x", "/* x", "/* a /* b */", "-- x\ny"}},
+		{NewPostgreSQLDialect(),
+			[]string{"-- x", "/* a /* b */ c */"},
+			[]string{"# x", "/* a /* b */"}},
+		{NewSQLiteDialect(),
+			[]string{"-- x", "/* a /* b */"},
+			[]string{"# x", "/* a /* b */ c */"}},
+		{NewMySQLDialect(),
+			[]string{"-- x", "--\tx", "--", "# x", "#x", "/* x */"},
+			[]string{"--x", "---", "/* a /* b */ c */"}},
+		{NewMariaDBDialect(),
+			[]string{"-- x", "# x", "/* x */"},
+			[]string{"--x"}},
+		{NewOracleDialect(),
+			[]string{"-- x", "--x", "/* x */"},
+			[]string{"# x", "/* a /* b */ c */"}},
+		{NewMSSQLDialect(),
+			[]string{"-- x", "/* a /* b */ c */"},
+			[]string{"# x", "/* a /* b */"}},
+		{NewMSAccessDialect(),
+			nil,
+			[]string{"-- x", "# x", "/* x */", "' x"}},
+	}
+
+	for _, tt := range tests {
+		for _, s := range tt.comments {
+			if !tt.d.IsComment(s) {
+				t.Errorf("%s: IsComment(%q) is false", tt.d.DialectName(), s)
+			}
+		}
+		for _, s := range tt.others {
+			if tt.d.IsComment(s) {
+				t.Errorf("%s: IsComment(%q) is true", tt.d.DialectName(), s)
+			}
+		}
+	}
+}
+
+func TestCommentTokens(t *testing.T) {
+
+	tests := []struct {
+		d        DbDialect
+		sql      string
+		comments []string
+	}{
+		{NewMySQLDialect(), "select 1--x\n", nil},
+		{NewMySQLDialect(), "select 1-- x\n", []string{"-- x"}},
+		{NewMySQLDialect(), "select 1 # x\r\n2", []string{"# x"}},
+		{NewMariaDBDialect(), "select 5--1", nil},
+		{NewPostgreSQLDialect(), "select 5--1", []string{"--1"}},
+		{NewPostgreSQLDialect(), "/* a /* b */ c */ d", []string{"/* a /* b */ c */"}},
+		{NewOracleDialect(), "/* a /* b */ c */ d", []string{"/* a /* b */"}},
+		{NewSQLiteDialect(), "select 1 /* x", []string{"/* x"}},
+		{NewMSAccessDialect(), "select 1 -- x /* y */", nil},
+	}
+
+	for _, tt := range tests {
+		var comments []string
+		for _, tk := range Tokenize(tt.d, tt.sql) {
+			if tk.Type == CommentToken {
+				comments = append(comments, tk.Value)
+			}
+		}
+		if len(comments) != len(tt.comments) {
+			t.Errorf("%s: comments in %q are %q, expected %q", tt.d.DialectName(), tt.sql, comments, tt.comments)
+			continue
+		}
+		for i := range comments {
+			if comments[i] != tt.comments[i] {
+				t.Errorf("%s: comments in %q are %q, expected %q", tt.d.DialectName(), tt.sql, comments, tt.comments)
+				break
+			}
+		}
+	}
+}
+
+func TestHintText(t *testing.T) {
+
+	tests := []struct {
+		d    DbDialect
+		sql  string
+		want string
+	}{
+		{NewOracleDialect(), "select /*+ index(t ix) */ *", "index(t ix)"},
+		{NewOracleDialect(), "select /*+full(t)*/ *", "full(t)"},
+		{NewOracleDialect(), "select --+ first_rows\n*", "first_rows"},
+		{NewMySQLDialect(), "select /*+ max_execution_time(10) */ *", "max_execution_time(10)"},
+		{NewMySQLDialect(), "select --+ x\n*", ""},
+		{NewPostgreSQLDialect(), "select /*+ seqscan(t) */ *", ""},
+		{NewMariaDBDialect(), "select /*+ x */ *", ""},
+		{NewMSSQLDialect(), "select /*+ x */ *", ""},
+	}
+
+	for _, tt := range tests {
+		got := ""
+		for _, tk := range Tokenize(tt.d, tt.sql) {
+			if tk.Type == HintToken {
+				got = HintText(tk)
+			}
+		}
+		if got != tt.want {
+			t.Errorf("%s: hint in %q is %q, expected %q", tt.d.DialectName(), tt.sql, got, tt.want)
+		}
+	}
+}
+
+func TestExecutableComment(t *testing.T) {
+
+	tests := []struct {
+		d       DbDialect
+		sql     string
+		want    string
+		version int
+	}{
+		{NewMySQLDialect(), "/*!50700 SET x = 1 */", "SET x = 1", 50700},
+		{NewMySQLDialect(), "/*!SET x = 1*/", "SET x = 1", 0},
+		{NewMySQLDialect(), "/*!80000*/", "", 80000},
+		{NewMySQLDialect(), "/*!40101 SET @a=@@b */;", "SET @a=@@b", 40101},
+		{NewMySQLDialect(), "/*M!100301 x */", "", 0},
+		{NewMariaDBDialect(), "/*M!100301 x */", "x", 100301},
+		{NewMariaDBDialect(), "/*!50700 x */", "x", 50700},
+		{NewMariaDBDialect(), "/*!1234567 x */", "7 x", 123456},
+		{NewPostgreSQLDialect(), "/*!50700 x */", "", 0},
+	}
+
+	for _, tt := range tests {
+		got, version := "", 0
+		for _, tk := range Tokenize(tt.d, tt.sql) {
+			if tk.Type == ExecutableCommentToken {
+				got, version = ExecutableComment(tk)
+			}
+		}
+		if got != tt.want || version != tt.version {
+			t.Errorf("%s: executable comment in %q is %q %d, expected %q %d", tt.d.DialectName(), tt.sql, got, version, tt.want, tt.version)
+		}
+	}
+
+	if s, v := ExecutableComment(Token{Type: CommentToken, Value: "/* x */"}); s != "" || v != 0 {
+		t.Errorf("ExecutableComment(/* x */) = %q %d", s, v)
+	}
+}
@@ -16,4 +16,50 @@ const (
 	FoldLower
 	FoldUpper
 	NoFolding
+	////////////////////////////////////////////////////////////////////
+	// Token types
+	WhitespaceToken
+	CommentToken
+	KeywordToken
+	ReservedKeywordToken
+	IdentifierToken
+	QuotedIdentifierToken
+	StringToken
+	NumericToken
+	OperatorToken
+	LabelToken
+	PunctuationToken
+	OtherToken
+	HintToken
+	ExecutableCommentToken
+	PlaceholderToken
+	////////////////////////////////////////////////////////////////////
+	// Placeholder styles
+	QuestionPlaceholder       // ?
+	QuestionNumberPlaceholder // ?1
+	DollarNumberPlaceholder   // $1
+	DollarNamePlaceholder     // $name
+	ColonNumberPlaceholder    // :1
+	ColonNamePlaceholder      // :name
+	AtNamePlaceholder         // @name
+	////////////////////////////////////////////////////////////////////
+	// Datatype categories
+	ExactNumericCategory
+	ApproximateNumericCategory
+	CharacterCategory
+	BinaryCategory
+	TemporalCategory
+	BooleanCategory
+	SpatialCategory
+	JSONCategory
+	XMLCategory
+	LOBCategory
+	OtherCategory
+	////////////////////////////////////////////////////////////////////
+	// SQLite type affinities
+	IntegerAffinity
+	TextAffinity
+	BlobAffinity
+	RealAffinity
+	NumericAffinity
 )
@@ -0,0 +1,78 @@
+package dialect
+
+import (
+	"sort"
+	"strings"
+)
+
+// DatatypeInfo is the metadata for a datatype
+type DatatypeInfo struct {
+	Name       string // the lower case name of the datatype, as for Datatype.Name
+	Category   int    // the category of the datatype (e.g. ExactNumericCategory)
+	Size       int    // the storage size in bytes, 0 if the size varies
+	MinValue   string // the smallest value of numeric and temporal types (if known)
+	MaxValue   string // the largest value of numeric and temporal types (if known)
+	Deprecated bool   // the datatype is deprecated
+}
+
+// Metadata shared by the datatypes of a number of dialects
+var (
+	tinyintInfo  = DatatypeInfo{Category: ExactNumericCategory, Size: 1, MinValue: "-128", MaxValue: "127"}
+	smallintInfo = DatatypeInfo{Category: ExactNumericCategory, Size: 2, MinValue: "-32768", MaxValue: "32767"}
+	integerInfo  = DatatypeInfo{Category: ExactNumericCategory, Size: 4, MinValue: "-2147483648", MaxValue: "2147483647"}
+	bigintInfo   = DatatypeInfo{Category: ExactNumericCategory, Size: 8, MinValue: "-9223372036854775808", MaxValue: "9223372036854775807"}
+	decimalInfo  = DatatypeInfo{Category: ExactNumericCategory}
+	realInfo     = DatatypeInfo{Category: ApproximateNumericCategory, Size: 4, MinValue: "-3.40E+38", MaxValue: "3.40E+38"}
+	doubleInfo   = DatatypeInfo{Category: ApproximateNumericCategory, Size: 8, MinValue: "-1.79E+308", MaxValue: "1.79E+308"}
+	floatInfo    = DatatypeInfo{Category: ApproximateNumericCategory}
+	booleanInfo  = DatatypeInfo{Category: BooleanCategory, Size: 1}
+	charInfo     = DatatypeInfo{Category: CharacterCategory}
+	binaryInfo   = DatatypeInfo{Category: BinaryCategory}
+	temporalInfo = DatatypeInfo{Category: TemporalCategory}
+	spatialInfo  = DatatypeInfo{Category: SpatialCategory}
+	jsonInfo     = DatatypeInfo{Category: JSONCategory}
+	xmlInfo      = DatatypeInfo{Category: XMLCategory}
+	lobInfo      = DatatypeInfo{Category: LOBCategory}
+	otherInfo    = DatatypeInfo{Category: OtherCategory}
+)
+
+// deprecated returns a copy of the supplied metadata that is flagged
+// as deprecated
+func deprecated(i DatatypeInfo) DatatypeInfo {
+	i.Deprecated = true
+	return i
+}
+
+// datatypeInfo returns the metadata for the named datatype. The name
+// may also be a full datatype specification (e.g. "varchar2 (30)")
+func datatypeInfo(d DbDialect, infos map[string]DatatypeInfo, name string) (DatatypeInfo, bool) {
+
+	k := strings.ToLower(strings.TrimSpace(name))
+	if _, ok := infos[k]; !ok {
+		if t, err := d.ParseDatatype(name); err == nil {
+			k = t.Name
+		}
+	}
+
+	i, ok := infos[k]
+	if !ok {
+		return DatatypeInfo{}, false
+	}
+	i.Name = k
+
+	return i, true
+}
+
+// datatypeInfos returns the metadata for all of the supplied datatypes
+// sorted by name
+func datatypeInfos(infos map[string]DatatypeInfo) []DatatypeInfo {
+
+	l := make([]DatatypeInfo, 0, len(infos))
+	for k, i := range infos {
+		i.Name = k
+		l = append(l, i)
+	}
+	sort.Slice(l, func(a, b int) bool { return l[a].Name < l[b].Name })
+
+	return l
+}
@@ -0,0 +1,44 @@
+package dialect
+
+import "testing"
+
+func TestDeprecatedDatatypes(t *testing.T) {
+
+	ora, ms := NewOracleDialect(), NewMSSQLDialect()
+
+	tests := []struct {
+		d          DbDialect
+		name       string
+		deprecated bool
+	}{
+		{ora, "long", true},
+		{ora, "long raw", true},
+		{ora, "identity", true},
+		{ora, "varchar2", false},
+		{ora, "varchar2 (30 char)", false},
+		{ora, "number", false},
+		{ms, "text", true},
+		{ms, "ntext", true},
+		{ms, "image", true},
+		{ms, "timestamp", true},
+		{ms, "varchar(max)", false},
+		{ms, "bit", false},
+		{NewMSAccessDialect(), "memo", true},
+		{NewPostgreSQLDialect(), "txid_snapshot", true},
+		{NewPostgreSQLDialect(), "pg_snapshot", false},
+		{NewStandardSQLDialect(), "bit", true},
+		{NewStandardSQLDialect(), "bit varying", true},
+		{NewStandardSQLDialect(), "binary", false},
+	}
+
+	for _, tt := range tests {
+		info, ok := tt.d.DatatypeInfo(tt.name)
+		if !ok {
+			t.Errorf("%s: DatatypeInfo(%q) not found", tt.d.DialectName(), tt.name)
+			continue
+		}
+		if info.Deprecated != tt.deprecated {
+			t.Errorf("%s: DatatypeInfo(%q).Deprecated = %t, expected %t", tt.d.DialectName(), tt.name, info.Deprecated, tt.deprecated)
+		}
+	}
+}
@@ -0,0 +1,839 @@
+package dialect
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrLossyDatatype is wrapped by the error returned from MapDatatype
+// when the mapped datatype cannot hold all of the values (or keep all
+// of the properties) of the original datatype
+var ErrLossyDatatype = errors.New("lossy datatype conversion")
+
+// The generic kinds of datatype that are used for mapping datatypes
+// between dialects
+const (
+	unknownKind = iota
+	booleanKind
+	tinyintKind
+	utinyintKind // unsigned tinyint, 0 to 255
+	smallintKind
+	integerKind
+	bigintKind
+	decimalKind
+	realKind
+	doubleKind
+	charKind
+	varcharKind
+	ncharKind
+	nvarcharKind
+	textKind
+	ntextKind
+	binaryKind
+	varbinaryKind
+	blobKind
+	bitKind
+	dateKind
+	timeKind
+	timeTZKind
+	timestampKind
+	timestampTZKind
+	intervalKind
+	intervalYMKind
+	intervalDSKind
+	uuidKind
+	jsonKind
+	xmlKind
+	geometryKind
+	geographyKind
+	enumKind
+	setKind
+)
+
+// genericKinds maps datatype names to their generic kind. Names that
+// mean different things in different dialects are sorted out by
+// genericType
+var genericKinds = map[string]int{
+	"bool":                           booleanKind,
+	"boolean":                        booleanKind,
+	"yes/no":                         booleanKind,
+	"tinyint":                        tinyintKind,
+	"smallint":                       smallintKind,
+	"int2":                           smallintKind,
+	"smallserial":                    smallintKind,
+	"year":                           smallintKind,
+	"mediumint":                      integerKind,
+	"int":                            integerKind,
+	"integer":                        integerKind,
+	"int4":                           integerKind,
+	"serial":                         integerKind,
+	"pls_integer":                    integerKind,
+	"binary_integer":                 integerKind,
+	"autonumber":                     integerKind,
+	"bigint":                         bigintKind,
+	"int8":                           bigintKind,
+	"bigserial":                      bigintKind,
+	"large number":                   bigintKind,
+	"unsigned big int":               bigintKind,
+	"decimal":                        decimalKind,
+	"dec":                            decimalKind,
+	"numeric":                        decimalKind,
+	"number":                         decimalKind,
+	"fixed":                          decimalKind,
+	"real":                           realKind,
+	"float4":                         realKind,
+	"single":                         realKind,
+	"binary_float":                   realKind,
+	"double":                         doubleKind,
+	"double precision":               doubleKind,
+	"float8":                         doubleKind,
+	"float":                          doubleKind,
+	"binary_double":                  doubleKind,
+	"char":                           charKind,
+	"character":                      charKind,
+	"native character":               charKind,
+	"varchar":                        varcharKind,
+	"varchar2":                       varcharKind,
+	"character varying":              varcharKind,
+	"varying character":              varcharKind,
+	"short text":                     varcharKind,
+	"nchar":                          ncharKind,
+	"national character":             ncharKind,
+	"national char":                  ncharKind,
+	"nvarchar":                       nvarcharKind,
+	"nvarchar2":                      nvarcharKind,
+	"national character varying":     nvarcharKind,
+	"nchar varying":                  nvarcharKind,
+	"national varchar":               nvarcharKind,
+	"text":                           textKind,
+	"clob":                           textKind,
+	"character large object":         textKind,
+	"tinytext":                       textKind,
+	"mediumtext":                     textKind,
+	"longtext":                       textKind,
+	"long text":                      textKind,
+	"memo":                           textKind,
+	"rich text":                      textKind,
+	"hyperlink":                      textKind,
+	"nclob":                          ntextKind,
+	"ntext":                          ntextKind,
+	"binary":                         binaryKind,
+	"char byte":                      binaryKind,
+	"varbinary":                      varbinaryKind,
+	"raw":                            varbinaryKind,
+	"binary varying":                 varbinaryKind,
+	"blob":                           blobKind,
+	"binary large object":            blobKind,
+	"tinyblob":                       blobKind,
+	"mediumblob":                     blobKind,
+	"longblob":                       blobKind,
+	"bytea":                          blobKind,
+	"image":                          blobKind,
+	"ole object":                     blobKind,
+	"long raw":                       blobKind,
+	"bit":                            bitKind,
+	"bit varying":                    bitKind,
+	"date":                           dateKind,
+	"time":                           timeKind,
+	"time without time zone":         timeKind,
+	"timetz":                         timeTZKind,
+	"time with time zone":            timeTZKind,
+	"timestamp":                      timestampKind,
+	"timestamp without time zone":    timestampKind,
+	"datetime":                       timestampKind,
+	"datetime2":                      timestampKind,
+	"smalldatetime":                  timestampKind,
+	"date/time":                      timestampKind,
+	"date/time extended":             timestampKind,
+	"timestamptz":                    timestampTZKind,
+	"timestamp with time zone":       timestampTZKind,
+	"timestamp with local time zone": timestampTZKind,
+	"datetimeoffset":                 timestampTZKind,
+	"interval":                       intervalKind,
+	"interval year":                  intervalYMKind,
+	"interval month":                 intervalYMKind,
+	"interval year to month":         intervalYMKind,
+	"interval day":                   intervalDSKind,
+	"interval day to hour":           intervalDSKind,
+	"interval day to minute":         intervalDSKind,
+	"interval day to second":         intervalDSKind,
+	"interval hour":                  intervalDSKind,
+	"interval hour to minute":        intervalDSKind,
+	"interval hour to second":        intervalDSKind,
+	"interval minute":                intervalDSKind,
+	"interval minute to second":      intervalDSKind,
+	"interval second":                intervalDSKind,
+	"uuid":                           uuidKind,
+	"uniqueidentifier":               uuidKind,
+	"json":                           jsonKind,
+	"jsonb":                          jsonKind,
+	"xml":                            xmlKind,
+	"geometry":                       geometryKind,
+	"geometrycollection":             geometryKind,
+	"linestring":                     geometryKind,
+	"multilinestring":                geometryKind,
+	"multipoint":                     geometryKind,
+	"multipolygon":                   geometryKind,
+	"geography":                      geographyKind,
+	"enum":                           enumKind,
+	"set":                            setKind,
+}
+
+// unboundedKinds maps the kinds that have a length to the kind to use
+// when the length is too large for the target dialect
+var unboundedKinds = map[int]int{
+	charKind:      textKind,
+	varcharKind:   textKind,
+	ncharKind:     ntextKind,
+	nvarcharKind:  ntextKind,
+	binaryKind:    blobKind,
+	varbinaryKind: blobKind,
+	enumKind:      textKind,
+	setKind:       textKind,
+}
+
+// affinityKinds maps the SQLite type affinities to the generic kind
+// used for type names that are not otherwise known
+var affinityKinds = map[int]int{
+	IntegerAffinity: bigintKind,
+	TextAffinity:    textKind,
+	BlobAffinity:    blobKind,
+	RealAffinity:    doubleKind,
+	NumericAffinity: decimalKind,
+}
+
+// kindTarget is the datatype that a generic kind maps to in a dialect
+type kindTarget struct {
+	spec      string // the datatype specification, parameters are only added when the spec has none
+	maxLength int    // the maximum length, when not limited by the parse rules of the dialect
+	lossy     string // the reason that the mapping is lossy (if it is)
+}
+
+// Reasons for lossy mappings
+const (
+	lossyTimeZone  = "the time zone is not stored"
+	lossyValues    = "the list of allowed values is not enforced"
+	lossyExact     = "exact numeric values are stored as floating point"
+	lossyYearMonth = "year to month intervals are not supported"
+	lossyFloat     = "the binary precision is reduced to 53 bits"
+)
+
+// kindTargets maps the generic kinds to the datatypes of each dialect.
+// Kinds that are missing for a dialect have no equivalent
+var kindTargets = map[int]map[int]kindTarget{
+	StandardSQL: {
+		booleanKind:     {spec: "boolean"},
+		tinyintKind:     {spec: "smallint"},
+		utinyintKind:    {spec: "smallint"},
+		smallintKind:    {spec: "smallint"},
+		integerKind:     {spec: "integer"},
+		bigintKind:      {spec: "bigint"},
+		decimalKind:     {spec: "numeric"},
+		realKind:        {spec: "real"},
+		doubleKind:      {spec: "double precision"},
+		charKind:        {spec: "character"},
+		varcharKind:     {spec: "character varying"},
+		ncharKind:       {spec: "character"},
+		nvarcharKind:    {spec: "character varying"},
+		textKind:        {spec: "character large object"},
+		ntextKind:       {spec: "nclob"},
+		binaryKind:      {spec: "binary large object"},
+		varbinaryKind:   {spec: "binary large object"},
+		blobKind:        {spec: "binary large object"},
+		bitKind:         {spec: "bit varying"},
+		dateKind:        {spec: "date"},
+		timeKind:        {spec: "time"},
+		timeTZKind:      {spec: "time with time zone"},
+		timestampKind:   {spec: "timestamp"},
+		timestampTZKind: {spec: "timestamp with time zone"},
+		intervalKind:    {spec: "interval day to second", lossy: lossyYearMonth},
+		intervalYMKind:  {spec: "interval year to month"},
+		intervalDSKind:  {spec: "interval day to second"},
+		uuidKind:        {spec: "character (36)"},
+		jsonKind:        {spec: "character large object"},
+		xmlKind:         {spec: "xml"},
+		enumKind:        {spec: "character varying", lossy: lossyValues},
+		setKind:         {spec: "character varying", lossy: lossyValues},
+	},
+	PostgreSQL: {
+		booleanKind:     {spec: "boolean"},
+		tinyintKind:     {spec: "smallint"},
+		utinyintKind:    {spec: "smallint"},
+		smallintKind:    {spec: "smallint"},
+		integerKind:     {spec: "integer"},
+		bigintKind:      {spec: "bigint"},
+		decimalKind:     {spec: "numeric"},
+		realKind:        {spec: "real"},
+		doubleKind:      {spec: "double precision"},
+		charKind:        {spec: "char"},
+		varcharKind:     {spec: "varchar"},
+		ncharKind:       {spec: "char"},
+		nvarcharKind:    {spec: "varchar"},
+		textKind:        {spec: "text"},
+		ntextKind:       {spec: "text"},
+		binaryKind:      {spec: "bytea"},
+		varbinaryKind:   {spec: "bytea"},
+		blobKind:        {spec: "bytea"},
+		bitKind:         {spec: "bit"},
+		dateKind:        {spec: "date"},
+		timeKind:        {spec: "time"},
+		timeTZKind:      {spec: "time with time zone"},
+		timestampKind:   {spec: "timestamp"},
+		timestampTZKind: {spec: "timestamp with time zone"},
+		intervalKind:    {spec: "interval"},
+		intervalYMKind:  {spec: "interval year to month"},
+		intervalDSKind:  {spec: "interval day to second"},
+		uuidKind:        {spec: "uuid"},
+		jsonKind:        {spec: "jsonb"},
+		xmlKind:         {spec: "xml"},
+		geometryKind:    {spec: "geometry"},
+		geographyKind:   {spec: "geography"},
+		enumKind:        {spec: "text", lossy: lossyValues},
+		setKind:         {spec: "text", lossy: lossyValues},
+	},
+	Oracle: {
+		// number (1) rather than boolean as boolean needs Oracle 23ai
+		booleanKind:     {spec: "number (1)"},
+		tinyintKind:     {spec: "number (3)"},
+		utinyintKind:    {spec: "number (3)"},
+		smallintKind:    {spec: "number (5)"},
+		integerKind:     {spec: "number (10)"},
+		bigintKind:      {spec: "number (19)"},
+		decimalKind:     {spec: "number"},
+		realKind:        {spec: "binary_float"},
+		doubleKind:      {spec: "binary_double"},
+		charKind:        {spec: "char"},
+		varcharKind:     {spec: "varchar2"},
+		ncharKind:       {spec: "nchar"},
+		nvarcharKind:    {spec: "nvarchar2"},
+		textKind:        {spec: "clob"},
+		ntextKind:       {spec: "nclob"},
+		binaryKind:      {spec: "raw"},
+		varbinaryKind:   {spec: "raw"},
+		blobKind:        {spec: "blob"},
+		dateKind:        {spec: "date"},
+		timestampKind:   {spec: "timestamp"},
+		timestampTZKind: {spec: "timestamp with time zone"},
+		intervalKind:    {spec: "interval day to second", lossy: lossyYearMonth},
+		intervalYMKind:  {spec: "interval year to month"},
+		intervalDSKind:  {spec: "interval day to second"},
+		uuidKind:        {spec: "raw (16)"},
+		jsonKind:        {spec: "clob"},
+		xmlKind:         {spec: "clob"},
+		enumKind:        {spec: "varchar2", lossy: lossyValues},
+		setKind:         {spec: "varchar2", lossy: lossyValues},
+	},
+	SQLite: {
+		booleanKind:     {spec: "boolean"},
+		tinyintKind:     {spec: "integer"},
+		utinyintKind:    {spec: "integer"},
+		smallintKind:    {spec: "integer"},
+		integerKind:     {spec: "integer"},
+		bigintKind:      {spec: "integer"},
+		decimalKind:     {spec: "numeric"},
+		realKind:        {spec: "real"},
+		doubleKind:      {spec: "real"},
+		charKind:        {spec: "character"},
+		varcharKind:     {spec: "varchar"},
+		ncharKind:       {spec: "nchar"},
+		nvarcharKind:    {spec: "nvarchar"},
+		textKind:        {spec: "text"},
+		ntextKind:       {spec: "text"},
+		binaryKind:      {spec: "blob"},
+		varbinaryKind:   {spec: "blob"},
+		blobKind:        {spec: "blob"},
+		dateKind:        {spec: "date"},
+		timeKind:        {spec: "text"},
+		timeTZKind:      {spec: "text"},
+		timestampKind:   {spec: "datetime"},
+		timestampTZKind: {spec: "datetime"},
+		uuidKind:        {spec: "blob"},
+		jsonKind:        {spec: "text"},
+		xmlKind:         {spec: "text"},
+		enumKind:        {spec: "text", lossy: lossyValues},
+		setKind:         {spec: "text", lossy: lossyValues},
+	},
+	MySQL:   mysqlKindTargets,
+	MariaDB: mariadbKindTargets,
+	MSSQL: {
+		booleanKind:     {spec: "bit"},
+		tinyintKind:     {spec: "smallint"},
+		utinyintKind:    {spec: "tinyint"},
+		smallintKind:    {spec: "smallint"},
+		integerKind:     {spec: "int"},
+		bigintKind:      {spec: "bigint"},
+		decimalKind:     {spec: "decimal"},
+		realKind:        {spec: "real"},
+		doubleKind:      {spec: "float"},
+		charKind:        {spec: "char"},
+		varcharKind:     {spec: "varchar"},
+		ncharKind:       {spec: "nchar"},
+		nvarcharKind:    {spec: "nvarchar"},
+		textKind:        {spec: "varchar (max)"},
+		ntextKind:       {spec: "nvarchar (max)"},
+		binaryKind:      {spec: "binary"},
+		varbinaryKind:   {spec: "varbinary"},
+		blobKind:        {spec: "varbinary (max)"},
+		dateKind:        {spec: "date"},
+		timeKind:        {spec: "time"},
+		timeTZKind:      {spec: "time", lossy: lossyTimeZone},
+		timestampKind:   {spec: "datetime2"},
+		timestampTZKind: {spec: "datetimeoffset"},
+		uuidKind:        {spec: "uniqueidentifier"},
+		jsonKind:        {spec: "nvarchar (max)"},
+		xmlKind:         {spec: "xml"},
+		geometryKind:    {spec: "geometry"},
+		geographyKind:   {spec: "geography"},
+		enumKind:        {spec: "varchar", lossy: lossyValues},
+		setKind:         {spec: "varchar", lossy: lossyValues},
+	},
+	MSAccess: {
+		booleanKind:     {spec: "yes/no"},
+		tinyintKind:     {spec: "integer"},
+		utinyintKind:    {spec: "byte"},
+		smallintKind:    {spec: "integer"},
+		integerKind:     {spec: "long"},
+		bigintKind:      {spec: "large number"},
+		decimalKind:     {spec: "double", lossy: lossyExact},
+		realKind:        {spec: "single"},
+		doubleKind:      {spec: "double"},
+		charKind:        {spec: "short text", maxLength: 255},
+		varcharKind:     {spec: "short text", maxLength: 255},
+		ncharKind:       {spec: "short text", maxLength: 255},
+		nvarcharKind:    {spec: "short text", maxLength: 255},
+		textKind:        {spec: "long text"},
+		ntextKind:       {spec: "long text"},
+		binaryKind:      {spec: "ole object"},
+		varbinaryKind:   {spec: "ole object"},
+		blobKind:        {spec: "ole object"},
+		dateKind:        {spec: "date/time"},
+		timeKind:        {spec: "date/time"},
+		timeTZKind:      {spec: "date/time", lossy: lossyTimeZone},
+		timestampKind:   {spec: "date/time"},
+		timestampTZKind: {spec: "date/time", lossy: lossyTimeZone},
+		uuidKind:        {spec: "short text"},
+		jsonKind:        {spec: "long text"},
+		xmlKind:         {spec: "long text"},
+		enumKind:        {spec: "short text", maxLength: 255, lossy: lossyValues},
+		setKind:         {spec: "short text", maxLength: 255, lossy: lossyValues},
+	},
+}
+
+// mysqlKindTargets maps the generic kinds to the MySQL datatypes
+var mysqlKindTargets = map[int]kindTarget{
+	booleanKind:     {spec: "tinyint (1)"},
+	tinyintKind:     {spec: "tinyint"},
+	utinyintKind:    {spec: "tinyint unsigned"},
+	smallintKind:    {spec: "smallint"},
+	integerKind:     {spec: "int"},
+	bigintKind:      {spec: "bigint"},
+	decimalKind:     {spec: "decimal"},
+	realKind:        {spec: "float"},
+	doubleKind:      {spec: "double"},
+	charKind:        {spec: "char"},
+	varcharKind:     {spec: "varchar"},
+	ncharKind:       {spec: "nchar"},
+	nvarcharKind:    {spec: "nvarchar"},
+	textKind:        {spec: "longtext"},
+	ntextKind:       {spec: "longtext"},
+	binaryKind:      {spec: "binary"},
+	varbinaryKind:   {spec: "varbinary"},
+	blobKind:        {spec: "longblob"},
+	bitKind:         {spec: "bit"},
+	dateKind:        {spec: "date"},
+	timeKind:        {spec: "time"},
+	timeTZKind:      {spec: "time", lossy: lossyTimeZone},
+	timestampKind:   {spec: "datetime"},
+	timestampTZKind: {spec: "datetime", lossy: lossyTimeZone},
+	uuidKind:        {spec: "binary (16)"},
+	jsonKind:        {spec: "longtext"},
+	xmlKind:         {spec: "longtext"},
+	geometryKind:    {spec: "geometry"},
+	geographyKind:   {spec: "geometry", lossy: "geodetic calculations are not supported"},
+	enumKind:        {spec: "enum"},
+	setKind:         {spec: "set"},
+}
+
+// mariadbKindTargets maps the generic kinds to the MariaDB datatypes
+var mariadbKindTargets = func() map[int]kindTarget {
+
+	m := make(map[int]kindTarget)
+	for k, v := range mysqlKindTargets {
+		m[k] = v
+	}
+	m[ncharKind] = kindTarget{spec: "national char"}
+	m[nvarcharKind] = kindTarget{spec: "national varchar"}
+	delete(m, geometryKind)
+	delete(m, geographyKind)
+
+	return m
+}()
+
+// MapDatatype returns the datatype of the to dialect that most closely
+// matches the supplied datatype of the from dialect (as returned by
+// the ParseDatatype method of the from dialect), e.g. an Oracle
+// number (10) maps to a PostgreSQL bigint.
+//
+// When the mapped datatype cannot hold all of the values (or keep all
+// of the properties) of the original datatype the mapped datatype is
+// returned along with an error that wraps ErrLossyDatatype. Any other
+// error means that there is no equivalent datatype.
+func MapDatatype(from, to DbDialect, t Datatype) (Datatype, error) {
+
+	if from.Dialect() == to.Dialect() {
+		return t, nil
+	}
+
+	errNoMatch := fmt.Errorf("%s datatype %q has no %s equivalent", from.DialectName(), t.String(), to.DialectName())
+
+	rules := to.parseRules()
+	if len(t.ArrayDims) > 0 && !rules.arrays {
+		return Datatype{}, errNoMatch
+	}
+
+	kind, g := genericType(from.Dialect(), t)
+
+	if g.Unsigned && !rules.modifiers {
+		// use the next larger type
+		g.Unsigned = false
+		switch kind {
+		case smallintKind:
+			kind = integerKind
+		case integerKind:
+			kind = bigintKind
+		case bigintKind:
+			kind = decimalKind
+			g.Precision, g.HasPrecision = 20, true
+			g.Scale, g.HasScale = 0, true
+		}
+	}
+
+	target, ok := kindTargets[to.Dialect()][kind]
+	if !ok {
+		return Datatype{}, errNoMatch
+	}
+
+	maxLength := target.maxLength
+	if maxLength == 0 {
+		maxLength = rules.limits[target.spec].maxLength
+	}
+	if g.HasLength && maxLength > 0 && g.Length > maxLength {
+		kind = unboundedKinds[kind]
+		target, ok = kindTargets[to.Dialect()][kind]
+		if !ok {
+			return Datatype{}, errNoMatch
+		}
+	}
+
+	if to.Dialect() == MSAccess && kind == decimalKind && g.HasScale && g.Scale <= 4 && g.Precision-g.Scale <= 15 {
+		// currency has four decimal places and 15 digits to the left
+		target = kindTarget{spec: "currency"}
+	}
+
+	var lossy []string
+	if target.lossy != "" {
+		lossy = append(lossy, target.lossy)
+	}
+	if kind == doubleKind && t.Name == "float" && (t.Precision > 53 || from.Dialect() == Oracle && !t.HasPrecision) {
+		// an Oracle float has up to (and defaults to) 126 bits of
+		// precision while double precision types have 53
+		lossy = append(lossy, lossyFloat)
+	}
+
+	m := Datatype{Name: target.spec, Unsigned: g.Unsigned, ArrayDims: t.ArrayDims}
+	if !strings.Contains(target.spec, "(") {
+		lossy = append(lossy, mapParams(to, rules, kind, g, &m)...)
+	}
+
+	r, err := to.ParseDatatype(m.String())
+	if err != nil {
+		// the target doesn't take parameters
+		r, err = to.ParseDatatype(Datatype{Name: m.Name, Unsigned: m.Unsigned, ArrayDims: m.ArrayDims}.String())
+		if err != nil {
+			return Datatype{}, errNoMatch
+		}
+	}
+
+	if len(lossy) > 0 {
+		return r, fmt.Errorf("%w from %s %q to %s %q: %s", ErrLossyDatatype, from.DialectName(), t.String(), to.DialectName(), r.String(), strings.Join(lossy, ", "))
+	}
+
+	return r, nil
+}
+
+// mapParams copies the parameters of the generic datatype to the
+// mapped datatype, fitting them to the limits of the to dialect, and
+// returns the reasons for any loss
+func mapParams(to DbDialect, rules datatypeRules, kind int, g Datatype, m *Datatype) []string {
+
+	var lossy []string
+	lim := rules.limits[m.Name]
+
+	switch {
+	case kind == enumKind || kind == setKind:
+		if m.Name == "enum" || m.Name == "set" {
+			m.Args = g.Args
+			return nil
+		}
+		m.Length, m.HasLength = g.Length, g.HasLength
+	case unboundedKinds[kind] != 0, kind == bitKind:
+		m.Length, m.HasLength = g.Length, g.HasLength
+	case kind == decimalKind:
+		m.Precision, m.HasPrecision = g.Precision, g.HasPrecision
+		m.Scale, m.HasScale = g.Scale, g.HasScale
+
+		if m.HasScale && m.Scale < lim.minScale {
+			// a negative scale rounds to the left of the decimal point
+			// so the same values fit when the digits are added to the
+			// precision
+			m.Precision += lim.minScale - m.Scale
+			m.Scale = lim.minScale
+		}
+
+		switch to.Dialect() {
+		case MSSQL, MySQL, MariaDB:
+			if !m.HasPrecision {
+				// unconstrained numerics default to a scale of zero
+				// in the target, which drops the fractional digits, so
+				// split the precision between the integer and
+				// fractional digits instead
+				m.Precision, m.HasPrecision = lim.maxPrecision, true
+				m.Scale, m.HasScale = lim.maxPrecision/2, true
+				if m.Scale > lim.maxScale {
+					m.Scale = lim.maxScale
+				}
+				lossy = append(lossy, "the precision and scale are limited")
+			}
+		}
+	case isFractionalKind(kind) && !rules.anyParams:
+		m.Precision, m.HasPrecision = g.Precision, g.HasPrecision
+
+		switch to.Dialect() {
+		case MySQL, MariaDB:
+			if !m.HasPrecision {
+				// the default is whole seconds
+				m.Precision, m.HasPrecision = 6, true
+			}
+		}
+	}
+
+	if m.HasPrecision && lim.maxPrecision > 0 && m.Precision > lim.maxPrecision {
+		lossy = append(lossy, fmt.Sprintf("the precision is reduced from %d to %d", m.Precision, lim.maxPrecision))
+		m.Precision = lim.maxPrecision
+	}
+
+	maxScale := lim.maxScale
+	if lim.scaleToPrecision && m.Precision < maxScale {
+		maxScale = m.Precision
+	}
+	if m.HasScale && lim.maxScale > 0 && m.Scale > maxScale {
+		lossy = append(lossy, fmt.Sprintf("the scale is reduced from %d to %d", m.Scale, maxScale))
+		m.Scale = maxScale
+	}
+
+	return lossy
+}
+
+// genericType returns the generic kind of the supplied datatype of the
+// from dialect along with the parameters of the datatype that are
+// relevant to the kind
+func genericType(from int, t Datatype) (int, Datatype) {
+
+	kind := genericKinds[t.Name]
+	if t.Name == "float" && t.HasPrecision && t.Precision <= 24 {
+		kind = realKind
+	}
+
+	switch from {
+	case Oracle:
+		switch t.Name {
+		case "number", "integer", "smallint":
+			return oracleNumber(t)
+		case "date":
+			// dates include the time of day
+			kind = timestampKind
+			t.Precision, t.HasPrecision = 0, true
+		case "long":
+			kind = textKind
+		}
+	case MSSQL:
+		switch {
+		case t.Name == "bit":
+			kind = booleanKind
+		case t.Name == "tinyint":
+			kind = utinyintKind
+		case t.Name == "timestamp":
+			// a synonym for rowversion
+			return binaryKind, Datatype{Length: 8, HasLength: true}
+		case t.Name == "money":
+			return decimalKind, Datatype{Precision: 19, HasPrecision: true, Scale: 4, HasScale: true}
+		case t.Name == "smallmoney":
+			return decimalKind, Datatype{Precision: 10, HasPrecision: true, Scale: 4, HasScale: true}
+		case t.Name == "datetime":
+			t.Precision, t.HasPrecision = 3, true
+		case t.Name == "smalldatetime":
+			t.Precision, t.HasPrecision = 0, true
+		case t.Max:
+			kind = unboundedKinds[kind]
+		}
+		if isFractionalKind(kind) && !t.HasPrecision {
+			t.Precision, t.HasPrecision = 7, true
+		}
+	case MySQL, MariaDB:
+		switch t.Name {
+		case "tinyint":
+			switch {
+			case t.Unsigned:
+				kind = utinyintKind
+				t.Unsigned = false
+			case t.HasPrecision && t.Precision == 1:
+				kind = booleanKind
+			}
+		case "mediumint":
+			// an unsigned mediumint still fits in an integer
+			t.Unsigned = false
+		case "float":
+			if !t.HasPrecision {
+				kind = realKind
+			}
+		case "real":
+			kind = doubleKind
+		case "point", "polygon":
+			kind = geometryKind
+		}
+		if isFractionalKind(kind) && !t.HasPrecision {
+			t.Precision, t.HasPrecision = 0, true
+		}
+	case MSAccess:
+		switch t.Name {
+		case "byte":
+			kind = utinyintKind
+		case "integer":
+			kind = smallintKind
+		case "long":
+			kind = integerKind
+		case "number":
+			kind = doubleKind
+		case "currency":
+			return decimalKind, Datatype{Precision: 19, HasPrecision: true, Scale: 4, HasScale: true}
+		case "text":
+			kind = varcharKind
+		}
+		if kind == varcharKind {
+			t.Length, t.HasLength = 255, true
+		}
+	case PostgreSQL:
+		switch t.Name {
+		case "money":
+			return decimalKind, Datatype{Precision: 19, HasPrecision: true, Scale: 2, HasScale: true}
+		case "name":
+			return varcharKind, Datatype{Length: 63, HasLength: true}
+		case "\"char\"":
+			return charKind, Datatype{Length: 1, HasLength: true}
+		}
+	case SQLite:
+		if kind == unknownKind {
+			kind = affinityKinds[sqliteAffinity(t.Name)]
+		}
+		if kind == realKind {
+			// real values are eight byte floating point numbers
+			kind = doubleKind
+		}
+	}
+
+	if (from == PostgreSQL || from == SQLite) && !t.HasLength {
+		// varchar without a length is unbounded
+		switch kind {
+		case varcharKind:
+			kind = textKind
+		case nvarcharKind:
+			kind = ntextKind
+		}
+	}
+
+	g := Datatype{Unsigned: t.Unsigned}
+
+	switch {
+	case kind == enumKind || kind == setKind:
+		g.Args = t.Args
+		g.Length, g.HasLength = enumLength(kind, t.Args), true
+	case unboundedKinds[kind] != 0, kind == bitKind:
+		g.Length, g.HasLength = t.Length, t.HasLength
+	case kind == decimalKind:
+		g.Precision, g.HasPrecision = t.Precision, t.HasPrecision
+		g.Scale, g.HasScale = t.Scale, t.HasScale
+	case isFractionalKind(kind):
+		g.Precision, g.HasPrecision = t.Precision, t.HasPrecision
+	}
+
+	return kind, g
+}
+
+// oracleNumber returns the generic kind of an Oracle number, using
+// integer kinds for numbers without decimal places that fit in them
+func oracleNumber(t Datatype) (int, Datatype) {
+
+	p, s := t.Precision, t.Scale
+	switch {
+	case t.Name != "number":
+		// integer and smallint are number (38)
+		p, s = 38, 0
+	case !t.HasPrecision:
+		return decimalKind, Datatype{}
+	}
+
+	if s < 0 {
+		// number (10, -2) rounds to hundreds so holds up to 12 digits
+		p, s = p-s, 0
+	}
+
+	switch {
+	case s > 0:
+		if s > p {
+			// number (2, 5) holds values like 0.00012
+			p = s
+		}
+		return decimalKind, Datatype{Precision: p, HasPrecision: true, Scale: s, HasScale: true}
+	case p <= 4:
+		return smallintKind, Datatype{}
+	case p <= 9:
+		return integerKind, Datatype{}
+	case p <= 18:
+		return bigintKind, Datatype{}
+	}
+
+	return decimalKind, Datatype{Precision: p, HasPrecision: true, Scale: 0, HasScale: true}
+}
+
+// enumLength returns the length of the longest value of an enum (or
+// of all of the values of a set) with the supplied values
+func enumLength(kind int, values []string) int {
+
+	n := 0
+	for i, v := range values {
+		l := len(strings.ReplaceAll(strings.Trim(v, "'"), "''", "'"))
+		switch {
+		case kind == setKind && i > 0:
+			n += l + 1
+		case kind == setKind:
+			n = l
+		case l > n:
+			n = l
+		}
+	}
+
+	return n
+}
+
+// isFractionalKind returns a boolean indicating if the supplied kind
+// takes a fractional seconds precision
+func isFractionalKind(kind int) bool {
+
+	switch kind {
+	case timeKind, timeTZKind, timestampKind, timestampTZKind:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+package dialect
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMapDatatype(t *testing.T) {
+
+	pg, my, ora, ms := NewPostgreSQLDialect(), NewMySQLDialect(), NewOracleDialect(), NewMSSQLDialect()
+
+	tests := []struct {
+		from, to  DbDialect
+		in, want  string
+		lossy     bool
+		noMapping bool
+	}{
+		{ora, pg, "varchar2(30)", "varchar(30)", false, false},
+		{ora, pg, "number(10)", "bigint", false, false},
+		{ora, pg, "number(5,2)", "numeric(5,2)", false, false},
+		{ora, pg, "date", "timestamp(0)", false, false},
+		{pg, ora, "text", "clob", false, false},
+		{pg, ora, "boolean", "number(1)", false, false},
+		{pg, my, "uuid", "binary(16)", false, false},
+		{pg, ms, "timestamp with time zone", "datetimeoffset", false, false},
+		{my, pg, "int unsigned", "bigint", false, false},
+		{my, pg, "enum('a','bb')", "text", true, false},
+		{ms, pg, "nvarchar(max)", "text", false, false},
+		{ms, pg, "bit", "boolean", false, false},
+		{pg, my, "integer[]", "", false, true},
+		{pg, my, "numeric(5,-2)", "decimal(7,0)", false, false},
+		{pg, ora, "numeric(5,-2)", "number(5,-2)", false, false},
+		{pg, ms, "float(10)", "real", false, false},
+		{ora, pg, "float(126)", "double precision", true, false},
+		{ora, pg, "float", "double precision", true, false},
+		{ora, pg, "float(53)", "double precision", false, false},
+		{ora, ms, "float(63)", "float", true, false},
+		{ora, pg, "binary_double", "double precision", false, false},
+		{pg, ora, "float(53)", "binary_double", false, false},
+		{pg, ms, "numeric", "decimal(38,19)", true, false},
+		{pg, my, "numeric", "decimal(65,30)", true, false},
+	}
+
+	for _, tt := range tests {
+		dt, err := tt.from.ParseDatatype(tt.in)
+		if err != nil {
+			t.Fatalf("%s: ParseDatatype(%q) %v", tt.from.DialectName(), tt.in, err)
+		}
+
+		got, err := MapDatatype(tt.from, tt.to, dt)
+		switch {
+		case tt.noMapping:
+			if err == nil || errors.Is(err, ErrLossyDatatype) {
+				t.Errorf("MapDatatype(%s, %s, %q) error %v, expected no mapping", tt.from.DialectName(), tt.to.DialectName(), tt.in, err)
+			}
+			continue
+		case errors.Is(err, ErrLossyDatatype) != tt.lossy:
+			t.Errorf("MapDatatype(%s, %s, %q) error %v", tt.from.DialectName(), tt.to.DialectName(), tt.in, err)
+		case err != nil && !tt.lossy:
+			t.Errorf("MapDatatype(%s, %s, %q) error %v", tt.from.DialectName(), tt.to.DialectName(), tt.in, err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("MapDatatype(%s, %s, %q) = %q, expected %q", tt.from.DialectName(), tt.to.DialectName(), tt.in, got.String(), tt.want)
+		}
+	}
+}
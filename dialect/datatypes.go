@@ -0,0 +1,559 @@
+package dialect
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrDatatypeLimit is wrapped by the error returned from ParseDatatype
+// (and MatchDatatype) when a parameter of the datatype is out of range
+// for the dialect
+var ErrDatatypeLimit = errors.New("datatype parameter out of range")
+
+// Datatype is the parsed form of a datatype specification such as
+// "varchar2 (30 char)" or "timestamp (6) with local time zone"
+type Datatype struct {
+	Schema        string   // the schema of a schema qualified datatype (PostgreSQL), quoted as needed, e.g. "public" for public.my_enum
+	Name          string   // the lower case name of the datatype without any parameters or modifiers, e.g. "timestamp with local time zone" (a PostgreSQL user-defined name is quoted as needed, e.g. "\"MyEnum\"")
+	Args          []string // the parameters (the tokens between the parentheses) as supplied
+	Length        int      // the length of character, binary and bit types
+	HasLength     bool     // a length was supplied
+	Precision     int      // the precision of numeric types, or the (leading field) precision of temporal types
+	HasPrecision  bool     // a precision was supplied
+	Scale         int      // the scale of numeric types, or the fractional seconds precision of "to second" intervals
+	HasScale      bool     // a scale was supplied
+	Semantics     string   // Oracle length semantics, "byte" or "char" (if supplied)
+	TimeZone      bool     // the type includes a time zone
+	LocalTimeZone bool     // the type is "with local time zone" (Oracle)
+	Max           bool     // the length is "max" (MSSQL)
+	ArrayDims     []int    // the array dimensions (PostgreSQL), 0 if no size was supplied
+	Unsigned      bool     // the type is unsigned (MySQL, MariaDB)
+	Zerofill      bool     // the type is zerofill (MySQL, MariaDB)
+}
+
+// datatypeRules describes how a dialect parses datatype specifications
+type datatypeRules struct {
+	types     map[string]bool        // the normalized datatype specifications supported by the dialect
+	signed    bool                   // numeric parameters may be signed
+	arrays    bool                   // trailing [] array dimensions are supported
+	modifiers bool                   // numeric types may be signed, unsigned or zerofill
+	anyParams bool                   // any parameters are allowed so only the name needs to be known
+	anyNames  bool                   // any name is allowed, with up to two numeric parameters
+	lengths   map[string]bool        // the datatypes whose parameter is a length (as opposed to a precision)
+	numerics  map[string]bool        // the numeric datatypes that the modifiers may be applied to
+	limits    map[string]paramLimits // the parameter limits of the datatypes, by name
+}
+
+// paramLimits is the range of the parameters of a datatype. Ranges with
+// a zero maximum are not checked
+type paramLimits struct {
+	minLength, maxLength       int
+	minPrecision, maxPrecision int
+	maxSinglePrecision         int // the maximum precision when there is no scale, if lower (MySQL float (p))
+	minScale, maxScale         int
+	scaleToPrecision           bool // the scale may not exceed the precision
+}
+
+// String returns the datatype specification for the datatype
+func (t Datatype) String() string {
+
+	var sb strings.Builder
+
+	name := t.Name
+	params := t.params()
+
+	if t.Schema != "" {
+		sb.WriteString(t.Schema + ".")
+	}
+
+	switch {
+	case strings.HasPrefix(name, "interval ") && strings.Contains(name, " to "):
+		// interval day (p) to second (s)
+		from, to, _ := strings.Cut(name, " to ")
+		sb.WriteString(from)
+		if t.HasPrecision {
+			sb.WriteString("(" + strconv.Itoa(t.Precision) + ")")
+		}
+		sb.WriteString(" to " + to)
+		if t.HasScale {
+			sb.WriteString("(" + strconv.Itoa(t.Scale) + ")")
+		}
+	case params != "" && strings.Contains(name, " with"):
+		// timestamp (p) with time zone
+		first, rest, _ := strings.Cut(name, " ")
+		sb.WriteString(first + params + " " + rest)
+	default:
+		sb.WriteString(name + params)
+	}
+
+	if t.Unsigned {
+		sb.WriteString(" unsigned")
+	}
+	if t.Zerofill {
+		sb.WriteString(" zerofill")
+	}
+
+	for _, n := range t.ArrayDims {
+		if n > 0 {
+			sb.WriteString("[" + strconv.Itoa(n) + "]")
+		} else {
+			sb.WriteString("[]")
+		}
+	}
+
+	return sb.String()
+}
+
+// params returns the parenthesized parameters of the datatype, or an
+// empty string if there are none
+func (t Datatype) params() string {
+
+	// Parameters that aren't covered by the fields are written as
+	// supplied (e.g. geometry (point, 4326) or enum ('a', 'b'))
+	nums := 0
+	for _, a := range t.Args {
+		switch la := strings.ToLower(a); {
+		case isNumberToken(a, true):
+			nums++
+		case la == "byte", la == "char", la == "max":
+		default:
+			return "(" + strings.Join(t.Args, ",") + ")"
+		}
+	}
+	if nums > 2 || (nums > 1 && t.HasLength) {
+		return "(" + strings.Join(t.Args, ",") + ")"
+	}
+
+	switch {
+	case t.Max:
+		return "(max)"
+	case t.HasLength && t.Semantics != "":
+		return "(" + strconv.Itoa(t.Length) + " " + t.Semantics + ")"
+	case t.HasLength:
+		return "(" + strconv.Itoa(t.Length) + ")"
+	case t.HasPrecision && t.HasScale:
+		return "(" + strconv.Itoa(t.Precision) + "," + strconv.Itoa(t.Scale) + ")"
+	case t.HasPrecision:
+		return "(" + strconv.Itoa(t.Precision) + ")"
+	}
+
+	return ""
+}
+
+// canonicalDatatype returns the supplied datatype with the name
+// replaced by the canonical form from the supplied aliases. Aliases
+// that include parameters (e.g. "tinyint(1)") replace the parameters
+// of the datatype, otherwise the parameters are kept
+func canonicalDatatype(d DbDialect, aliases map[string]string, t Datatype) Datatype {
+
+	a, ok := aliases[t.Name]
+	if !ok {
+		return t
+	}
+
+	c, err := d.ParseDatatype(a)
+	if err != nil {
+		return t
+	}
+
+	if len(c.Args) == 0 {
+		c.Args = t.Args
+		c.Length, c.HasLength = t.Length, t.HasLength
+		c.Precision, c.HasPrecision = t.Precision, t.HasPrecision
+		c.Scale, c.HasScale = t.Scale, t.HasScale
+		c.Semantics, c.Max = t.Semantics, t.Max
+	}
+	c.Unsigned, c.Zerofill, c.ArrayDims = t.Unsigned, t.Zerofill, t.ArrayDims
+	c.Schema = t.Schema
+
+	// the parameters may not be valid for the canonical form
+	if _, err := d.ParseDatatype(c.String()); err != nil {
+		return t
+	}
+
+	return c
+}
+
+// MatchDatatype returns the longest datatype found at the start of the
+// supplied tokens along with the number of tokens that make up the
+// datatype. Whitespace tokens are skipped (and counted) so the tokens
+// may come straight from a Lexer (e.g. "timestamp", " ", "(", "6",
+// ")", " ", "with", " ", "local", " ", "time", " ", "zone"). The error
+// wraps ErrDatatypeLimit when the longest datatype has a parameter
+// that is out of range.
+func MatchDatatype(d DbDialect, tokens []string) (Datatype, int, error) {
+
+	var words []string
+	var ends [][2]int // the number of words and tokens of each candidate
+	depth := 0
+
+scan:
+	for i, v := range tokens {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+
+		switch {
+		case v == "(" || v == "[":
+			depth++
+		case v == ")" || v == "]":
+			depth--
+			if depth < 0 {
+				break scan
+			}
+		case v == ";":
+			break scan
+		case depth > 0:
+		case v == "," || !d.IsDatatypePart(v):
+			break scan
+		}
+
+		words = append(words, v)
+		if depth == 0 {
+			ends = append(ends, [2]int{len(words), i + 1})
+		}
+	}
+
+	// A parameter that is out of range is an error rather than a reason
+	// to settle for a shorter datatype (varchar2 rather than
+	// varchar2(5000))
+	for j := len(ends) - 1; j >= 0; j-- {
+		t, err := d.ParseDatatype(words[:ends[j][0]]...)
+		switch {
+		case err == nil:
+			return t, ends[j][1], nil
+		case errors.Is(err, ErrDatatypeLimit):
+			return Datatype{}, 0, err
+		}
+	}
+
+	at := ""
+	if len(words) > 0 {
+		at = words[0]
+	} else if len(tokens) > 0 {
+		at = tokens[0]
+	}
+
+	return Datatype{}, 0, fmt.Errorf("no %s datatype found at %q", d.DialectName(), at)
+}
+
+// datatypeParts returns the set of words used in the supplied datatype
+// specifications plus any extra words
+func datatypeParts(types map[string]bool, extra ...string) map[string]bool {
+
+	parts := make(map[string]bool)
+	for k := range types {
+		for _, v := range splitDatatype([]string{k}) {
+			switch v {
+			case "(", ")", ",", "[", "]", "n", "s":
+			default:
+				parts[v] = true
+			}
+		}
+	}
+	for _, v := range extra {
+		parts[v] = true
+	}
+
+	return parts
+}
+
+// parseDatatype parses the supplied datatype tokens using the supplied
+// rules. Tokens that contain more than one part of the specification
+// (e.g. "varchar(30)") are split up
+func parseDatatype(d DbDialect, r datatypeRules, tokens []string) (Datatype, error) {
+
+	var t Datatype
+
+	toks := splitDatatype(tokens)
+	errInvalid := fmt.Errorf("%q is not a valid %s datatype", strings.Join(tokens, " "), d.DialectName())
+
+	if r.arrays {
+		toks, t.ArrayDims = splitArrayDims(toks)
+	}
+	if len(toks) == 0 {
+		return Datatype{}, errInvalid
+	}
+
+	var name []string
+	var key []string
+	var mods []int
+	var nums []int
+	depth := 0
+	pv := ""
+
+	for i, v := range toks {
+		lv := strings.ToLower(v)
+
+		switch {
+		case v == "(":
+			if depth > 0 || i == 0 {
+				return Datatype{}, errInvalid
+			}
+			depth++
+			key = append(key, " (")
+		case v == ")":
+			if depth == 0 {
+				return Datatype{}, errInvalid
+			}
+			depth--
+			key = append(key, ")")
+		case v == ",":
+			if depth == 0 {
+				return Datatype{}, errInvalid
+			}
+			key = append(key, ",")
+		case depth > 0:
+			t.Args = append(t.Args, v)
+			switch {
+			case isNumberToken(v, r.signed):
+				n, err := strconv.Atoi(v)
+				if err != nil {
+					return Datatype{}, errInvalid
+				}
+				nums = append(nums, n)
+				key = append(key, "n")
+			case len(v) > 1 && v[0] == '\'' && v[len(v)-1] == '\'':
+				// the values of an enum or set
+				if pv == "," && key[len(key)-2] == "s" {
+					key = key[:len(key)-1]
+				} else {
+					key = append(key, "s")
+				}
+			case lv == "max":
+				t.Max = true
+				key = append(key, lv)
+			case (lv == "byte" || lv == "char") && isNumberToken(pv, r.signed):
+				t.Semantics = lv
+				key = append(key, " "+lv)
+			case pv == "(" || pv == ",":
+				key = append(key, lv)
+			default:
+				key = append(key, " "+lv)
+			}
+		case r.modifiers && i > 0 && (lv == "signed" || lv == "unsigned" || lv == "zerofill"):
+			t.Unsigned = t.Unsigned || lv == "unsigned"
+			t.Zerofill = t.Zerofill || lv == "zerofill"
+			mods = append(mods, len(key))
+			key = append(key, " "+lv)
+		case i == 0:
+			name = append(name, lv)
+			key = append(key, lv)
+		default:
+			name = append(name, lv)
+			key = append(key, " "+lv)
+		}
+		pv = lv
+	}
+
+	if depth != 0 {
+		return Datatype{}, errInvalid
+	}
+
+	t.Name = strings.Join(name, " ")
+	k := strings.Join(key, "")
+
+	switch {
+	case r.types[k]:
+	case len(mods) > 0 && r.numerics[t.Name]:
+		// the modifiers may be applied to any numeric type
+		for i := len(mods) - 1; i >= 0; i-- {
+			key = append(key[:mods[i]], key[mods[i]+1:]...)
+		}
+		if !r.types[strings.Join(key, "")] {
+			return Datatype{}, errInvalid
+		}
+	case r.anyParams && r.types[t.Name]:
+	case r.anyNames && len(nums) == len(t.Args) && len(nums) <= 2 && isNameWords(d, name):
+	default:
+		return Datatype{}, errInvalid
+	}
+
+	switch {
+	case len(nums) == 0:
+	case len(nums) == 1 && strings.HasSuffix(k, " to second (n)"):
+		// PostgreSQL interval day to second (p)
+		t.Scale, t.HasScale = nums[0], true
+	case r.lengths[t.Name]:
+		t.Length, t.HasLength = nums[0], true
+	default:
+		t.Precision, t.HasPrecision = nums[0], true
+		if len(nums) > 1 {
+			t.Scale, t.HasScale = nums[1], true
+		}
+	}
+
+	if err := checkLimits(d, r.limits, t); err != nil {
+		return Datatype{}, err
+	}
+
+	switch {
+	case strings.HasSuffix(t.Name, " with local time zone"):
+		t.TimeZone = true
+		t.LocalTimeZone = true
+	case strings.HasSuffix(t.Name, " with time zone"):
+		t.TimeZone = true
+	case t.Name == "timestamptz", t.Name == "timetz", t.Name == "datetimeoffset":
+		t.TimeZone = true
+	}
+
+	return t, nil
+}
+
+// checkLimits returns an error if the parameters of the supplied
+// datatype are outside of the limits for the datatype
+func checkLimits(d DbDialect, limits map[string]paramLimits, t Datatype) error {
+
+	l, ok := limits[t.Name]
+	if !ok {
+		return nil
+	}
+
+	outOfRange := func(what string, v, lo, hi int) error {
+		return fmt.Errorf("%w: %q is not a valid %s datatype, the %s (%d) must be between %d and %d", ErrDatatypeLimit, t.String(), d.DialectName(), what, v, lo, hi)
+	}
+
+	if t.HasLength && l.maxLength > 0 && (t.Length < l.minLength || t.Length > l.maxLength) {
+		return outOfRange("length", t.Length, l.minLength, l.maxLength)
+	}
+	maxPrecision := l.maxPrecision
+	if !t.HasScale && l.maxSinglePrecision > 0 {
+		maxPrecision = l.maxSinglePrecision
+	}
+	if t.HasPrecision && maxPrecision > 0 && (t.Precision < l.minPrecision || t.Precision > maxPrecision) {
+		return outOfRange("precision", t.Precision, l.minPrecision, maxPrecision)
+	}
+
+	maxScale := l.maxScale
+	if l.scaleToPrecision && t.HasPrecision && t.Precision < maxScale {
+		maxScale = t.Precision
+	}
+	if t.HasScale && l.maxScale > 0 && (t.Scale < l.minScale || t.Scale > maxScale) {
+		return outOfRange("scale", t.Scale, l.minScale, maxScale)
+	}
+
+	return nil
+}
+
+// splitDatatype splits the supplied datatype tokens on whitespace and
+// around the punctuation used in datatype specifications so that both
+// "varchar(30)" and "varchar", "(", "30", ")" may be used. Signs are
+// joined to the numbers that they precede
+func splitDatatype(tokens []string) []string {
+
+	var toks []string
+	for _, s := range tokens {
+		start := -1
+		quote := byte(0)
+		for i := 0; i < len(s); i++ {
+			c := s[i]
+			switch {
+			case quote != 0:
+				if c == quote {
+					quote = 0
+				}
+				continue
+			case c == '\'' || c == '"':
+				quote = c
+			case isSpace(c):
+				if start >= 0 {
+					toks = append(toks, s[start:i])
+					start = -1
+				}
+				continue
+			case strings.IndexByte("(),[]", c) >= 0:
+				if start >= 0 {
+					toks = append(toks, s[start:i])
+					start = -1
+				}
+				toks = append(toks, s[i:i+1])
+				continue
+			}
+			if start < 0 {
+				start = i
+			}
+		}
+		if start >= 0 {
+			toks = append(toks, s[start:])
+		}
+	}
+
+	// join signs to their numbers, e.g. number (10, -2)
+	var z []string
+	for i := 0; i < len(toks); i++ {
+		v := toks[i]
+		if (v == "-" || v == "+") && i+1 < len(toks) && isNumberToken(toks[i+1], false) {
+			v += toks[i+1]
+			i++
+		}
+		z = append(z, v)
+	}
+
+	return z
+}
+
+// splitArrayDims removes any trailing array dimensions ("[]" or "[n]",
+// or the SQL standard "array" or "array[n]" for a single dimension)
+// from the supplied tokens and returns the remaining tokens and the
+// dimensions
+func splitArrayDims(toks []string) ([]string, []int) {
+
+	var dims []int
+	for len(toks) >= 2 && toks[len(toks)-1] == "]" {
+		switch {
+		case toks[len(toks)-2] == "[":
+			dims = append([]int{0}, dims...)
+			toks = toks[:len(toks)-2]
+		case len(toks) >= 3 && toks[len(toks)-3] == "[" && isNumberToken(toks[len(toks)-2], false):
+			n, _ := strconv.Atoi(toks[len(toks)-2])
+			dims = append([]int{n}, dims...)
+			toks = toks[:len(toks)-3]
+		default:
+			return toks, dims
+		}
+	}
+
+	if len(toks) >= 2 && len(dims) <= 1 && strings.EqualFold(toks[len(toks)-1], "array") {
+		if len(dims) == 0 {
+			dims = []int{0}
+		}
+		toks = toks[:len(toks)-1]
+	}
+
+	return toks, dims
+}
+
+// isNumberToken returns a boolean indicating if the supplied token is
+// an integer, optionally with a sign
+func isNumberToken(s string, signed bool) bool {
+
+	if signed && len(s) > 1 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isNameWords returns a boolean indicating if the supplied words are
+// all identifiers that are not keywords (so that column constraints
+// such as "primary key" are not mistaken for a datatype)
+func isNameWords(d DbDialect, words []string) bool {
+
+	for _, w := range words {
+		if !d.IsIdentifier(w) || d.IsKeyword(w) {
+			return false
+		}
+	}
+
+	return len(words) > 0
+}
@@ -0,0 +1,224 @@
+package dialect
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDatatypeParams(t *testing.T) {
+
+	tests := []struct {
+		d                        DbDialect
+		in                       string
+		length, precision, scale int
+	}{
+		{NewPostgreSQLDialect(), "varchar(10)", 10, 0, 0},
+		{NewPostgreSQLDialect(), "numeric(10,2)", 0, 10, 2},
+		{NewPostgreSQLDialect(), "interval day to second(3)", 0, 0, 3},
+		{NewOracleDialect(), "interval day(2) to second(6)", 0, 2, 6},
+		{NewOracleDialect(), "raw(16)", 16, 0, 0},
+		{NewMySQLDialect(), "int(11)", 0, 11, 0},
+		{NewMySQLDialect(), "text(100)", 100, 0, 0},
+		{NewMSSQLDialect(), "varbinary(20)", 20, 0, 0},
+		{NewMSSQLDialect(), "float(24)", 0, 24, 0},
+		{NewSQLiteDialect(), "varying character(10)", 10, 0, 0},
+		{NewSQLiteDialect(), "money(10,2)", 0, 10, 2},
+		{NewStandardSQLDialect(), "character varying(10)", 10, 0, 0},
+	}
+
+	for _, tt := range tests {
+		dt, err := tt.d.ParseDatatype(tt.in)
+		if err != nil || dt.Length != tt.length || dt.Precision != tt.precision || dt.Scale != tt.scale {
+			t.Errorf("%s: ParseDatatype(%q) = length %d precision %d scale %d %v", tt.d.DialectName(), tt.in, dt.Length, dt.Precision, dt.Scale, err)
+		}
+	}
+}
+
+func TestMatchDatatype(t *testing.T) {
+
+	sq := NewSQLiteDialect()
+
+	tests := []struct {
+		d    DbDialect
+		sql  string
+		want string
+		n    int
+	}{
+		{sq, "floating point not null", "floating point", 3},
+		{sq, "unsigned big int primary key", "unsigned big int", 5},
+		{sq, "my_type(10, 2) default 0", "my_type(10,2)", 7},
+		{sq, "x generated always as (1)", "x", 1},
+		{NewPostgreSQLDialect(), "timestamp (3) with time zone not null", "timestamp(3) with time zone", 11},
+		{NewPostgreSQLDialect(), "integer array[3],", "integer[3]", 6},
+		{NewOracleDialect(), "varchar2(30 char) not null", "varchar2(30 char)", 6},
+		{NewMySQLDialect(), "int unsigned zerofill default 0", "int unsigned zerofill", 5},
+	}
+
+	for _, tt := range tests {
+		var tokens []string
+		for _, tk := range Tokenize(tt.d, tt.sql) {
+			tokens = append(tokens, tk.Value)
+		}
+
+		dt, n, err := MatchDatatype(tt.d, tokens)
+		if err != nil || dt.String() != tt.want || n != tt.n {
+			t.Errorf("%s: MatchDatatype(%q) = %q %d %v, expected %q %d", tt.d.DialectName(), tt.sql, dt.String(), n, err, tt.want, tt.n)
+		}
+
+		if tt.d.Dialect() == SQLite && sq.Affinity(tokens[:n]...) != sq.Affinity(tt.want) {
+			t.Errorf("Affinity(%q) differs from Affinity(%q)", tokens[:n], tt.want)
+		}
+	}
+
+	if _, _, err := MatchDatatype(sq, []string{"primary", " ", "key"}); err == nil {
+		t.Errorf("MatchDatatype(primary key) succeeded, expected an error")
+	}
+}
+
+func TestParseDatatypeLimits(t *testing.T) {
+
+	tests := []struct {
+		d     DbDialect
+		valid []string
+		bad   []string
+	}{
+		{NewOracleDialect(),
+			[]string{"varchar2(4000)", "varchar2(30 char)", "number(38,2)", "number(5,-2)", "timestamp(9)"},
+			[]string{"varchar2(4001)", "number(39)", "timestamp(10)"}},
+		{NewPostgreSQLDialect(),
+			[]string{"varchar(10)", "numeric(10,2)", "timestamp(6)", "bit(3)", "timestamptz(3)", "timetz(3)",
+				"interval day to second(3)", "interval second(3)", "timestamp(3) with time zone", "numeric(5,-2)",
+				"numeric(2,3)", "float(53)"},
+			[]string{"varchar(0)", "numeric(1001)", "timestamp(7)", "timetz(7)", "interval day to second(7)",
+				"numeric(5,-1001)", "float(54)", "varchar(-1)"}},
+		{NewMySQLDialect(),
+			[]string{"varchar(65535)", "decimal(65,30)", "int(11) unsigned zerofill", "datetime(6)", "float(53)",
+				"float(0)", "float(60,2)"},
+			[]string{"varchar(65536)", "decimal(66)", "decimal(10,11)", "datetime(7)", "float(54)"}},
+		{NewMariaDBDialect(),
+			[]string{"float(53)", "float(60,2)"},
+			[]string{"float(54)"}},
+		{NewMSSQLDialect(),
+			[]string{"varchar(max)", "nvarchar(4000)", "decimal(38,38)", "datetime2(7)"},
+			[]string{"nvarchar(4001)", "datetime2(8)"}},
+	}
+
+	for _, tt := range tests {
+		for _, s := range tt.valid {
+			if dt, err := tt.d.ParseDatatype(s); err != nil || dt.String() != s {
+				t.Errorf("%s: ParseDatatype(%q) = %q %v", tt.d.DialectName(), s, dt.String(), err)
+			}
+		}
+		for _, s := range tt.bad {
+			if _, err := tt.d.ParseDatatype(s); err == nil {
+				t.Errorf("%s: ParseDatatype(%q) succeeded, expected an error", tt.d.DialectName(), s)
+			}
+		}
+	}
+}
+
+func TestMatchDatatypeLimits(t *testing.T) {
+
+	tests := []struct {
+		d   DbDialect
+		sql string
+	}{
+		{NewOracleDialect(), "varchar2(5000) not null"},
+		{NewOracleDialect(), "number (39, 2),"},
+		{NewMSSQLDialect(), "nvarchar(4001) null"},
+		{NewMySQLDialect(), "decimal(66,30) default 0"},
+		{NewPostgreSQLDialect(), "numeric(1001)"},
+		{NewPostgreSQLDialect(), "timestamp (7) with time zone"},
+	}
+
+	for _, tt := range tests {
+		var tokens []string
+		for _, tk := range Tokenize(tt.d, tt.sql) {
+			tokens = append(tokens, tk.Value)
+		}
+
+		dt, n, err := MatchDatatype(tt.d, tokens)
+		if !errors.Is(err, ErrDatatypeLimit) {
+			t.Errorf("%s: MatchDatatype(%q) = %q %d %v, expected a limit error", tt.d.DialectName(), tt.sql, dt.String(), n, err)
+		}
+	}
+}
+
+func TestSetExtendedStringSize(t *testing.T) {
+
+	std := NewOracleDialect()
+	ext := NewOracleDialect()
+	ext.SetExtendedStringSize(true)
+
+	tests := []struct {
+		in            string
+		standard, ext bool
+	}{
+		{"varchar2(4000)", true, true},
+		{"varchar2(4001)", false, true},
+		{"varchar2(32767 char)", false, true},
+		{"varchar2(32768)", false, false},
+		{"nvarchar2(32767)", false, true},
+		{"raw(2000)", true, true},
+		{"raw(2001)", false, true},
+		{"char(2001)", false, false},
+		{"number(39)", false, false},
+	}
+
+	for _, tt := range tests {
+		for _, d := range []*OracleDialect{std, ext} {
+			want := tt.standard
+			if d == ext {
+				want = tt.ext
+			}
+			_, err := d.ParseDatatype(tt.in)
+			if (err == nil) != want {
+				t.Errorf("extended %v: ParseDatatype(%q) error %v", d == ext, tt.in, err)
+			}
+			if err != nil && !errors.Is(err, ErrDatatypeLimit) {
+				t.Errorf("extended %v: ParseDatatype(%q) error %v, expected a limit error", d == ext, tt.in, err)
+			}
+		}
+	}
+
+	if _, _, err := MatchDatatype(ext, []string{"varchar2", "(", "5000", ")"}); err != nil {
+		t.Errorf("extended: MatchDatatype(varchar2(5000)) %v", err)
+	}
+}
+
+func TestCanonicalDatatype(t *testing.T) {
+
+	tests := []struct {
+		d        DbDialect
+		in, want string
+	}{
+		{NewPostgreSQLDialect(), "int4", "integer"},
+		{NewPostgreSQLDialect(), "varchar(10)", "character varying(10)"},
+		{NewPostgreSQLDialect(), "timestamptz", "timestamp with time zone"},
+		{NewPostgreSQLDialect(), "int4[]", "integer[]"},
+		{NewPostgreSQLDialect(), "decimal(5,2)", "numeric(5,2)"},
+		{NewPostgreSQLDialect(), "float(10)", "real"},
+		{NewPostgreSQLDialect(), "float(25)", "double precision"},
+		{NewPostgreSQLDialect(), "pg_catalog.float(10)", "pg_catalog.real"},
+		{NewPostgreSQLDialect(), "pg_catalog.float[]", "pg_catalog.double precision[]"},
+		{NewPostgreSQLDialect(), "pg_catalog.int4", "pg_catalog.integer"},
+		{NewMySQLDialect(), "integer", "int"},
+		{NewMySQLDialect(), "dec(5,2)", "decimal(5,2)"},
+		{NewMySQLDialect(), "bool", "tinyint(1)"},
+		{NewMySQLDialect(), "real(10,2)", "double(10,2)"},
+		{NewMariaDBDialect(), "boolean", "tinyint(1)"},
+		{NewOracleDialect(), "smallint", "number(38)"},
+		{NewOracleDialect(), "integer", "number(38)"},
+		{NewSQLiteDialect(), "int", "int"},
+	}
+
+	for _, tt := range tests {
+		dt, err := tt.d.ParseDatatype(tt.in)
+		if err != nil {
+			t.Fatalf("%s: ParseDatatype(%q) %v", tt.d.DialectName(), tt.in, err)
+		}
+		if got := tt.d.CanonicalDatatype(dt).String(); got != tt.want {
+			t.Errorf("%s: CanonicalDatatype(%q) = %q, expected %q", tt.d.DialectName(), tt.in, got, tt.want)
+		}
+	}
+}
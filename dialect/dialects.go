@@ -11,13 +11,38 @@ type DbDialect interface {
 	IdentQuoteChar() string
 	StringQuoteChar() string
 	MaxOperatorLength() int
+	LineCommentChars() []string
+	BlockCommentChars() (string, string)
+	NestedComments() bool
+	IsComment(s string) bool
+	IsHint(s string) bool
+	IsExecutableComment(s string) bool
+	IsStringLiteral(s string) bool
+	UnquoteString(s string) (string, error)
+	QuoteString(s string) string
+	QuoteIdentifier(s string) (string, error)
+	UnquoteIdentifier(s string) (string, error)
+	FormatIdentifier(s string) (string, error)
+	IsNumericLiteral(s string) bool
+	ParseNumericLiteral(s string) (NumericLiteral, error)
+	stringForms() []stringForm
+	numberForm() numberForm
+	identQuotes() []identQuote
 	IsDatatype(s ...string) bool
+	ParseDatatype(tokens ...string) (Datatype, error)
+	parseRules() datatypeRules
+	IsDatatypePart(s string) bool
+	DatatypeInfo(name string) (DatatypeInfo, bool)
+	Datatypes() []DatatypeInfo
+	CanonicalDatatype(t Datatype) Datatype
 	keyword(s string) (bool, bool)
 	IsKeyword(s string) bool
 	IsReservedKeyword(s string) bool
 	IsOperator(s string) bool
 	IsLabel(s string) bool
 	IsIdentifier(s string) bool
+	PlaceholderStyles() []int
+	IsPlaceholder(s string) bool
 }
 
 func StrToDialect(v string) int {
@@ -56,7 +81,9 @@ func NewDialect(v string) DbDialect {
 	case Oracle:
 		return NewOracleDialect()
 	case PostgreSQL:
-		return NewPostgreSQLDialect()
+		// a pointer so that RegisterDatatype can be used
+		d := NewPostgreSQLDialect()
+		return &d
 	case SQLite:
 		return NewSQLiteDialect()
 	}
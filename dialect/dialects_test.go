@@ -0,0 +1,37 @@
+package dialect
+
+import "reflect"
+
+// testDialects returns one of each of the supported dialects
+func testDialects() []DbDialect {
+	return []DbDialect{
+		NewStandardSQLDialect(),
+		NewPostgreSQLDialect(),
+		NewSQLiteDialect(),
+		NewMySQLDialect(),
+		NewMariaDBDialect(),
+		NewOracleDialect(),
+		NewMSSQLDialect(),
+		NewMSAccessDialect(),
+	}
+}
+
+// equalInts returns true if the supplied slices hold the same values
+func equalInts(a, b []int) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalArgs returns true if the supplied arguments are the same, nil
+// and empty argument lists are considered equal
+func equalArgs(a, b []any) bool {
+	return reflect.DeepEqual(a, b) || len(a) == 0 && len(b) == 0
+}
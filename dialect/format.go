@@ -0,0 +1,166 @@
+package dialect
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatLiteral returns the supplied Go value rendered as a literal
+// that is valid in the supplied dialect. Supported values are nil,
+// booleans, integers, floats, strings, byte slices, time.Time, values
+// that implement driver.Valuer (such as the sql.Null* types) and
+// pointers to any of these.
+//
+// Times are rendered using their wall clock time (the location is not
+// included in the literal). MSSQL times are truncated to milliseconds
+// so that they are valid for datetime as well as datetime2 columns.
+func FormatLiteral(d DbDialect, v any) (string, error) {
+
+	switch t := v.(type) {
+	case nil:
+		return "NULL", nil
+	case time.Time:
+		return formatTime(d, t), nil
+	case []byte:
+		if t == nil {
+			return "NULL", nil
+		}
+		return formatBytes(d, t)
+	case driver.Valuer:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return "NULL", nil
+		}
+		dv, err := t.Value()
+		if err != nil {
+			return "", err
+		}
+		return FormatLiteral(d, dv)
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return "NULL", nil
+		}
+		return FormatLiteral(d, rv.Elem().Interface())
+	case reflect.Bool:
+		return formatBool(d, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return formatFloat(d, rv.Float(), rv.Type().Bits())
+	case reflect.String:
+		return d.QuoteString(rv.String()), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return FormatLiteral(d, rv.Bytes())
+		}
+	}
+
+	return "", fmt.Errorf("cannot format %T as a literal for %s", v, d.DialectName())
+}
+
+// formatBool returns the boolean literal for the supplied value
+func formatBool(d DbDialect, b bool) string {
+
+	switch d.Dialect() {
+	case SQLite, MSSQL, Oracle:
+		// no boolean literals (or not in all supported versions)
+		if b {
+			return "1"
+		}
+		return "0"
+	case MSAccess:
+		if b {
+			return "True"
+		}
+		return "False"
+	}
+
+	if b {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+// formatFloat returns the numeric literal for the supplied value
+func formatFloat(d DbDialect, f float64, bits int) (string, error) {
+
+	if !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return strconv.FormatFloat(f, 'g', -1, bits), nil
+	}
+
+	switch d.Dialect() {
+	case PostgreSQL:
+		switch {
+		case math.IsNaN(f):
+			return "'NaN'::float8", nil
+		case f > 0:
+			return "'Infinity'::float8", nil
+		}
+		return "'-Infinity'::float8", nil
+	case Oracle:
+		switch {
+		case math.IsNaN(f):
+			return "BINARY_DOUBLE_NAN", nil
+		case f > 0:
+			return "BINARY_DOUBLE_INFINITY", nil
+		}
+		return "-BINARY_DOUBLE_INFINITY", nil
+	}
+
+	return "", fmt.Errorf("cannot format %v as a literal for %s", f, d.DialectName())
+}
+
+// formatBytes returns the binary string literal for the supplied bytes
+func formatBytes(d DbDialect, b []byte) (string, error) {
+
+	h := strings.ToUpper(hex.EncodeToString(b))
+
+	switch d.Dialect() {
+	case PostgreSQL:
+		return `'\x` + h + "'::bytea", nil
+	case Oracle:
+		return "HEXTORAW('" + h + "')", nil
+	case MSSQL:
+		return "0x" + h, nil
+	case MSAccess:
+		return "", fmt.Errorf("cannot format binary data as a literal for %s", d.DialectName())
+	}
+
+	return "X'" + h + "'", nil
+}
+
+// formatTime returns the timestamp (or date) literal for the supplied
+// time
+func formatTime(d DbDialect, t time.Time) string {
+
+	switch d.Dialect() {
+	case MSAccess:
+		if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+			return t.Format("#2006-01-02#")
+		}
+		return t.Format("#2006-01-02 15:04:05#")
+	case MSSQL:
+		// the ISO 8601 form is not affected by the language settings.
+		// Only milliseconds are included as datetime columns reject
+		// any further fractional digits
+		return "'" + t.Format("2006-01-02T15:04:05.999") + "'"
+	case SQLite:
+		return "'" + t.Format("2006-01-02 15:04:05.999999999") + "'"
+	case MySQL, MariaDB:
+		return "TIMESTAMP '" + t.Format("2006-01-02 15:04:05.999999") + "'"
+	}
+
+	return "TIMESTAMP '" + t.Format("2006-01-02 15:04:05.999999999") + "'"
+}
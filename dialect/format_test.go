@@ -0,0 +1,42 @@
+package dialect
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatLiteral(t *testing.T) {
+
+	ts := time.Date(2024, 1, 31, 13, 4, 5, 123456789, time.UTC)
+	day := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		d    DbDialect
+		v    any
+		want string
+	}{
+		{NewMSAccessDialect(), day, "#2024-01-31#"},
+		{NewMSAccessDialect(), ts, "#2024-01-31 13:04:05#"},
+		{NewMSSQLDialect(), ts, "'2024-01-31T13:04:05.123'"},
+		{NewPostgreSQLDialect(), ts, "TIMESTAMP '2024-01-31 13:04:05.123456789'"},
+		{NewMySQLDialect(), ts, "TIMESTAMP '2024-01-31 13:04:05.123456'"},
+		{NewOracleDialect(), true, "1"},
+		{NewPostgreSQLDialect(), "it's", "'it''s'"},
+		{NewPostgreSQLDialect(), []byte{0x0a}, `'\x0A'::bytea`},
+		{NewStandardSQLDialect(), nil, "NULL"},
+	}
+
+	for _, tt := range tests {
+		got, err := FormatLiteral(tt.d, tt.v)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: FormatLiteral(%v) = %s %v, expected %s", tt.d.DialectName(), tt.v, got, err, tt.want)
+			continue
+		}
+
+		// The literal must be a single token for the lexer of the dialect
+		tokens := Tokenize(tt.d, got)
+		if tt.d.Dialect() == MSAccess && (len(tokens) != 1 || tokens[0].Type != StringToken) {
+			t.Errorf("%s: literal %s is %d tokens", tt.d.DialectName(), got, len(tokens))
+		}
+	}
+}
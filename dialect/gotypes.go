@@ -0,0 +1,128 @@
+package dialect
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// goType is the Go type for a generic kind of datatype, for columns
+// that are not null and for columns that are nullable
+type goType struct {
+	notNull  reflect.Type
+	nullable reflect.Type
+}
+
+// Go types that are used for a number of kinds of datatype
+var (
+	int64GoType   = goType{reflect.TypeOf(int64(0)), reflect.TypeOf(sql.NullInt64{})}
+	float64GoType = goType{reflect.TypeOf(float64(0)), reflect.TypeOf(sql.NullFloat64{})}
+	stringGoType  = goType{reflect.TypeOf(""), reflect.TypeOf(sql.NullString{})}
+	bytesGoType   = goType{reflect.TypeOf([]byte(nil)), reflect.TypeOf([]byte(nil))}
+	timeGoType    = goType{reflect.TypeOf(time.Time{}), reflect.TypeOf(sql.NullTime{})}
+	ratGoType     = goType{reflect.TypeOf((*big.Rat)(nil)), reflect.TypeOf((*big.Rat)(nil))}
+	uint64GoType  = goType{reflect.TypeOf(uint64(0)), reflect.TypeOf((*uint64)(nil))}
+)
+
+// kindGoTypes maps the generic kinds of datatype to Go types
+var kindGoTypes = map[int]goType{
+	booleanKind:     {reflect.TypeOf(false), reflect.TypeOf(sql.NullBool{})},
+	tinyintKind:     int64GoType,
+	utinyintKind:    int64GoType,
+	smallintKind:    int64GoType,
+	integerKind:     int64GoType,
+	bigintKind:      int64GoType,
+	decimalKind:     ratGoType,
+	realKind:        float64GoType,
+	doubleKind:      float64GoType,
+	charKind:        stringGoType,
+	varcharKind:     stringGoType,
+	ncharKind:       stringGoType,
+	nvarcharKind:    stringGoType,
+	textKind:        stringGoType,
+	ntextKind:       stringGoType,
+	binaryKind:      bytesGoType,
+	varbinaryKind:   bytesGoType,
+	blobKind:        bytesGoType,
+	bitKind:         bytesGoType,
+	dateKind:        timeGoType,
+	timeKind:        stringGoType,
+	timeTZKind:      stringGoType,
+	timestampKind:   timeGoType,
+	timestampTZKind: timeGoType,
+	intervalKind:    stringGoType,
+	intervalYMKind:  stringGoType,
+	intervalDSKind:  stringGoType,
+	uuidKind:        {reflect.TypeOf([16]byte{}), reflect.TypeOf((*[16]byte)(nil))},
+	jsonKind:        {reflect.TypeOf(json.RawMessage(nil)), reflect.TypeOf(json.RawMessage(nil))},
+	xmlKind:         stringGoType,
+	geometryKind:    bytesGoType,
+	geographyKind:   bytesGoType,
+	enumKind:        stringGoType,
+	setKind:         stringGoType,
+}
+
+// categoryGoTypes maps datatype categories to Go types, for the
+// datatypes that have no generic kind (such as PostgreSQL inet)
+var categoryGoTypes = map[int]goType{
+	CharacterCategory: stringGoType,
+	BinaryCategory:    bytesGoType,
+	LOBCategory:       bytesGoType,
+	SpatialCategory:   bytesGoType,
+	XMLCategory:       stringGoType,
+	OtherCategory:     stringGoType,
+}
+
+// GoType returns the recommended Go type for scanning and binding
+// values of the supplied datatype (as returned by the ParseDatatype
+// method of the dialect). Nullable columns get the matching sql.Null*
+// type, or a pointer or slice type that is nil for NULL.
+//
+// Integers map to int64 (uint64 for unsigned bigints), numerics that
+// may not fit in an int64 or that have decimal places map to *big.Rat,
+// character types to string, binary types to []byte, dates and
+// timestamps to time.Time and uuids to [16]byte. The MSSQL
+// uniqueidentifier maps to []byte as the driver returns the 16 bytes
+// in the SQL Server (mixed endian) order. Time of day and interval
+// types map to string as drivers differ in how they return them.
+//
+// PostgreSQL arrays map to slices of the element type for both null
+// and not null columns. These can not be scanned by database/sql
+// directly, they need a driver specific wrapper such as pq.Array.
+func GoType(d DbDialect, t Datatype, nullable bool) (reflect.Type, error) {
+
+	kind, g := genericType(d.Dialect(), t)
+
+	gt, ok := kindGoTypes[kind]
+	switch {
+	case !ok:
+		i, _ := d.DatatypeInfo(t.Name)
+		gt, ok = categoryGoTypes[i.Category]
+		if !ok {
+			return nil, fmt.Errorf("no Go type for %s datatype %q", d.DialectName(), t.String())
+		}
+	case kind == uuidKind && d.Dialect() == MSSQL:
+		gt = bytesGoType
+	case kind == bigintKind && g.Unsigned:
+		gt = uint64GoType
+	case kind == decimalKind && g.HasPrecision && g.Scale <= 0 && g.Precision-g.Scale <= 18:
+		gt = int64GoType
+	}
+
+	if len(t.ArrayDims) > 0 {
+		rt := gt.notNull
+		for range t.ArrayDims {
+			rt = reflect.SliceOf(rt)
+		}
+		return rt, nil
+	}
+
+	if nullable {
+		return gt.nullable, nil
+	}
+
+	return gt.notNull, nil
+}
@@ -0,0 +1,63 @@
+package dialect
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGoType(t *testing.T) {
+
+	pg := NewPostgreSQLDialect()
+
+	tests := []struct {
+		d                 DbDialect
+		in                string
+		notNull, nullable reflect.Type
+	}{
+		{pg, "integer", reflect.TypeOf(int64(0)), reflect.TypeOf(sql.NullInt64{})},
+		{pg, "numeric(10)", reflect.TypeOf(int64(0)), reflect.TypeOf(sql.NullInt64{})},
+		{pg, "numeric(10,2)", reflect.TypeOf((*big.Rat)(nil)), reflect.TypeOf((*big.Rat)(nil))},
+		{pg, "numeric(5,-2)", reflect.TypeOf(int64(0)), reflect.TypeOf(sql.NullInt64{})},
+		{pg, "numeric(18,-1)", reflect.TypeOf((*big.Rat)(nil)), reflect.TypeOf((*big.Rat)(nil))},
+		{pg, "float(10)", reflect.TypeOf(float64(0)), reflect.TypeOf(sql.NullFloat64{})},
+		{pg, "text", reflect.TypeOf(""), reflect.TypeOf(sql.NullString{})},
+		{pg, "inet", reflect.TypeOf(""), reflect.TypeOf(sql.NullString{})},
+		{pg, "bytea", reflect.TypeOf([]byte(nil)), reflect.TypeOf([]byte(nil))},
+		{pg, "uuid", reflect.TypeOf([16]byte{}), reflect.TypeOf((*[16]byte)(nil))},
+		{pg, "timestamptz", reflect.TypeOf(time.Time{}), reflect.TypeOf(sql.NullTime{})},
+		{pg, "time", reflect.TypeOf(""), reflect.TypeOf(sql.NullString{})},
+		{pg, "boolean", reflect.TypeOf(false), reflect.TypeOf(sql.NullBool{})},
+		{pg, "double precision", reflect.TypeOf(float64(0)), reflect.TypeOf(sql.NullFloat64{})},
+		{pg, "jsonb", reflect.TypeOf(json.RawMessage(nil)), reflect.TypeOf(json.RawMessage(nil))},
+		{pg, "uuid[]", reflect.TypeOf([][16]byte(nil)), reflect.TypeOf([][16]byte(nil))},
+		{pg, "numeric(38)", reflect.TypeOf((*big.Rat)(nil)), reflect.TypeOf((*big.Rat)(nil))},
+		{pg, "text[][]", reflect.TypeOf([][]string(nil)), reflect.TypeOf([][]string(nil))},
+		{NewMySQLDialect(), "tinyint(1)", reflect.TypeOf(false), reflect.TypeOf(sql.NullBool{})},
+		{NewMySQLDialect(), "bigint unsigned", reflect.TypeOf(uint64(0)), reflect.TypeOf((*uint64)(nil))},
+		{NewMySQLDialect(), "int unsigned", reflect.TypeOf(int64(0)), reflect.TypeOf(sql.NullInt64{})},
+		{NewMSSQLDialect(), "uniqueidentifier", reflect.TypeOf([]byte(nil)), reflect.TypeOf([]byte(nil))},
+		{NewOracleDialect(), "number", reflect.TypeOf((*big.Rat)(nil)), reflect.TypeOf((*big.Rat)(nil))},
+		{NewMSSQLDialect(), "bit", reflect.TypeOf(false), reflect.TypeOf(sql.NullBool{})},
+	}
+
+	for _, tt := range tests {
+		dt, err := tt.d.ParseDatatype(tt.in)
+		if err != nil {
+			t.Fatalf("%s: ParseDatatype(%q) %v", tt.d.DialectName(), tt.in, err)
+		}
+		for _, nullable := range []bool{false, true} {
+			want := tt.notNull
+			if nullable {
+				want = tt.nullable
+			}
+			got, err := GoType(tt.d, dt, nullable)
+			if err != nil || got != want {
+				t.Errorf("%s: GoType(%q, %v) = %v %v, expected %v", tt.d.DialectName(), tt.in, nullable, got, err, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+)
+
+// identQuote is a pair of characters that may be used to delimit a
+// quoted identifier. When doubled is set embedded closing characters
+// are escaped by doubling them, otherwise the identifier ends at the
+// first closing character (as for SQLite and MSAccess [...])
+type identQuote struct {
+	open    byte
+	close   byte
+	doubled bool
+}
+
+// quoteIdentifier returns the supplied identifier quoted using the
+// supplied quote characters. Identifiers can't contain the closing
+// character of a form that doesn't allow doubling so that is an error
+func quoteIdentifier(q identQuote, s string) (string, error) {
+
+	c := string(q.close)
+	if !q.doubled {
+		if strings.Contains(s, c) {
+			return "", fmt.Errorf("identifier %q can't be quoted, it contains %s", s, c)
+		}
+		return string(q.open) + s + c, nil
+	}
+	return string(q.open) + strings.ReplaceAll(s, c, c+c) + c, nil
+}
+
+// unquoteIdentifier returns the supplied quoted identifier with the
+// enclosing quotes removed and any doubled closing quote characters
+// reduced to a single character
+func unquoteIdentifier(quotes []identQuote, s string) (string, error) {
+
+	for _, q := range quotes {
+		if len(s) < 2 || s[0] != q.open || s[len(s)-1] != q.close {
+			continue
+		}
+
+		c := string(q.close)
+		body := s[1 : len(s)-1]
+		if !q.doubled {
+			if strings.Contains(body, c) {
+				return "", fmt.Errorf("unexpected quote in identifier %s", s)
+			}
+			return body, nil
+		}
+		if strings.Count(body, c)%2 != 0 || strings.Count(body, c+c)*2 != strings.Count(body, c) {
+			return "", fmt.Errorf("unescaped quote in identifier %s", s)
+		}
+		return strings.ReplaceAll(body, c+c, c), nil
+	}
+
+	return "", fmt.Errorf("%s is not a quoted identifier", s)
+}
+
+// formatIdentifier returns the supplied identifier unquoted if it is a
+// valid identifier that is not a reserved keyword and that is not
+// changed by the case folding of the dialect. Otherwise the identifier
+// is quoted
+func formatIdentifier(d DbDialect, s string) (string, error) {
+
+	// IsIdentifier allows for qualified names but the supplied name is
+	// a single identifier so any "." needs quoting
+	if s == "" || strings.Contains(s, ".") {
+		return d.QuoteIdentifier(s)
+	}
+
+	if !d.IsIdentifier(s) || d.IsReservedKeyword(s) {
+		return d.QuoteIdentifier(s)
+	}
+
+	switch d.CaseFolding() {
+	case FoldLower:
+		if s != strings.ToLower(s) {
+			return d.QuoteIdentifier(s)
+		}
+	case FoldUpper:
+		if s != strings.ToUpper(s) {
+			return d.QuoteIdentifier(s)
+		}
+	}
+
+	return s, nil
+}
+
+// matchIdentQuote returns the index of the identifier quote form that
+// starts with the supplied character, or -1 if there is none
+func matchIdentQuote(quotes []identQuote, c byte) int {
+
+	for i, q := range quotes {
+		if q.open == c {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,93 @@
+package dialect
+
+import "testing"
+
+func TestIdentifierQuoting(t *testing.T) {
+
+	for _, d := range testDialects() {
+		for _, s := range []string{"a", "a b", "select", "x\"y", "x`y", "é"} {
+			q, err := d.QuoteIdentifier(s)
+			if err != nil {
+				t.Errorf("%s: QuoteIdentifier(%q) %v", d.DialectName(), s, err)
+				continue
+			}
+			got, err := d.UnquoteIdentifier(q)
+			if err != nil || got != s {
+				t.Errorf("%s: UnquoteIdentifier(QuoteIdentifier(%q)) = %q %v", d.DialectName(), s, got, err)
+			}
+		}
+	}
+
+	quoted := []struct {
+		d       DbDialect
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{NewMSSQLDialect(), "a]b", "[a]]b]", false},
+		{NewMySQLDialect(), "a`b", "`a``b`", false},
+		{NewPostgreSQLDialect(), `a"b`, `"a""b"`, false},
+		{NewSQLiteDialect(), "a]b", `"a]b"`, false},
+		{NewMSAccessDialect(), "a b", "[a b]", false},
+		{NewMSAccessDialect(), "a]b", "", true},
+	}
+
+	for _, tt := range quoted {
+		got, err := tt.d.QuoteIdentifier(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("%s: QuoteIdentifier(%q) = %q %v, expected %q", tt.d.DialectName(), tt.in, got, err, tt.want)
+		}
+	}
+
+	tests := []struct {
+		d       DbDialect
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{NewMSSQLDialect(), "[a]]b]", "a]b", false},
+		{NewMSSQLDialect(), `"a""b"`, `a"b`, false},
+		{NewSQLiteDialect(), "[a]]b]", "", true},
+		{NewSQLiteDialect(), "[a b]", "a b", false},
+		{NewMSAccessDialect(), "[a]]b]", "", true},
+		{NewMySQLDialect(), "`a``b`", "a`b", false},
+		{NewPostgreSQLDialect(), `"a"b"`, "", true},
+		{NewPostgreSQLDialect(), "a", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.d.UnquoteIdentifier(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("%s: UnquoteIdentifier(%q) = %q %v", tt.d.DialectName(), tt.in, got, err)
+		}
+	}
+}
+
+func TestFormatIdentifier(t *testing.T) {
+
+	tests := []struct {
+		d    DbDialect
+		in   []string
+		want []string
+	}{
+		{NewPostgreSQLDialect(), []string{"user_id", "user", "User", "a b", "a.b", ""}, []string{"user_id", `"user"`, `"User"`, `"a b"`, `"a.b"`, `""`}},
+		{NewOracleDialect(), []string{"USER_ID", "user_id", "select"}, []string{"USER_ID", `"user_id"`, `"select"`}},
+		{NewMySQLDialect(), []string{"user_id", "User", "a`b"}, []string{"user_id", "User", "`a``b`"}},
+		{NewMariaDBDialect(), []string{"user_id", "from", "select", "User"}, []string{"user_id", "`from`", "`select`", "User"}},
+		{NewMSSQLDialect(), []string{"user_id", "user", "a]b"}, []string{"user_id", "[user]", "[a]]b]"}},
+		{NewSQLiteDialect(), []string{"user_id", "a b"}, []string{"user_id", `"a b"`}},
+		{NewMSAccessDialect(), []string{"user_id", "select"}, []string{"user_id", "[select]"}},
+	}
+
+	for _, tt := range tests {
+		for i, s := range tt.in {
+			if got, err := tt.d.FormatIdentifier(s); err != nil || got != tt.want[i] {
+				t.Errorf("%s: FormatIdentifier(%q) = %s %v, expected %s", tt.d.DialectName(), s, got, err, tt.want[i])
+			}
+		}
+	}
+
+	if got, err := NewMSAccessDialect().FormatIdentifier("a]b"); err == nil {
+		t.Errorf("MSAccess: FormatIdentifier(a]b) = %s, expected an error", got)
+	}
+}
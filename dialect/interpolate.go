@@ -0,0 +1,93 @@
+package dialect
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DebugInterpolate returns the supplied query with the bind parameter
+// placeholders replaced by the matching arguments formatted as literals
+// (see FormatLiteral). Placeholders are located using the lexing rules
+// of the supplied dialect.
+//
+// The result is for display only, such as for logging or for pasting
+// into a SQL console. It is NOT safe to execute and is not a substitute
+// for passing the arguments to the database as bind parameters.
+//
+// Positional "?" placeholders use the next argument, numbered
+// placeholders use the numbered argument and named placeholders use the
+// sql.NamedArg with the same name. Otherwise, as for SQLite, a named
+// placeholder gets the number one more than the largest number so far
+// when it first appears and every later use of the same name reuses
+// that argument (@p1 style names use the number in the name). This
+// means that Oracle ":a ... :a" consumes a single argument, as when
+// binding by name, while ":1" always refers to the first argument.
+func DebugInterpolate(d DbDialect, query string, args []any) (string, error) {
+
+	named := make(map[string]any)
+	for _, a := range args {
+		if na, ok := a.(sql.NamedArg); ok {
+			named[na.Name] = na.Value
+		}
+	}
+
+	tokens := Tokenize(d, query)
+	names := make(map[string]int)
+	last := -1
+
+	for i, t := range tokens {
+		if t.Type != PlaceholderToken {
+			continue
+		}
+
+		idx := -1
+		switch placeholderStyle(d, t.Value) {
+		case QuestionPlaceholder:
+			// as for SQLite, "?" is one more than the largest number so far
+			idx = last + 1
+		case QuestionNumberPlaceholder, DollarNumberPlaceholder, ColonNumberPlaceholder:
+			n, _ := strconv.Atoi(t.Value[1:])
+			idx = n - 1
+		default:
+			name := t.Value[1:]
+			if v, ok := named[name]; ok {
+				s, err := FormatLiteral(d, v)
+				if err != nil {
+					return "", err
+				}
+				tokens[i].Value = s
+				continue
+			}
+			if n, err := strconv.Atoi(strings.TrimPrefix(name, "p")); err == nil && strings.HasPrefix(name, "p") {
+				idx = n - 1
+				break
+			}
+			if _, ok := names[name]; !ok {
+				names[name] = last + 1
+			}
+			idx = names[name]
+		}
+
+		if idx < 0 || idx >= len(args) {
+			return "", fmt.Errorf("no argument for placeholder %q at %s", t.Value, t.Start)
+		}
+		if idx > last {
+			last = idx
+		}
+
+		v := args[idx]
+		if na, ok := v.(sql.NamedArg); ok {
+			v = na.Value
+		}
+
+		s, err := FormatLiteral(d, v)
+		if err != nil {
+			return "", err
+		}
+		tokens[i].Value = s
+	}
+
+	return JoinTokens(tokens), nil
+}
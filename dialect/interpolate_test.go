@@ -0,0 +1,36 @@
+package dialect
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestDebugInterpolate(t *testing.T) {
+
+	ora := NewOracleDialect()
+
+	tests := []struct {
+		d       DbDialect
+		query   string
+		args    []any
+		want    string
+		wantErr bool
+	}{
+		{ora, "select :a, :a, :b from dual", []any{1, 2}, "select 1, 1, 2 from dual", false},
+		{ora, "select :2, :1 from dual", []any{1, 2}, "select 2, 1 from dual", false},
+		{ora, "select :1, :a from dual", []any{1, 2}, "select 1, 2 from dual", false},
+		{ora, "select :a, :b from dual", []any{sql.Named("b", "x"), 1}, "select 'x', 'x' from dual", false},
+		{ora, "select :a, :b from dual", []any{1}, "", true},
+		{NewSQLiteDialect(), "select :a, ?, :b, :a", []any{1, 2, 3}, "select 1, 2, 3, 1", false},
+		{NewPostgreSQLDialect(), "select $2, '$1', $1", []any{"a", nil}, "select NULL, '$1', 'a'", false},
+		{NewMSSQLDialect(), "select @p2, @p1", []any{1, 2}, "select 2, 1", false},
+		{NewMySQLDialect(), "select ?, ?", []any{1}, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := DebugInterpolate(tt.d, tt.query, tt.args)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("%s: DebugInterpolate(%q) = %q %v, expected %q", tt.d.DialectName(), tt.query, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,723 @@
+package dialect
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Position identifies a location in the SQL being tokenized
+type Position struct {
+	Offset int // byte offset, starting at 0
+	Line   int // line number, starting at 1
+	Column int // column number in characters, starting at 1
+}
+
+// String returns the line and column of the position
+func (p Position) String() string {
+	return fmt.Sprintf("line %d col %d", p.Line, p.Column)
+}
+
+// Token is a single lexical element of a SQL script. Value is the raw
+// text of the token as found in the source, Start is the position of
+// the first character of the token and End is the position
+// immediately following the last character of the token
+type Token struct {
+	Type  int
+	Value string
+	Start Position
+	End   Position
+}
+
+// TypeName returns the name of the token type
+func (t Token) TypeName() string {
+
+	switch t.Type {
+	case WhitespaceToken:
+		return "Whitespace"
+	case CommentToken:
+		return "Comment"
+	case KeywordToken:
+		return "Keyword"
+	case ReservedKeywordToken:
+		return "ReservedKeyword"
+	case IdentifierToken:
+		return "Identifier"
+	case QuotedIdentifierToken:
+		return "QuotedIdentifier"
+	case StringToken:
+		return "String"
+	case NumericToken:
+		return "Numeric"
+	case OperatorToken:
+		return "Operator"
+	case LabelToken:
+		return "Label"
+	case PunctuationToken:
+		return "Punctuation"
+	case PlaceholderToken:
+		return "Placeholder"
+	case HintToken:
+		return "Hint"
+	case ExecutableCommentToken:
+		return "ExecutableComment"
+	}
+	return "Other"
+}
+
+// Lexer splits SQL text into tokens using the keyword, operator,
+// label, identifier and quoting rules of a DbDialect.
+//
+// The token stream is lossless: every byte of the input, including
+// whitespace, comments, unterminated quotes and invalid UTF-8, belongs
+// to exactly one token so concatenating the Values of the tokens (see
+// JoinTokens) reproduces the input byte-for-byte.
+//
+// A Lexer created with NewReaderLexer reads its input incrementally
+// and only buffers the current token plus enough lookahead to decide
+// where it ends (which is sized from the MaxOperatorLength of the
+// dialect) so arbitrarily large scripts can be tokenized in bounded
+// memory.
+type Lexer struct {
+	d     DbDialect
+	r     io.Reader
+	err   error
+	buf   []byte
+	tok   int // start of the current token in buf
+	pos   int // current position in buf
+	start Position
+	last  byte // the last byte of the previous token
+	words map[string]int
+	ops   map[string]bool
+
+	// comment syntax of the dialect
+	lineComments []string
+	blockOpen    string
+	blockClose   string
+	nested       bool
+
+	// literal and quoted identifier forms of the dialect
+	strings []stringForm
+	numbers numberForm
+	idents  []identQuote
+}
+
+// maxLabelLength is the longest label (including the enclosing
+// "<<" and ">>") that the lexer will look for
+const maxLabelLength = 132
+
+// maxWords is the number of distinct words (and operator candidates)
+// whose classification the lexer remembers. The dialect keyword and
+// operator lookups are relatively expensive and the same words tend to
+// be seen over and over again
+const maxWords = 4096
+
+// readSize is the minimum number of bytes to request from the reader
+// of a streaming Lexer
+const readSize = 32 * 1024
+
+// NewLexer returns a Lexer for tokenizing the supplied SQL using the
+// rules of the supplied dialect
+func NewLexer(d DbDialect, sql string) *Lexer {
+	l := newLexer(d)
+	l.buf = []byte(sql)
+	return l
+}
+
+// NewReaderLexer returns a Lexer for tokenizing the SQL read from the
+// supplied reader using the rules of the supplied dialect
+func NewReaderLexer(d DbDialect, r io.Reader) *Lexer {
+	l := newLexer(d)
+	l.r = r
+	return l
+}
+
+func newLexer(d DbDialect) *Lexer {
+
+	l := Lexer{d: d, start: Position{Line: 1, Column: 1}}
+
+	l.lineComments = d.LineCommentChars()
+	l.blockOpen, l.blockClose = d.BlockCommentChars()
+	l.nested = d.NestedComments()
+	l.strings = d.stringForms()
+	l.numbers = d.numberForm()
+	l.idents = d.identQuotes()
+
+	return &l
+}
+
+// Tokenize splits the supplied SQL into tokens using the rules of the
+// supplied dialect
+func Tokenize(d DbDialect, sql string) []Token {
+
+	var tokens []Token
+
+	l := NewLexer(d, sql)
+	for {
+		t, err := l.Next()
+		if err != nil {
+			break
+		}
+		tokens = append(tokens, t)
+	}
+
+	return tokens
+}
+
+// JoinTokens returns the concatenated values of the supplied tokens.
+// For the tokens returned by Tokenize this is the original SQL
+func JoinTokens(tokens []Token) string {
+
+	var sb strings.Builder
+	for _, t := range tokens {
+		sb.WriteString(t.Value)
+	}
+
+	return sb.String()
+}
+
+// Next returns the next token from the input. Once the input has been
+// exhausted Next returns io.EOF. For a streaming Lexer any other error
+// returned by the reader is returned instead of a token
+func (l *Lexer) Next() (Token, error) {
+
+	l.tok = l.pos
+	if !l.more() {
+		if l.err != nil && l.err != io.EOF {
+			return Token{}, l.err
+		}
+		return Token{}, io.EOF
+	}
+
+	typ := l.scan()
+
+	// Guard against a scan that fails to consume anything so that the
+	// lexer always makes progress and no input is ever dropped
+	if l.pos <= l.tok {
+		l.pos = l.tok
+		l.pos += l.nextRune()
+		typ = OtherToken
+	}
+
+	if l.err != nil && l.err != io.EOF {
+		return Token{}, l.err
+	}
+
+	t := Token{Type: typ, Value: string(l.buf[l.tok:l.pos]), Start: l.start}
+	t.End = advancePosition(l.start, t.Value)
+	l.start = t.End
+	l.last = l.buf[l.pos-1]
+
+	return t, nil
+}
+
+// advancePosition returns the position that follows the supplied text
+// when that text starts at position p
+func advancePosition(p Position, s string) Position {
+
+	p.Offset += len(s)
+	for _, r := range s {
+		if r == '\n' {
+			p.Line++
+			p.Column = 1
+		} else {
+			p.Column++
+		}
+	}
+
+	return p
+}
+
+// fill attempts to make at least n bytes of unread input available,
+// reading more from the reader as needed. It returns false if the
+// input is exhausted first
+func (l *Lexer) fill(n int) bool {
+
+	for len(l.buf)-l.pos < n && l.r != nil && l.err == nil {
+
+		// Discard the tokens that have already been returned
+		if l.tok > 0 {
+			m := copy(l.buf, l.buf[l.tok:])
+			l.buf = l.buf[:m]
+			l.pos -= l.tok
+			l.tok = 0
+		}
+
+		if cap(l.buf)-len(l.buf) < readSize {
+			b := make([]byte, len(l.buf), 2*cap(l.buf)+readSize)
+			copy(b, l.buf)
+			l.buf = b
+		}
+
+		m, err := l.r.Read(l.buf[len(l.buf):cap(l.buf)])
+		l.buf = l.buf[:len(l.buf)+m]
+		l.err = err
+	}
+
+	return len(l.buf)-l.pos >= n
+}
+
+// more returns a boolean indicating if there is any unread input
+func (l *Lexer) more() bool {
+	return l.fill(1)
+}
+
+// peek returns the byte that is n bytes past the current position,
+// or 0 if that is past the end of the input
+func (l *Lexer) peek(n int) byte {
+	if !l.fill(n + 1) {
+		return 0
+	}
+	return l.buf[l.pos+n]
+}
+
+// hasPrefix returns a boolean indicating if the unread input starts
+// with the supplied string
+func (l *Lexer) hasPrefix(s string) bool {
+	if s == "" || !l.fill(len(s)) {
+		return false
+	}
+	return string(l.buf[l.pos:l.pos+len(s)]) == s
+}
+
+// lookahead returns up to n bytes of unread input
+func (l *Lexer) lookahead(n int) string {
+	if !l.fill(n) {
+		n = len(l.buf) - l.pos
+	}
+	return string(l.buf[l.pos : l.pos+n])
+}
+
+// peekAt returns the byte that is n bytes past the current position
+// and a boolean indicating if there is such a byte
+func (l *Lexer) peekAt(n int) (byte, bool) {
+	if !l.fill(n + 1) {
+		return 0, false
+	}
+	return l.buf[l.pos+n], true
+}
+
+// nextRune returns the width of the rune at the current position
+func (l *Lexer) nextRune() int {
+	l.fill(utf8.UTFMax)
+	_, w := utf8.DecodeRune(l.buf[l.pos:])
+	return w
+}
+
+// scan consumes the next token and returns the token type
+func (l *Lexer) scan() int {
+
+	c := l.peek(0)
+
+	switch {
+	case isSpace(c):
+		for isSpace(l.peek(0)) {
+			l.pos++
+		}
+		return WhitespaceToken
+	case l.scanComment():
+		s := string(l.buf[l.tok:l.pos])
+		switch {
+		case l.d.IsHint(s):
+			return HintToken
+		case l.d.IsExecutableComment(s):
+			return ExecutableCommentToken
+		}
+		return CommentToken
+	case l.scanString():
+		return StringToken
+	case l.scanQuotedIdentifier():
+		return QuotedIdentifierToken
+	case l.scanPlaceholder():
+		return PlaceholderToken
+	case l.scanNumber():
+		return NumericToken
+	}
+
+	if n := l.labelLength(); n > 0 {
+		l.pos += n
+		return LabelToken
+	}
+
+	if n := l.wordLength(); n > 0 {
+		word := string(l.buf[l.pos : l.pos+n])
+		l.pos += n
+
+		// labels of the "name:" form
+		if l.peek(0) == ':' && l.peek(1) != ':' && l.peek(1) != '=' {
+			if l.d.IsLabel(word + ":") {
+				l.pos++
+				return LabelToken
+			}
+		}
+
+		return l.wordType(word)
+	}
+
+	if n := l.operatorLength(); n > 0 {
+		l.pos += n
+		return OperatorToken
+	}
+
+	if strings.IndexByte("(),;.[]{}", c) >= 0 {
+		l.pos++
+		return PunctuationToken
+	}
+
+	l.pos += l.nextRune()
+	return OtherToken
+}
+
+// scanComment consumes the comment at the current position, if there
+// is one, and returns a boolean indicating if a comment was found. An
+// unterminated block comment runs to the end of the input
+func (l *Lexer) scanComment() bool {
+
+	for _, p := range l.lineComments {
+		if !l.hasPrefix(p) {
+			continue
+		}
+
+		n := len(p)
+		for l.fill(n+1) && l.peek(n) != '\n' && l.peek(n) != '\r' {
+			n++
+		}
+		if l.d.IsComment(string(l.buf[l.pos : l.pos+n])) {
+			l.pos += n
+			return true
+		}
+	}
+
+	if !l.hasPrefix(l.blockOpen) {
+		return false
+	}
+
+	depth := 0
+	for l.more() {
+		switch {
+		case l.hasPrefix(l.blockOpen) && (l.nested || depth == 0):
+			depth++
+			l.pos += len(l.blockOpen)
+		case l.hasPrefix(l.blockClose):
+			depth--
+			l.pos += len(l.blockClose)
+			if depth == 0 {
+				return true
+			}
+		default:
+			l.pos++
+		}
+	}
+
+	return true
+}
+
+// wordType returns the token type for an unquoted word
+func (l *Lexer) wordType(word string) int {
+
+	if typ, ok := l.words[word]; ok {
+		return typ
+	}
+	if l.words == nil || len(l.words) >= maxWords {
+		l.words = make(map[string]int)
+	}
+
+	typ := IdentifierToken
+	isKey, isReserved := l.d.keyword(word)
+
+	switch {
+	case l.isOperator(word):
+		typ = OperatorToken
+	case isReserved:
+		typ = ReservedKeywordToken
+	case isKey:
+		typ = KeywordToken
+	}
+
+	l.words[word] = typ
+	return typ
+}
+
+// scanString consumes the string literal at the current position, if
+// there is one, and returns a boolean indicating if a string literal
+// was found. An unterminated string literal runs to the end of the
+// input
+func (l *Lexer) scanString() bool {
+
+	f, start := matchStringForm(l.strings, l.peekAt)
+	if start == 0 {
+		return false
+	}
+
+	n, _ := scanStringLiteral(f, start, l.peekAt)
+	l.pos += n
+
+	return true
+}
+
+// scanQuotedIdentifier consumes the quoted identifier at the current
+// position, if there is one, and returns a boolean indicating if a
+// quoted identifier was found. Embedded closing quotes are escaped by
+// doubling them (for the forms that allow it) and an unterminated
+// identifier runs to the end of the input
+func (l *Lexer) scanQuotedIdentifier() bool {
+
+	i := matchIdentQuote(l.idents, l.peek(0))
+	if i < 0 {
+		return false
+	}
+
+	q := l.idents[i].close
+	l.pos++
+	for l.more() {
+		if l.peek(0) == q {
+			l.pos++
+			if !l.idents[i].doubled || l.peek(0) != q {
+				return true
+			}
+		}
+		l.pos++
+	}
+
+	return true
+}
+
+// scanPlaceholder consumes the bind parameter placeholder at the
+// current position, if there is one, and returns a boolean indicating
+// if a placeholder was found. Neither colon of a "::" cast starts a
+// placeholder
+func (l *Lexer) scanPlaceholder() bool {
+
+	switch l.peek(0) {
+	case ':':
+		if l.last == ':' || l.peek(1) == ':' {
+			return false
+		}
+	case '?', '$', '@':
+	default:
+		return false
+	}
+
+	n := 1
+	for l.fill(n + 1) {
+		l.fill(n + utf8.UTFMax)
+		r, w := utf8.DecodeRune(l.buf[l.pos+n:])
+		if !isWordRune(r) {
+			break
+		}
+		n += w
+	}
+
+	s := l.lookahead(n)
+	for ; n > 0; n-- {
+		if l.d.IsPlaceholder(s[:n]) {
+			l.pos += n
+			return true
+		}
+	}
+
+	return false
+}
+
+// scanNumber consumes the numeric literal at the current position, if
+// there is one, and returns a boolean indicating if a numeric literal
+// was found
+func (l *Lexer) scanNumber() bool {
+
+	c := l.peek(0)
+	if !isDigit(c) && c != '.' && c != '$' {
+		return false
+	}
+
+	// Gather the run of characters that could be part of a number and
+	// then find the longest numeric literal in that run
+	n := 0
+	for l.fill(n + 1) {
+		c := l.peek(n)
+		if isDigit(c) || isAlpha(c) || c == '_' || c == '.' || c == '$' {
+			n++
+			continue
+		}
+		if (c == '+' || c == '-') && n > 0 && (l.peek(n-1) == 'e' || l.peek(n-1) == 'E') {
+			n++
+			continue
+		}
+		break
+	}
+
+	m := numericLength(l.numbers, l.lookahead(n))
+	if m == 0 {
+		return false
+	}
+
+	// Some dialects allow unquoted identifiers to start with digits
+	// (MySQL for one) in which case a longer identifier wins
+	if isDigit(c) && l.wordLength() > m {
+		return false
+	}
+
+	l.pos += m
+	return true
+}
+
+// labelLength returns the length of the "<<name>>" style label at the
+// current position, or 0 if there is no such label
+func (l *Lexer) labelLength() int {
+
+	if !l.hasPrefix("<<") {
+		return 0
+	}
+
+	s := l.lookahead(maxLabelLength)
+	idx := strings.Index(s, ">>")
+	if idx < 0 {
+		return 0
+	}
+
+	if l.d.IsLabel(s[:idx+2]) {
+		return idx + 2
+	}
+	return 0
+}
+
+// wordLength returns the length of the longest unquoted identifier
+// (or keyword) at the current position. Qualified names are not
+// joined; the separating periods are returned as punctuation
+func (l *Lexer) wordLength() int {
+
+	// Consider the run of characters that could be part of a word, it
+	// is usually an identifier as a whole so that the dialect only needs
+	// to check it once
+	n := 0
+	for l.fill(n + 1) {
+		l.fill(n + utf8.UTFMax)
+		r, w := utf8.DecodeRune(l.buf[l.pos+n:])
+		if !isWordRune(r) {
+			break
+		}
+		n += w
+	}
+	if n == 0 || l.isIdentifier(n) {
+		return n
+	}
+
+	// Otherwise keep the longest prefix that is an identifier. The
+	// dialects only restrict the first character and the characters
+	// that may follow it (and MySQL names may not be all digits) so the
+	// prefixes that are identifiers are contiguous: skip any leading
+	// digits, then binary search for the last prefix that is one
+	lo := l.nextRuneAt(0)
+	for lo < n && !l.isIdentifier(lo) {
+		if !isDigit(l.buf[l.pos+lo-1]) {
+			return 0
+		}
+		lo += l.nextRuneAt(lo)
+	}
+	if lo >= n {
+		return 0
+	}
+
+	hi := n // isIdentifier(lo) is true, isIdentifier(hi) is false
+	for {
+		mid := lo + (hi-lo)/2
+		for mid > lo && !utf8.RuneStart(l.buf[l.pos+mid]) {
+			mid--
+		}
+		if mid == lo {
+			return lo
+		}
+		if l.isIdentifier(mid) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+}
+
+// isIdentifier returns a boolean indicating if the next n bytes are an
+// identifier for the dialect
+func (l *Lexer) isIdentifier(n int) bool {
+	return l.d.IsIdentifier(string(l.buf[l.pos : l.pos+n]))
+}
+
+// nextRuneAt returns the width of the rune n bytes past the current
+// position
+func (l *Lexer) nextRuneAt(n int) int {
+	_, w := utf8.DecodeRune(l.buf[l.pos+n:])
+	return w
+}
+
+// operatorLength returns the length of the longest operator at the
+// current position, or 0 if there is no operator
+func (l *Lexer) operatorLength() int {
+
+	// Only consider runs of symbol characters, there is no point in
+	// asking the dialect about anything containing letters, digits,
+	// quotes or whitespace
+	s := l.lookahead(l.d.MaxOperatorLength())
+	for i := 0; i < len(s); i++ {
+		if !isSymbol(s[i]) {
+			s = s[:i]
+			break
+		}
+	}
+
+	for n := len(s); n > 0; n-- {
+		if l.isOperator(s[:n]) {
+			return n
+		}
+	}
+
+	return 0
+}
+
+// isOperator returns the (remembered) result of the dialect IsOperator
+// check for the supplied string
+func (l *Lexer) isOperator(s string) bool {
+
+	if ok, seen := l.ops[s]; seen {
+		return ok
+	}
+	if l.ops == nil || len(l.ops) >= maxWords {
+		l.ops = make(map[string]bool)
+	}
+
+	ok := l.d.IsOperator(s)
+	l.ops[s] = ok
+	return ok
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\f', '\v':
+		return true
+	}
+	return false
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isWordRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case r == '_', r == '$', r == '#', r == '@':
+		return true
+	}
+	return r >= 0x80 && r != utf8.RuneError
+}
+
+func isSymbol(c byte) bool {
+	switch {
+	case c == 0, isSpace(c), isDigit(c):
+		return false
+	case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return false
+	case c == '_', c == '\'', c == '"':
+		return false
+	}
+	return true
+}
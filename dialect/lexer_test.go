@@ -0,0 +1,226 @@
+package dialect
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// lexerCorpus is SQL that exercises the tokenizing rules of the
+// dialects, including input that is invalid for some (or all) of them
+var lexerCorpus = []string{
+	"",
+	" ",
+	"select 1",
+	"SELECT a, b.c, \"d\"\"e\" FROM t WHERE x = 'it''s' AND y <> 1.5e-3;\n",
+	"select * from t -- trailing comment",
+	"select /* block */ 1 /* nested /* comment */ still */ from dual",
+	"select /*+ index(t) */ * from t",
+	"/*!50001 select 1 */ # mysql comment\nselect `a``b` from `t`",
+	"select [a]]b], [c d], N'unicode' from [t]",
+	"select $$dollar ' quoted$$, $tag$body$tag$, E'esc\\'aped' from t",
+	"select q'[it's]', nq'{x}', x'0A', b'101', 0x1F from dual",
+	"<<lbl>> begin x := :1; goto lbl; end;",
+	"select a::int, b[1:2], c ->> 'k', d @> e from t where f = $1 and g = ?",
+	"select :name, @name, ?1, :1 from t",
+	"select #2024-01-02#, 'unterminated",
+	"select \"unterminated",
+	"select [unterminated",
+	"/* unterminated",
+	"select 'line1\nline2'\r\nfrom\tt\f\v",
+	"select café, naïve from t",
+	"select \xff\xfe invalid \xc3 utf8",
+	"1.2.3 .5 5. 1e 1e+ 0x 0b2 123abc $5 $ @ ? : ::",
+	"!@#$%^&*()-=+[]{}|;:,.<>/?~`",
+}
+
+// lexerFragments are the building blocks for the random SQL used by
+// the round trip tests
+var lexerFragments = []string{
+	" ", "\n", "\t", "select", "from", "x", "_y1", "'", "''", "\"", "`",
+	"[", "]", "(", ")", ",", ";", ".", ":", "::", ":=", "?", "$", "$$",
+	"@", "#", "--", "/*", "*/", "/*+", "/*!", "<<", ">>", "1", "2.5",
+	"e", "-", "+", "*", "/", "=", "<>", "N'", "q'[", "E'", "\\", "\r",
+	"é", "\xff",
+}
+
+func TestJoinTokensRoundTrip(t *testing.T) {
+
+	rnd := rand.New(rand.NewSource(1))
+	inputs := append([]string(nil), lexerCorpus...)
+	for i := 0; i < 500; i++ {
+		var sb strings.Builder
+		for j := rnd.Intn(20); j >= 0; j-- {
+			sb.WriteString(lexerFragments[rnd.Intn(len(lexerFragments))])
+		}
+		inputs = append(inputs, sb.String())
+	}
+
+	for _, d := range testDialects() {
+		for _, s := range inputs {
+			tokens := Tokenize(d, s)
+			if got := JoinTokens(tokens); got != s {
+				t.Errorf("%s: JoinTokens(Tokenize(%q)) = %q", d.DialectName(), s, got)
+				continue
+			}
+
+			// The positions must be contiguous and match the values
+			p := Position{Line: 1, Column: 1}
+			for _, tk := range tokens {
+				if tk.Value == "" {
+					t.Errorf("%s: empty token in %q", d.DialectName(), s)
+				}
+				if tk.Start != p || tk.End != advancePosition(p, tk.Value) {
+					t.Errorf("%s: token %q of %q at %v-%v, expected start %v", d.DialectName(), tk.Value, s, tk.Start, tk.End, p)
+				}
+				p = tk.End
+			}
+		}
+	}
+}
+
+func TestTokenPositions(t *testing.T) {
+
+	sql := "select a,\n  'x\ny' /* c\n */ from\r\n'\u00e9' t"
+
+	tests := []struct {
+		value      string
+		start, end Position
+	}{
+		{"select", Position{0, 1, 1}, Position{6, 1, 7}},
+		{",", Position{8, 1, 9}, Position{9, 1, 10}},
+		{"\n  ", Position{9, 1, 10}, Position{12, 2, 3}},
+		{"'x\ny'", Position{12, 2, 3}, Position{17, 3, 3}},
+		{"/* c\n */", Position{18, 3, 4}, Position{26, 4, 4}},
+		{"from", Position{27, 4, 5}, Position{31, 4, 9}},
+		{"\r\n", Position{31, 4, 9}, Position{33, 5, 1}},
+		{"'\u00e9'", Position{33, 5, 1}, Position{37, 5, 4}},
+		{"t", Position{38, 5, 5}, Position{39, 5, 6}},
+	}
+
+	tokens := Tokenize(NewPostgreSQLDialect(), sql)
+	for _, tt := range tests {
+		found := false
+		for _, tk := range tokens {
+			if tk.Value == tt.value {
+				found = true
+				if tk.Start != tt.start || tk.End != tt.end {
+					t.Errorf("token %q at %+v-%+v, expected %+v-%+v", tt.value, tk.Start, tk.End, tt.start, tt.end)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("no %q token in %q", tt.value, sql)
+		}
+	}
+}
+
+func TestReaderLexerParity(t *testing.T) {
+
+	// A long input forces the streaming lexer to refill its buffer
+	long := strings.Repeat(strings.Join(lexerCorpus, "\n"), 50)
+	inputs := append(append([]string(nil), lexerCorpus...), long)
+
+	for _, d := range testDialects() {
+		for _, s := range inputs {
+			want := Tokenize(d, s)
+
+			var got []Token
+			l := NewReaderLexer(d, iotest.OneByteReader(strings.NewReader(s)))
+			for {
+				tk, err := l.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("%s: unexpected error %v", d.DialectName(), err)
+				}
+				got = append(got, tk)
+			}
+
+			if len(got) != len(want) {
+				t.Errorf("%s: %d streamed tokens, expected %d for %.40q", d.DialectName(), len(got), len(want), s)
+				continue
+			}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("%s: streamed token %d is %+v, expected %+v", d.DialectName(), i, got[i], want[i])
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestReaderLexerError(t *testing.T) {
+
+	r := io.MultiReader(strings.NewReader("select 1"), iotest.ErrReader(io.ErrUnexpectedEOF))
+	l := NewReaderLexer(NewPostgreSQLDialect(), r)
+
+	var err error
+	for err == nil {
+		_, err = l.Next()
+	}
+	if err != io.ErrUnexpectedEOF {
+		t.Errorf("got error %v, expected %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestTokenTypes(t *testing.T) {
+
+	tests := []struct {
+		d     DbDialect
+		sql   string
+		types []int
+	}{
+		{NewPostgreSQLDialect(), "select $1", []int{ReservedKeywordToken, WhitespaceToken, PlaceholderToken}},
+		{NewPostgreSQLDialect(), "$$a$$", []int{StringToken}},
+		{NewOracleDialect(), "/*+ full(t) */", []int{HintToken}},
+		{NewOracleDialect(), "<<lbl>>", []int{LabelToken}},
+		{NewMySQLDialect(), "/*!50001 x */", []int{ExecutableCommentToken}},
+		{NewMySQLDialect(), "`a`", []int{QuotedIdentifierToken}},
+		{NewMSSQLDialect(), "[a]]b]", []int{QuotedIdentifierToken}},
+		{NewMSSQLDialect(), "@p", []int{PlaceholderToken}},
+		{NewSQLiteDialect(), "[a]]b]", []int{QuotedIdentifierToken, PunctuationToken, IdentifierToken, PunctuationToken}},
+		{NewMSAccessDialect(), "[a]]", []int{QuotedIdentifierToken, PunctuationToken}},
+		{NewStandardSQLDialect(), "12.5e3", []int{NumericToken}},
+	}
+
+	for _, tt := range tests {
+		tokens := Tokenize(tt.d, tt.sql)
+		var types []int
+		for _, tk := range tokens {
+			types = append(types, tk.Type)
+		}
+		if !equalInts(types, tt.types) {
+			t.Errorf("%s: Tokenize(%q) types %v, expected %v", tt.d.DialectName(), tt.sql, types, tt.types)
+		}
+	}
+}
+
+func BenchmarkReaderLexer(b *testing.B) {
+
+	// Many distinct identifiers defeat the word cache of the lexer
+	var sb strings.Builder
+	for i := 0; sb.Len() < 1<<20; i++ {
+		fmt.Fprintf(&sb, "insert into table_%d (column_name_%d, other_column_%d) values (%d, 'value %d');\n", i%5000, i, i*7, i, i)
+	}
+	s := sb.String()
+
+	for _, d := range []DbDialect{NewOracleDialect(), NewMySQLDialect(), NewPostgreSQLDialect()} {
+		b.Run(d.DialectName(), func(b *testing.B) {
+			b.SetBytes(int64(len(s)))
+			for i := 0; i < b.N; i++ {
+				l := NewReaderLexer(d, strings.NewReader(s))
+				for {
+					if _, err := l.Next(); err != nil {
+						break
+					}
+				}
+			}
+		})
+	}
+}
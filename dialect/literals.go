@@ -0,0 +1,754 @@
+package dialect
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// String literal styles
+const (
+	stringPlain       = iota // embedded quotes are doubled
+	stringBackslash          // MySQL style backslash escapes
+	stringEscape             // PostgreSQL E'...' style backslash escapes
+	stringUnicode            // U&'...' unicode escapes
+	stringHex                // X'...' hexadecimal
+	stringBit                // B'...' binary digits
+	stringAlternative        // Oracle q'[...]' alternative quoting
+	stringDollar             // PostgreSQL $tag$...$tag$ dollar quoting
+	stringDate               // MSAccess #...# date/time, no escapes
+)
+
+// stringForm describes one of the forms of string literal that are
+// supported by a dialect
+type stringForm struct {
+	prefix string // case-insensitive prefix preceding the opening quote, "_" for a character set introducer
+	quote  byte   // the opening (and closing) quote character
+	style  int    // the string literal style
+}
+
+var errNotStringLiteral = errors.New("not a string literal")
+
+// numberForm describes the forms of numeric literal, beyond the usual
+// decimal and exponent forms, that are supported by a dialect
+type numberForm struct {
+	hex         bool // 0x1F
+	octal       bool // 0o17
+	binary      bool // 0b101
+	quoted      bool // X'1F' and B'101'
+	underscores bool // 1_000_000
+	floatSuffix bool // 2.5f and 2.5d
+	money       bool // $12.50
+}
+
+// NumericLiteral is the parsed form of a numeric literal
+type NumericLiteral struct {
+	Value       *big.Rat // the exact value of the literal
+	Base        int      // 2, 8, 10 or 16
+	Integer     bool     // the literal has no decimal point or exponent
+	Approximate bool     // the literal has an exponent or a binary float suffix
+	Suffix      string   // the binary float suffix, if any ("f" or "d")
+	Money       bool     // the literal is a money literal
+}
+
+// matchStringForm returns the string form, and the length of the
+// prefix (including the opening quote), of the string literal that
+// starts at the beginning of the input exposed by peek. The returned
+// length is 0 if there is no string literal
+func matchStringForm(forms []stringForm, peek func(int) (byte, bool)) (stringForm, int) {
+
+	for _, f := range forms {
+		if n := matchStringPrefix(f, peek); n > 0 {
+			return f, n
+		}
+	}
+
+	return stringForm{}, 0
+}
+
+func matchStringPrefix(f stringForm, peek func(int) (byte, bool)) int {
+
+	n := 0
+	switch f.prefix {
+	case "_":
+		// character set introducer, e.g. _utf8mb4'...'
+		if c, _ := peek(0); c != '_' {
+			return 0
+		}
+		n = 1
+		for c, ok := peek(n); ok && (isDigit(c) || isAlpha(c)); c, ok = peek(n) {
+			n++
+		}
+		if n == 1 {
+			return 0
+		}
+	case "$":
+		// dollar quoting, the tag follows the rules for unquoted
+		// identifiers except that it cannot contain a dollar sign
+		if c, _ := peek(0); c != '$' {
+			return 0
+		}
+		n = 1
+		for c, ok := peek(n); ok && (isAlpha(c) || c == '_' || c >= 0x80 || (n > 1 && isDigit(c))); c, ok = peek(n) {
+			n++
+		}
+		if c, _ := peek(n); c != '$' {
+			return 0
+		}
+		return n + 1
+	default:
+		for n < len(f.prefix) {
+			c, _ := peek(n)
+			if toUpper(c) != f.prefix[n] {
+				return 0
+			}
+			n++
+		}
+	}
+
+	if c, _ := peek(n); c != f.quote {
+		return 0
+	}
+	n++
+
+	if f.style == stringAlternative {
+		// the alternative quote delimiter
+		c, ok := peek(n)
+		if !ok || isSpace(c) {
+			return 0
+		}
+		n++
+	}
+
+	return n
+}
+
+// scanStringLiteral returns the length of the string literal of the
+// supplied form that starts at the beginning of the input exposed by
+// peek, along with a boolean indicating if the literal is terminated.
+// An unterminated literal runs to the end of the input
+func scanStringLiteral(f stringForm, start int, peek func(int) (byte, bool)) (int, bool) {
+
+	n := start
+
+	switch f.style {
+	case stringDollar:
+		// the closing delimiter is the same as the opening one
+		var tag []byte
+		for i := 0; i < start; i++ {
+			c, _ := peek(i)
+			tag = append(tag, c)
+		}
+		for {
+			if _, ok := peek(n); !ok {
+				return n, false
+			}
+			if hasTag(tag, n, peek) {
+				return n + len(tag), true
+			}
+			n++
+		}
+
+	case stringAlternative:
+		// the closing delimiter is the opening delimiter, or its
+		// matching bracket, followed by the quote
+		c, _ := peek(start - 1)
+		switch c {
+		case '[':
+			c = ']'
+		case '{':
+			c = '}'
+		case '<':
+			c = '>'
+		case '(':
+			c = ')'
+		}
+		for {
+			b, ok := peek(n)
+			if !ok {
+				return n, false
+			}
+			n++
+			if b == c {
+				if q, _ := peek(n); q == f.quote {
+					return n + 1, true
+				}
+			}
+		}
+	}
+
+	for {
+		c, ok := peek(n)
+		if !ok {
+			return n, false
+		}
+		n++
+
+		switch {
+		case c == '\\' && (f.style == stringBackslash || f.style == stringEscape):
+			if _, ok := peek(n); ok {
+				n++
+			}
+		case c == f.quote:
+			if q, _ := peek(n); q != f.quote || f.style == stringDate {
+				return n, true
+			}
+			n++
+		}
+	}
+}
+
+func hasTag(tag []byte, n int, peek func(int) (byte, bool)) bool {
+	for i, t := range tag {
+		if c, ok := peek(n + i); !ok || c != t {
+			return false
+		}
+	}
+	return true
+}
+
+// peekString returns a peek function for the supplied string
+func peekString(s string) func(int) (byte, bool) {
+	return func(i int) (byte, bool) {
+		if i >= len(s) {
+			return 0, false
+		}
+		return s[i], true
+	}
+}
+
+// isStringLiteral returns a boolean indicating if the supplied string
+// is a complete string literal of one of the supplied forms
+func isStringLiteral(forms []stringForm, s string) bool {
+
+	f, start := matchStringForm(forms, peekString(s))
+	if start == 0 {
+		return false
+	}
+
+	n, ok := scanStringLiteral(f, start, peekString(s))
+	return ok && n == len(s)
+}
+
+// unquoteString returns the value of the supplied string literal,
+// which must be one of the supplied forms
+func unquoteString(forms []stringForm, s string) (string, error) {
+
+	f, start := matchStringForm(forms, peekString(s))
+	if start == 0 {
+		return "", errNotStringLiteral
+	}
+
+	n, ok := scanStringLiteral(f, start, peekString(s))
+	if !ok || n != len(s) {
+		return "", errNotStringLiteral
+	}
+
+	switch f.style {
+	case stringDollar:
+		return s[start : len(s)-start], nil
+	case stringAlternative:
+		return s[start : len(s)-2], nil
+	}
+
+	body := s[start : len(s)-1]
+	q := string(f.quote)
+
+	switch f.style {
+	case stringDate:
+		return body, nil
+	case stringBackslash:
+		return unescapeBackslash(body, f.quote), nil
+	case stringEscape:
+		return unescapeEscape(body, f.quote)
+	case stringUnicode:
+		return unescapeUnicode(strings.ReplaceAll(body, q+q, q))
+	case stringHex:
+		return decodeHex(body)
+	case stringBit:
+		if strings.Trim(body, "01") != "" {
+			return "", fmt.Errorf("invalid binary digits in %q", body)
+		}
+		return body, nil
+	}
+
+	return strings.ReplaceAll(body, q+q, q), nil
+}
+
+// quotePlain returns the supplied string as a single quoted string
+// literal with embedded quotes doubled
+func quotePlain(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// quoteNational returns the supplied string as a single quoted string
+// literal, using the N'...' national character form when the string
+// contains non-ASCII characters
+func quoteNational(s string) string {
+
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return "N" + quotePlain(s)
+		}
+	}
+
+	return quotePlain(s)
+}
+
+// quoteBackslash returns the supplied string as a single quoted string
+// literal using MySQL style backslash escapes
+func quoteBackslash(s string) string {
+
+	var sb strings.Builder
+	sb.WriteByte('\'')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case 0:
+			sb.WriteString(`\0`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case 0x1a:
+			sb.WriteString(`\Z`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\'':
+			sb.WriteString(`''`)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('\'')
+
+	return sb.String()
+}
+
+// quoteEscape returns the supplied string as a PostgreSQL style
+// E'...' string literal with backslash escapes for the control
+// characters
+func quoteEscape(s string) string {
+
+	var sb strings.Builder
+	sb.WriteString("E'")
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\'':
+			sb.WriteString(`''`)
+		default:
+			if c < 0x20 || c == 0x7f {
+				fmt.Fprintf(&sb, `\x%02x`, c)
+				continue
+			}
+			sb.WriteByte(c)
+		}
+	}
+	sb.WriteByte('\'')
+
+	return sb.String()
+}
+
+// hasControlChars returns a boolean indicating if the supplied string
+// contains any ASCII control characters
+func hasControlChars(s string) bool {
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] == 0x7f {
+			return true
+		}
+	}
+
+	return false
+}
+
+// numericLength returns the length of the longest numeric literal of
+// the supplied form found at the start of the supplied string, or 0 if
+// the string does not start with a numeric literal
+func numericLength(nf numberForm, s string) int {
+
+	if s == "" {
+		return 0
+	}
+
+	if nf.money && s[0] == '$' {
+		i, n := scanDigits(s, 1, isDigit, false)
+		if i < len(s) && s[i] == '.' {
+			var m int
+			i, m = scanDigits(s, i+1, isDigit, false)
+			n += m
+		}
+		if n == 0 {
+			return 0
+		}
+		return i
+	}
+
+	if nf.quoted && len(s) > 2 && s[1] == '\'' {
+		isValid := isHexDigit
+		switch s[0] {
+		case 'x', 'X':
+		case 'b', 'B':
+			isValid = isBinaryDigit
+		default:
+			return 0
+		}
+		i, _ := scanDigits(s, 2, isValid, false)
+		if i < len(s) && s[i] == '\'' {
+			return i + 1
+		}
+		return 0
+	}
+
+	if s[0] == '0' && len(s) > 2 {
+		var isValid func(byte) bool
+		switch {
+		case nf.hex && (s[1] == 'x' || s[1] == 'X'):
+			isValid = isHexDigit
+		case nf.octal && (s[1] == 'o' || s[1] == 'O'):
+			isValid = isOctalDigit
+		case nf.binary && (s[1] == 'b' || s[1] == 'B'):
+			isValid = isBinaryDigit
+		}
+		if isValid != nil {
+			start := 2
+			if nf.underscores && s[start] == '_' {
+				start++
+			}
+			if i, n := scanDigits(s, start, isValid, nf.underscores); n > 0 {
+				return i
+			}
+		}
+	}
+
+	i, n := scanDigits(s, 0, isDigit, nf.underscores)
+	if i < len(s) && s[i] == '.' && !strings.HasPrefix(s[i:], "..") {
+		var m int
+		i, m = scanDigits(s, i+1, isDigit, nf.underscores)
+		n += m
+	}
+	if n == 0 {
+		return 0
+	}
+
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j, m := scanDigits(s, j, isDigit, nf.underscores); m > 0 {
+			i = j
+		}
+	}
+
+	if nf.floatSuffix && i < len(s) {
+		switch s[i] {
+		case 'f', 'F', 'd', 'D':
+			i++
+		}
+	}
+
+	return i
+}
+
+// scanDigits returns the position following the run of valid digits
+// that starts at position i of the supplied string, along with the
+// number of digits found. Underscores, if allowed, must be between
+// digits
+func scanDigits(s string, i int, isValid func(byte) bool, underscores bool) (int, int) {
+
+	n := 0
+	for i < len(s) {
+		switch {
+		case isValid(s[i]):
+			n++
+			i++
+		case underscores && s[i] == '_' && n > 0 && i+1 < len(s) && isValid(s[i+1]):
+			i++
+		default:
+			return i, n
+		}
+	}
+
+	return i, n
+}
+
+// isNumericLiteral returns a boolean indicating if the supplied string
+// is a numeric literal of the supplied form
+func isNumericLiteral(nf numberForm, s string) bool {
+	return s != "" && numericLength(nf, s) == len(s)
+}
+
+// parseNumericLiteral parses the supplied numeric literal, which must
+// be of the supplied form
+func parseNumericLiteral(nf numberForm, s string) (NumericLiteral, error) {
+
+	if !isNumericLiteral(nf, s) {
+		return NumericLiteral{}, fmt.Errorf("%q is not a numeric literal", s)
+	}
+
+	n := NumericLiteral{Base: 10, Integer: true}
+	t := strings.ReplaceAll(s, "_", "")
+
+	switch {
+	case t[0] == '$':
+		n.Money = true
+		t = t[1:]
+	case len(t) > 2 && t[1] == '\'':
+		n.Base = 16
+		if t[0] == 'b' || t[0] == 'B' {
+			n.Base = 2
+		}
+		t = t[2 : len(t)-1]
+	case len(t) > 2 && t[0] == '0':
+		switch t[1] {
+		case 'x', 'X':
+			n.Base = 16
+		case 'o', 'O':
+			n.Base = 8
+		case 'b', 'B':
+			n.Base = 2
+		}
+		if n.Base != 10 {
+			t = t[2:]
+		}
+	}
+
+	if n.Base != 10 {
+		v, ok := new(big.Int).SetString(t, n.Base)
+		if !ok {
+			return NumericLiteral{}, fmt.Errorf("%q is not a numeric literal", s)
+		}
+		n.Value = new(big.Rat).SetInt(v)
+		return n, nil
+	}
+
+	switch t[len(t)-1] {
+	case 'f', 'F', 'd', 'D':
+		n.Suffix = strings.ToLower(t[len(t)-1:])
+		n.Approximate = true
+		t = t[:len(t)-1]
+	}
+
+	if strings.ContainsAny(t, "eE") {
+		n.Approximate = true
+		n.Integer = false
+	}
+	if strings.Contains(t, ".") {
+		n.Integer = false
+	}
+
+	v, ok := new(big.Rat).SetString(t)
+	if !ok {
+		return NumericLiteral{}, fmt.Errorf("%q is not a numeric literal", s)
+	}
+	n.Value = v
+
+	return n, nil
+}
+
+// unescapeBackslash decodes the MySQL style backslash escapes in the
+// body of a string literal
+func unescapeBackslash(s string, quote byte) string {
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			switch s[i] {
+			case '0':
+				sb.WriteByte(0)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'Z':
+				sb.WriteByte(0x1a)
+			case '%', '_':
+				// retained so that they can be used in LIKE patterns
+				sb.WriteByte('\\')
+				sb.WriteByte(s[i])
+			default:
+				sb.WriteByte(s[i])
+			}
+		case c == quote && i+1 < len(s) && s[i+1] == quote:
+			i++
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String()
+}
+
+// unescapeEscape decodes the PostgreSQL style backslash escapes in the
+// body of an E'...' string literal
+func unescapeEscape(s string, quote byte) (string, error) {
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == quote && i+1 < len(s) && s[i+1] == quote {
+			i++
+			sb.WriteByte(c)
+			continue
+		}
+		if c != '\\' || i+1 >= len(s) {
+			sb.WriteByte(c)
+			continue
+		}
+
+		i++
+		switch c = s[i]; c {
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			n := 1
+			for n < 3 && i+n < len(s) && s[i+n] >= '0' && s[i+n] <= '7' {
+				n++
+			}
+			v, _ := strconv.ParseUint(s[i:i+n], 8, 8)
+			sb.WriteByte(byte(v))
+			i += n - 1
+		case 'x':
+			n := 0
+			for n < 2 && i+1+n < len(s) && isHexDigit(s[i+1+n]) {
+				n++
+			}
+			if n == 0 {
+				sb.WriteByte(c)
+				continue
+			}
+			v, _ := strconv.ParseUint(s[i+1:i+1+n], 16, 8)
+			sb.WriteByte(byte(v))
+			i += n
+		case 'u', 'U':
+			n := 4
+			if c == 'U' {
+				n = 8
+			}
+			if i+n >= len(s) {
+				return "", fmt.Errorf("invalid unicode escape in %q", s)
+			}
+			v, err := strconv.ParseUint(s[i+1:i+1+n], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(v)) {
+				return "", fmt.Errorf("invalid unicode escape in %q", s)
+			}
+			sb.WriteRune(rune(v))
+			i += n
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// unescapeUnicode decodes the \XXXX and \+XXXXXX escapes in the body of
+// a U&'...' string literal
+func unescapeUnicode(s string) (string, error) {
+
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' {
+			sb.WriteByte(c)
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == '\\' {
+			sb.WriteByte(c)
+			i++
+			continue
+		}
+
+		n := 4
+		j := i + 1
+		if j < len(s) && s[j] == '+' {
+			n = 6
+			j++
+		}
+		if j+n > len(s) {
+			return "", fmt.Errorf("invalid unicode escape in %q", s)
+		}
+		v, err := strconv.ParseUint(s[j:j+n], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(v)) {
+			return "", fmt.Errorf("invalid unicode escape in %q", s)
+		}
+		sb.WriteRune(rune(v))
+		i = j + n - 1
+	}
+
+	return sb.String(), nil
+}
+
+// decodeHex decodes the hexadecimal digits in the body of a X'...'
+// string literal
+func decodeHex(s string) (string, error) {
+
+	if len(s)%2 != 0 {
+		return "", fmt.Errorf("odd number of hexadecimal digits in %q", s)
+	}
+
+	b := make([]byte, len(s)/2)
+	for i := range b {
+		v, err := strconv.ParseUint(s[2*i:2*i+2], 16, 8)
+		if err != nil {
+			return "", fmt.Errorf("invalid hexadecimal digits in %q", s)
+		}
+		b[i] = byte(v)
+	}
+
+	return string(b), nil
+}
+
+func isAlpha(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isBinaryDigit(c byte) bool {
+	return c == '0' || c == '1'
+}
+
+func isOctalDigit(c byte) bool {
+	return c >= '0' && c <= '7'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+func toUpper(c byte) byte {
+	if c >= 'a' && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
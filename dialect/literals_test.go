@@ -0,0 +1,205 @@
+package dialect
+
+import "testing"
+
+func TestUnquoteString(t *testing.T) {
+
+	pg, ora, my := NewPostgreSQLDialect(), NewOracleDialect(), NewMySQLDialect()
+
+	tests := []struct {
+		d    DbDialect
+		in   string
+		want string
+	}{
+		{pg, "'it''s'", "it's"},
+		{pg, "E'a\\nb'", "a\nb"},
+		{pg, "e'it\\'s'", "it's"},
+		{pg, "E'it''s'", "it's"},
+		{pg, "E'\\x41\\101\\u0041\\U00000041'", "AAAA"},
+		{pg, "U&'d\\0061t\\+000061'", "data"},
+		{pg, "u&'a\\\\b'", "a\\b"},
+		{pg, "$$it's$$", "it's"},
+		{pg, "$$$$", ""},
+		{pg, "$tag$a$$b$tag$", "a$$b"},
+		{pg, "$a_1$x$a$y$a_1$", "x$a$y"},
+		{pg, "X'4142'", "AB"},
+		{pg, "B'101'", "101"},
+		{pg, "N'x'", "x"},
+		{ora, "q'[it's]'", "it's"},
+		{ora, "Q'{a}b}'", "a}b"},
+		{ora, "q'<a>'", "a"},
+		{ora, "q'(a)'", "a"},
+		{ora, "nq'!x!'", "x"},
+		{ora, "q'#a'b#'", "a'b"},
+		{my, "'a\\'b'", "a'b"},
+		{my, "'a\\nb\\%'", "a\nb\\%"},
+		{my, `"a""b"`, `a"b`},
+		{my, "_utf8mb4'x'", "x"},
+		{NewSQLiteDialect(), "'a\\n'", "a\\n"},
+		{NewMSSQLDialect(), "N'it''s'", "it's"},
+		{NewMSAccessDialect(), "#2024-01-02#", "2024-01-02"},
+	}
+
+	for _, tt := range tests {
+		if !tt.d.IsStringLiteral(tt.in) {
+			t.Errorf("%s: IsStringLiteral(%q) is false", tt.d.DialectName(), tt.in)
+		}
+		got, err := tt.d.UnquoteString(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: UnquoteString(%q) = %q %v, expected %q", tt.d.DialectName(), tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestIsStringLiteral(t *testing.T) {
+
+	tests := []struct {
+		d       DbDialect
+		invalid []string
+	}{
+		{NewPostgreSQLDialect(), []string{"'abc", "'a'b'", "E'abc\\'", "$tag$abc$tg$", "$1$x$1$", "U&'x", "\"a\"", "q'[x]'"}},
+		{NewOracleDialect(), []string{"q'[x'", "q'[x]", "q' x '", "E'x'", "$$x$$"}},
+		{NewMySQLDialect(), []string{"'a\\'", "$$x$$"}},
+		{NewMSSQLDialect(), []string{"\"a\"", "E'x'"}},
+		{NewMSAccessDialect(), []string{"#2024-01-02"}},
+	}
+
+	for _, tt := range tests {
+		for _, s := range tt.invalid {
+			if tt.d.IsStringLiteral(s) {
+				t.Errorf("%s: IsStringLiteral(%q) is true", tt.d.DialectName(), s)
+			}
+			if _, err := tt.d.UnquoteString(s); err == nil {
+				t.Errorf("%s: UnquoteString(%q) succeeded, expected an error", tt.d.DialectName(), s)
+			}
+		}
+	}
+
+	// Escapes that are not valid are an error when unquoting
+	for _, s := range []string{"U&'\\00'", "X'4'", "B'102'"} {
+		if _, err := NewPostgreSQLDialect().UnquoteString(s); err == nil {
+			t.Errorf("UnquoteString(%q) succeeded, expected an error", s)
+		}
+	}
+}
+
+func TestParseNumericLiteral(t *testing.T) {
+
+	pg, ora := NewPostgreSQLDialect(), NewOracleDialect()
+
+	tests := []struct {
+		d                    DbDialect
+		in, value            string
+		base                 int
+		integer, approximate bool
+		suffix               string
+		money                bool
+	}{
+		{pg, "42", "42", 10, true, false, "", false},
+		{pg, "1.5", "3/2", 10, false, false, "", false},
+		{pg, ".5", "1/2", 10, false, false, "", false},
+		{pg, "5.", "5", 10, false, false, "", false},
+		{pg, "1.5e3", "1500", 10, false, true, "", false},
+		{pg, "1E-2", "1/100", 10, false, true, "", false},
+		{pg, "1_000_000", "1000000", 10, true, false, "", false},
+		{pg, "0x1F", "31", 16, true, false, "", false},
+		{pg, "0o17", "15", 8, true, false, "", false},
+		{pg, "0b101", "5", 2, true, false, "", false},
+		{pg, "0xFFFF_FFFF_FFFF_FFFF_FF", "4722366482869645213695", 16, true, false, "", false},
+		{ora, "2.5f", "5/2", 10, false, true, "f", false},
+		{ora, "25D", "25", 10, true, true, "d", false},
+		{NewMySQLDialect(), "X'1F'", "31", 16, true, false, "", false},
+		{NewMySQLDialect(), "b'101'", "5", 2, true, false, "", false},
+		{NewMSSQLDialect(), "$12.50", "25/2", 10, false, false, "", true},
+		{NewMSSQLDialect(), "0x0A", "10", 16, true, false, "", false},
+		{NewSQLiteDialect(), "1_0.0_1", "1001/100", 10, false, false, "", false},
+	}
+
+	for _, tt := range tests {
+		if !tt.d.IsNumericLiteral(tt.in) {
+			t.Errorf("%s: IsNumericLiteral(%q) is false", tt.d.DialectName(), tt.in)
+		}
+		n, err := tt.d.ParseNumericLiteral(tt.in)
+		if err != nil {
+			t.Errorf("%s: ParseNumericLiteral(%q) %v", tt.d.DialectName(), tt.in, err)
+			continue
+		}
+		if n.Value.RatString() != tt.value || n.Base != tt.base || n.Integer != tt.integer ||
+			n.Approximate != tt.approximate || n.Suffix != tt.suffix || n.Money != tt.money {
+			t.Errorf("%s: ParseNumericLiteral(%q) = %s %+v", tt.d.DialectName(), tt.in, n.Value.RatString(), n)
+		}
+	}
+
+	bad := []struct {
+		d       DbDialect
+		invalid []string
+	}{
+		{pg, []string{"", "1e", "1e+", "0x", "0b2", "1__0", "_1", "1_", "$5", "2.5f", "X'1F'", "1.2.3"}},
+		{ora, []string{"0x1F", "1_000", "f"}},
+		{NewMySQLDialect(), []string{"0o17", "X'1G'", "b'102'", "1_000"}},
+		{NewMSSQLDialect(), []string{"0b101", "$"}},
+		{NewMSAccessDialect(), []string{"0x1F", "$1"}},
+	}
+
+	for _, tt := range bad {
+		for _, s := range tt.invalid {
+			if tt.d.IsNumericLiteral(s) {
+				t.Errorf("%s: IsNumericLiteral(%q) is true", tt.d.DialectName(), s)
+			}
+			if _, err := tt.d.ParseNumericLiteral(s); err == nil {
+				t.Errorf("%s: ParseNumericLiteral(%q) succeeded, expected an error", tt.d.DialectName(), s)
+			}
+		}
+	}
+}
+
+func TestQuoteString(t *testing.T) {
+
+	my := NewMySQLDialect()
+	myNoEsc := NewMySQLDialect()
+	myNoEsc.SetNoBackslashEscapes(true)
+	mariaNoEsc := NewMariaDBDialect()
+	mariaNoEsc.SetNoBackslashEscapes(true)
+
+	tests := []struct {
+		d        DbDialect
+		in, want string
+	}{
+		{NewStandardSQLDialect(), "it's", "'it''s'"},
+		{NewPostgreSQLDialect(), "it's", "'it''s'"},
+		{NewPostgreSQLDialect(), "a\\b", "'a\\b'"},
+		{NewPostgreSQLDialect(), "a\nb\\'\x01", "E'a\\nb\\\\''\\x01'"},
+		{my, "it's a\\b\n\x00\x1a", "'it''s a\\\\b\\n\\0\\Z'"},
+		{myNoEsc, "it's a\\b", "'it''s a\\b'"},
+		{NewMariaDBDialect(), "a\\b", "'a\\\\b'"},
+		{mariaNoEsc, "a\\b", "'a\\b'"},
+		{NewOracleDialect(), "it's", "'it''s'"},
+		{NewOracleDialect(), "café", "N'café'"},
+		{NewMSSQLDialect(), "café", "N'café'"},
+		{NewSQLiteDialect(), "a\\b\n", "'a\\b\n'"},
+		{NewMSAccessDialect(), "it's", "'it''s'"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.d.QuoteString(tt.in); got != tt.want {
+			t.Errorf("%s: QuoteString(%q) = %s, expected %s", tt.d.DialectName(), tt.in, got, tt.want)
+		}
+	}
+
+	// The quoted strings must be single string tokens that unquote to
+	// the original string
+	values := []string{"", "it's", "a\\b", "\\'", "a\nb\r\tc", "café", "\x00\x1a\x7f", "'; drop table t; --"}
+	for _, d := range append(testDialects(), myNoEsc, mariaNoEsc) {
+		for _, s := range values {
+			q := d.QuoteString(s)
+			tokens := Tokenize(d, q)
+			if len(tokens) != 1 || tokens[0].Type != StringToken {
+				t.Errorf("%s: QuoteString(%q) = %s is %d tokens", d.DialectName(), s, q, len(tokens))
+				continue
+			}
+			if got, err := d.UnquoteString(q); err != nil || got != s {
+				t.Errorf("%s: UnquoteString(QuoteString(%q)) = %q %v", d.DialectName(), s, got, err)
+			}
+		}
+	}
+}
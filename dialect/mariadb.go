@@ -1,13 +1,16 @@
 package dialect
 
 import (
-	"regexp"
 	"strings"
 )
 
 type MariaDBDialect struct {
 	dialect int
 	name    string
+
+	// noBackslashEscapes indicates that the NO_BACKSLASH_ESCAPES SQL
+	// mode is enabled so backslash is an ordinary character in strings
+	noBackslashEscapes bool
 }
 
 func NewMariaDBDialect() *MariaDBDialect {
@@ -19,6 +22,13 @@ func NewMariaDBDialect() *MariaDBDialect {
 	return &d
 }
 
+// SetNoBackslashEscapes sets whether the NO_BACKSLASH_ESCAPES SQL mode
+// is enabled, in which case backslash is not an escape character in
+// string literals
+func (d *MariaDBDialect) SetNoBackslashEscapes(b bool) {
+	d.noBackslashEscapes = b
+}
+
 func (d MariaDBDialect) Dialect() int {
 	return d.dialect
 }
@@ -41,506 +51,802 @@ func (d MariaDBDialect) MaxOperatorLength() int {
 	return 3
 }
 
-// IsDatatype returns a boolean indicating if the supplied string
-// (or string slice) is considered to be a datatype in MariaDB
-func (d MariaDBDialect) IsDatatype(s ...string) bool {
+// LineCommentChars returns the character sequences that start a
+// comment that runs to the end of the line
+func (d MariaDBDialect) LineCommentChars() []string {
+	return []string{"--", "#"}
+}
+
+// BlockCommentChars returns the character sequences that start and
+// end a block comment
+func (d MariaDBDialect) BlockCommentChars() (string, string) {
+	return "/*", "*/"
+}
+
+// NestedComments returns a boolean indicating if block comments
+// may be nested in MariaDB
+func (d MariaDBDialect) NestedComments() bool {
+	return false
+}
+
+// IsComment returns a boolean indicating if the supplied string
+// is considered to be a complete comment in MariaDB
+func (d MariaDBDialect) IsComment(s string) bool {
 
-	var mariadbDatatypes = map[string]bool{
-
-		"bigint (n) signed":               true,
-		"bigint (n)":                      true,
-		"bigint (n) unsigned":             true,
-		"bigint (n) zerofill":             true,
-		"bigint signed":                   true,
-		"bigint":                          true,
-		"bigint unsigned":                 true,
-		"bigint zerofill":                 true,
-		"binary (n)":                      true,
-		"binary":                          true,
-		"bit (n)":                         true,
-		"bit":                             true,
-		"blob (n)":                        true,
-		"blob":                            true,
-		"boolean":                         true,
-		"bool":                            true,
-		"char byte (n)":                   true, // compatibility feature
-		"char byte":                       true, // compatibility feature
-		"char (n)":                        true,
-		"char":                            true,
-		"datetime":                        true,
-		"date":                            true,
-		"decimal (n,n) signed":            true,
-		"decimal (n,n)":                   true,
-		"decimal (n,n) unsigned":          true,
-		"decimal (n,n) zerofill":          true,
-		"decimal (n) signed":              true,
-		"decimal (n)":                     true,
-		"decimal (n) unsigned":            true,
-		"decimal (n) zerofill":            true,
-		"decimal signed":                  true,
-		"decimal":                         true,
-		"decimal unsigned":                true,
-		"decimal zerofill":                true,
-		"dec (n,n) signed":                true, // synonym for decimal
-		"dec (n,n)":                       true, // synonym for decimal
-		"dec (n,n) unsigned":              true, // synonym for decimal
-		"dec (n,n) zerofill":              true, // synonym for decimal
-		"dec (n) signed":                  true, // synonym for decimal
-		"dec (n)":                         true, // synonym for decimal
-		"dec (n) unsigned":                true, // synonym for decimal
-		"dec (n) zerofill":                true, // synonym for decimal
-		"dec signed":                      true, // synonym for decimal
-		"dec":                             true, // synonym for decimal
-		"dec unsigned":                    true, // synonym for decimal
-		"dec zerofill":                    true, // synonym for decimal
-		"double (n,n) signed":             true,
-		"double (n,n)":                    true,
-		"double (n,n) unsigned":           true,
-		"double (n,n) zerofill":           true,
-		"double precision (n,n) signed":   true,
-		"double precision (n,n)":          true,
-		"double precision (n,n) unsigned": true,
-		"double precision (n,n) zerofill": true,
-		"double precision signed":         true,
-		"double precision":                true,
-		"double precision unsigned":       true,
-		"double precision zerofill":       true,
-		"double signed":                   true,
-		"double":                          true,
-		"double unsigned":                 true,
-		"double zerofill":                 true,
-		"enum":                            true,
-		"fixed (n,n) signed":              true, // other DB compatibility synonym for decimal
-		"fixed (n,n)":                     true, // other DB compatibility synonym for decimal
-		"fixed (n,n) unsigned":            true, // other DB compatibility synonym for decimal
-		"fixed (n,n) zerofill":            true, // other DB compatibility synonym for decimal
-		"fixed (n) signed":                true, // other DB compatibility synonym for decimal
-		"fixed (n)":                       true, // other DB compatibility synonym for decimal
-		"fixed (n) unsigned":              true, // other DB compatibility synonym for decimal
-		"fixed (n) zerofill":              true, // other DB compatibility synonym for decimal
-		"fixed signed":                    true, // other DB compatibility synonym for decimal
-		"fixed":                           true, // other DB compatibility synonym for decimal
-		"fixed unsigned":                  true, // other DB compatibility synonym for decimal
-		"fixed zerofill":                  true, // other DB compatibility synonym for decimal
-		"float (n,n) signed":              true,
-		"float (n,n)":                     true,
-		"float (n,n) unsigned":            true,
-		"float (n,n) zerofill":            true,
-		"float signed":                    true,
-		"float":                           true,
-		"float unsigned":                  true,
-		"float zerofill":                  true,
-		"integer (n) signed":              true,
-		"integer (n)":                     true,
-		"integer (n) unsigned":            true,
-		"integer (n) zerofill":            true,
-		"integer signed":                  true,
-		"integer":                         true,
-		"integer unsigned":                true,
-		"integer zerofill":                true,
-		"int (n) signed":                  true,
-		"int (n)":                         true,
-		"int (n) unsigned":                true,
-		"int (n) zerofill":                true,
-		"int signed":                      true,
-		"int":                             true,
-		"int unsigned":                    true,
-		"int zerofill":                    true,
-		"longblob":                        true,
-		"longtext":                        true,
-		"mediumblob":                      true,
-		"mediumint (n) signed":            true,
-		"mediumint (n)":                   true,
-		"mediumint (n) unsigned":          true,
-		"mediumint (n) zerofill":          true,
-		"mediumint signed":                true,
-		"mediumint":                       true,
-		"mediumint unsigned":              true,
-		"mediumint zerofill":              true,
-		"mediumtext":                      true,
-		"national char (n)":               true,
-		"national char":                   true,
-		"national varchar (n)":            true,
-		"national varchar":                true,
-		"number (n,n) signed":             true, // Oracle mode synonym for decimal
-		"number (n,n)":                    true, // Oracle mode synonym for decimal
-		"number (n,n) unsigned":           true, // Oracle mode synonym for decimal
-		"number (n,n) zerofill":           true, // Oracle mode synonym for decimal
-		"number (n) signed":               true, // Oracle mode synonym for decimal
-		"number (n)":                      true, // Oracle mode synonym for decimal
-		"number (n) unsigned":             true, // Oracle mode synonym for decimal
-		"number (n) zerofill":             true, // Oracle mode synonym for decimal
-		"number signed":                   true, // Oracle mode synonym for decimal
-		"number":                          true, // Oracle mode synonym for decimal
-		"number unsigned":                 true, // Oracle mode synonym for decimal
-		"number zerofill":                 true, // Oracle mode synonym for decimal
-		"numeric (n,n) signed":            true, // synonym for decimal
-		"numeric (n,n)":                   true, // synonym for decimal
-		"numeric (n,n) unsigned":          true, // synonym for decimal
-		"numeric (n,n) zerofill":          true, // synonym for decimal
-		"numeric (n) signed":              true, // synonym for decimal
-		"numeric (n)":                     true, // synonym for decimal
-		"numeric (n) unsigned":            true, // synonym for decimal
-		"numeric (n) zerofill":            true, // synonym for decimal
-		"numeric signed":                  true, // synonym for decimal
-		"numeric":                         true, // synonym for decimal
-		"numeric unsigned":                true, // synonym for decimal
-		"numeric zerofill":                true, // synonym for decimal
-		"real (n,n) signed":               true,
-		"real (n,n)":                      true,
-		"real (n,n) unsigned":             true,
-		"real (n,n) zerofill":             true,
-		"real signed":                     true,
-		"real":                            true,
-		"real unsigned":                   true,
-		"real zerofill":                   true,
-		"set":                             true,
-		"smallint (n) signed":             true,
-		"smallint (n)":                    true,
-		"smallint (n) unsigned":           true,
-		"smallint (n) zerofill":           true,
-		"smallint signed":                 true,
-		"smallint":                        true,
-		"smallint unsigned":               true,
-		"smallint zerofill":               true,
-		"text (n)":                        true,
-		"text":                            true,
-		"timestamp":                       true,
-		"time":                            true,
-		"tinyblob":                        true,
-		"tinyint (n) signed":              true,
-		"tinyint (n)":                     true,
-		"tinyint (n) unsigned":            true,
-		"tinyint (n) zerofill":            true,
-		"tinyint signed":                  true,
-		"tinyint":                         true,
-		"tinyint unsigned":                true,
-		"tinyint zerofill":                true,
-		"tinytext":                        true,
-		"varbinary (n)":                   true,
-		"varbinary":                       true,
-		"varchar (n)":                     true,
-		"varchar":                         true,
-		"vector (n)":                      true,
-		"vector":                          true,
-		"year":                            true,
+	// "The -- (double-dash) comment style requires the second dash to
+	// be followed by at least one whitespace or control character"
+	if strings.HasPrefix(s, "--") && len(s) > 2 && s[2] > ' ' {
+		return false
 	}
+	return isComment(d, s)
+}
 
-	var z []string
-	rn := regexp.MustCompile(`^[0-9]+$`)
-
-	for i, v := range s {
-		switch v {
-		case "(":
-			z = append(z, " "+v)
-		case ")", ",":
-			z = append(z, v)
-		default:
-			switch {
-			case rn.MatchString(v):
-				z = append(z, "n")
-			case i == 0:
-				z = append(z, v)
-			default:
-				z = append(z, " "+v)
-			}
-		}
+// IsHint returns a boolean indicating if the supplied string
+// is considered to be an optimizer hint in MariaDB
+func (d MariaDBDialect) IsHint(s string) bool {
+	return false
+}
+
+// IsExecutableComment returns a boolean indicating if the supplied
+// string is considered to be an executable comment in MariaDB
+func (d MariaDBDialect) IsExecutableComment(s string) bool {
+
+	// "/*! ... */" is executed by both MySQL and MariaDB while
+	// "/*M! ... */" is only executed by MariaDB
+	if !d.IsComment(s) {
+		return false
+	}
+	return strings.HasPrefix(s, "/*!") || strings.HasPrefix(s, "/*M!")
+}
+
+// stringForms returns the forms of string literal supported by
+// MariaDB
+func (d MariaDBDialect) stringForms() []stringForm {
+
+	style := stringBackslash
+	if d.noBackslashEscapes {
+		style = stringPlain
 	}
 
-	k := strings.ToLower(strings.Join(z, ""))
-	if _, ok := mariadbDatatypes[k]; ok {
-		return true
+	// Double quoted strings are strings unless the ANSI_QUOTES SQL mode
+	// is enabled, "_" is a character set introducer (e.g. _utf8mb4'...')
+	return []stringForm{
+		{"", '\'', style},
+		{"", '"', style},
+		{"N", '\'', style},
+		{"_", '\'', style},
+		{"_", '"', style},
+		{"X", '\'', stringHex},
+		{"B", '\'', stringBit},
 	}
+}
 
-	return false
+// IsStringLiteral returns a boolean indicating if the supplied string
+// is considered to be a complete string literal in MariaDB
+func (d MariaDBDialect) IsStringLiteral(s string) bool {
+	return isStringLiteral(d.stringForms(), s)
 }
 
-func (d MariaDBDialect) keyword(s string) (bool, bool) {
+// UnquoteString returns the value of the supplied MariaDB string literal
+func (d MariaDBDialect) UnquoteString(s string) (string, error) {
+	return unquoteString(d.stringForms(), s)
+}
 
-	/*
-	   MariaDB keywords
+// QuoteString returns the supplied string quoted as a string literal
+// for MariaDB with any special characters escaped
+func (d MariaDBDialect) QuoteString(s string) string {
+
+	if d.noBackslashEscapes {
+		return quotePlain(s)
+	}
+	return quoteBackslash(s)
+}
 
-	   https://mariadb.com/kb/en/library/reserved-words/
+// identQuotes returns the forms of quoted identifier supported by
+// MariaDB, with the preferred form first
+func (d MariaDBDialect) identQuotes() []identQuote {
+	return []identQuote{
+		{'`', '`', true},
+	}
+}
+
+// QuoteIdentifier returns the supplied identifier quoted for MariaDB
+// with any embedded quote characters escaped
+func (d MariaDBDialect) QuoteIdentifier(s string) (string, error) {
+	return quoteIdentifier(d.identQuotes()[0], s)
+}
 
-	    * Some keywords are exceptions for historical reasons, and are permitted as unquoted identifiers.
-	    * In Oracle mode, from MariaDB 10.3, there are a number of extra reserved words.
+// UnquoteIdentifier returns the name of the supplied quoted MariaDB
+// identifier
+func (d MariaDBDialect) UnquoteIdentifier(s string) (string, error) {
+	return unquoteIdentifier(d.identQuotes(), s)
+}
 
-	   The isReserved value is set to false as there is no indication (from
-	   the above link) if the keywords are reserved or not.
+// FormatIdentifier returns the supplied identifier as it should be
+// written in MariaDB, quoting it only when needed
+func (d MariaDBDialect) FormatIdentifier(s string) (string, error) {
 
-	*/
+	// The keyword list does not indicate which keywords are reserved
+	// so any keyword is quoted
+	if d.IsKeyword(s) {
+		return d.QuoteIdentifier(s)
+	}
+	return formatIdentifier(d, s)
+}
+
+// numberForm returns the forms of numeric literal supported by
+// MariaDB
+func (d MariaDBDialect) numberForm() numberForm {
+
+	// X'1F' and B'101' are hexadecimal and bit-value literals that are
+	// treated as numbers in numeric contexts (and as binary strings
+	// otherwise)
+	return numberForm{hex: true, binary: true, quoted: true}
+}
 
-	// map[keyword]isReserved
-	var mariadbKeywords = map[string]bool{
-		"ACCESSIBLE":                    false,
-		"ACTION":                        false,
-		"ADD":                           false,
-		"ALL":                           false,
-		"ALTER":                         false,
-		"ANALYZE":                       false,
-		"AND":                           false,
-		"ASC":                           false,
-		"ASENSITIVE":                    false,
-		"AS":                            false,
-		"BEFORE":                        false,
-		"BETWEEN":                       false,
-		"BIGINT":                        false,
-		"BINARY":                        false,
-		"BIT":                           false,
-		"BLOB":                          false,
-		"BODY":                          false,
-		"BOTH":                          false,
-		"BY":                            false,
-		"CALL":                          false,
-		"CASCADE":                       false,
-		"CASE":                          false,
-		"CHANGE":                        false,
-		"CHARACTER":                     false,
-		"CHAR":                          false,
-		"CHECK":                         false,
-		"COLLATE":                       false,
-		"COLUMN":                        false,
-		"CONDITION":                     false,
-		"CONSTRAINT":                    false,
-		"CONTINUE":                      false,
-		"CONVERT":                       false,
-		"CREATE":                        false,
-		"CROSS":                         false,
-		"CURRENT_DATE":                  false,
-		"CURRENT_ROLE":                  false,
-		"CURRENT_TIME":                  false,
-		"CURRENT_TIMESTAMP":             false,
-		"CURRENT_USER":                  false,
-		"CURSOR":                        false,
-		"DATABASE":                      false,
-		"DATABASES":                     false,
-		"DATE":                          false,
-		"DAY_HOUR":                      false,
-		"DAY_MICROSECOND":               false,
-		"DAY_MINUTE":                    false,
-		"DAY_SECOND":                    false,
-		"DEC":                           false,
-		"DECIMAL":                       false,
-		"DECLARE":                       false,
-		"DEFAULT":                       false,
-		"DELAYED":                       false,
-		"DELETE":                        false,
-		"DESC":                          false,
-		"DESCRIBE":                      false,
-		"DETERMINISTIC":                 false,
-		"DISTINCT":                      false,
-		"DISTINCTROW":                   false,
-		"DIV":                           false,
-		"DO_DOMAIN_IDS":                 false,
-		"DOUBLE":                        false,
-		"DROP":                          false,
-		"DUAL":                          false,
-		"EACH":                          false,
-		"ELSE":                          false,
-		"ELSEIF":                        false,
-		"ELSIF":                         false,
-		"ENCLOSED":                      false,
-		"ENUM":                          false,
-		"ESCAPED":                       false,
-		"EXCEPT":                        false,
-		"EXISTS":                        false,
-		"EXIT":                          false,
-		"EXPLAIN":                       false,
-		"FALSE":                         false,
-		"FETCH":                         false,
-		"FLOAT4":                        false,
-		"FLOAT8":                        false,
-		"FLOAT":                         false,
-		"FORCE":                         false,
-		"FOREIGN":                       false,
-		"FOR":                           false,
-		"FROM":                          false,
-		"FULLTEXT":                      false,
-		"GENERAL":                       false,
-		"GOTO":                          false,
-		"GRANT":                         false,
-		"GROUP":                         false,
-		"HAVING":                        false,
-		"HIGH_PRIORITY":                 false,
-		"HISTORY":                       false,
-		"HOUR_MICROSECOND":              false,
-		"HOUR_MINUTE":                   false,
-		"HOUR_SECOND":                   false,
-		"IF":                            false,
-		"IGNORE_DOMAIN_IDS":             false,
-		"IGNORE":                        false,
-		"IGNORE_SERVER_IDS":             false,
-		"INDEX":                         false,
-		"IN":                            false,
-		"INFILE":                        false,
-		"INNER":                         false,
-		"INOUT":                         false,
-		"INSENSITIVE":                   false,
-		"INSERT":                        false,
-		"INT1":                          false,
-		"INT2":                          false,
-		"INT3":                          false,
-		"INT4":                          false,
-		"INT8":                          false,
-		"INTEGER":                       false,
-		"INTERSECT":                     false,
-		"INTERVAL":                      false,
-		"INT":                           false,
-		"INTO":                          false,
-		"IS":                            false,
-		"ITERATE":                       false,
-		"JOIN":                          false,
-		"KEY":                           false,
-		"KEYS":                          false,
-		"KILL":                          false,
-		"LEADING":                       false,
-		"LEAVE":                         false,
-		"LEFT":                          false,
-		"LIKE":                          false,
-		"LIMIT":                         false,
-		"LINEAR":                        false,
-		"LINES":                         false,
-		"LOAD":                          false,
-		"LOCALTIME":                     false,
-		"LOCALTIMESTAMP":                false,
-		"LOCK":                          false,
-		"LONGBLOB":                      false,
-		"LONG":                          false,
-		"LONGTEXT":                      false,
-		"LOOP":                          false,
-		"LOW_PRIORITY":                  false,
-		"MASTER_HEARTBEAT_PERIOD":       false,
-		"MASTER_SSL_VERIFY_SERVER_CERT": false,
-		"MATCH":                         false,
-		"MAXVALUE":                      false,
-		"MEDIUMBLOB":                    false,
-		"MEDIUMINT":                     false,
-		"MEDIUMTEXT":                    false,
-		"MIDDLEINT":                     false,
-		"MINUTE_MICROSECOND":            false,
-		"MINUTE_SECOND":                 false,
-		"MOD":                           false,
-		"MODIFIES":                      false,
-		"NATURAL":                       false,
-		"NO":                            false,
-		"NOT":                           false,
-		"NO_WRITE_TO_BINLOG":            false,
-		"NULL":                          false,
-		"NUMERIC":                       false,
-		"ON":                            false,
-		"OPTIMIZE":                      false,
-		"OPTIONALLY":                    false,
-		"OPTION":                        false,
-		"ORDER":                         false,
-		"OR":                            false,
-		"OTHERS":                        false,
-		"OUTER":                         false,
-		"OUT":                           false,
-		"OUTFILE":                       false,
-		"OVER":                          false,
-		"PACKAGE":                       false,
-		"PAGE_CHECKSUM":                 false,
-		"PARSE_VCOL_EXPR":               false,
-		"PARTITION":                     false,
-		"PERIOD":                        false,
-		"PRECISION":                     false,
-		"PRIMARY":                       false,
-		"PROCEDURE":                     false,
-		"PURGE":                         false,
-		"RAISE":                         false,
-		"RANGE":                         false,
-		"READ":                          false,
-		"READS":                         false,
-		"READ_WRITE":                    false,
-		"REAL":                          false,
-		"RECURSIVE":                     false,
-		"REFERENCES":                    false,
-		"REF_SYSTEM_ID":                 false,
-		"REGEXP":                        false,
-		"RELEASE":                       false,
-		"RENAME":                        false,
-		"REPEAT":                        false,
-		"REPLACE":                       false,
-		"REQUIRE":                       false,
-		"RESIGNAL":                      false,
-		"RESTRICT":                      false,
-		"RETURN":                        false,
-		"RETURNING":                     false,
-		"REVOKE":                        false,
-		"RIGHT":                         false,
-		"RLIKE":                         false,
-		"ROWS":                          false,
-		"ROWTYPE":                       false,
-		"SCHEMA":                        false,
-		"SCHEMAS":                       false,
-		"SECOND_MICROSECOND":            false,
-		"SELECT":                        false,
-		"SENSITIVE":                     false,
-		"SEPARATOR":                     false,
-		"SET":                           false,
-		"SHOW":                          false,
-		"SIGNAL":                        false,
-		"SLOW":                          false,
-		"SMALLINT":                      false,
-		"SPATIAL":                       false,
-		"SPECIFIC":                      false,
-		"SQL_BIG_RESULT":                false,
-		"SQL_CALC_FOUND_ROWS":           false,
-		"SQLEXCEPTION":                  false,
-		"SQL":                           false,
-		"SQL_SMALL_RESULT":              false,
-		"SQLSTATE":                      false,
-		"SQLWARNING":                    false,
-		"SSL":                           false,
-		"STARTING":                      false,
-		"STATS_AUTO_RECALC":             false,
-		"STATS_PERSISTENT":              false,
-		"STATS_SAMPLE_PAGES":            false,
-		"STRAIGHT_JOIN":                 false,
-		"SYSTEM":                        false,
-		"SYSTEM_TIME":                   false,
-		"TABLE":                         false,
-		"TERMINATED":                    false,
-		"TEXT":                          false,
-		"THEN":                          false,
-		"TIME":                          false,
-		"TIMESTAMP":                     false,
-		"TINYBLOB":                      false,
-		"TINYINT":                       false,
-		"TINYTEXT":                      false,
-		"TO":                            false,
-		"TRAILING":                      false,
-		"TRIGGER":                       false,
-		"TRUE":                          false,
-		"UNDO":                          false,
-		"UNION":                         false,
-		"UNIQUE":                        false,
-		"UNLOCK":                        false,
-		"UNSIGNED":                      false,
-		"UPDATE":                        false,
-		"USAGE":                         false,
-		"USE":                           false,
-		"USING":                         false,
-		"UTC_DATE":                      false,
-		"UTC_TIME":                      false,
-		"UTC_TIMESTAMP":                 false,
-		"VALUES":                        false,
-		"VARBINARY":                     false,
-		"VARCHARACTER":                  false,
-		"VARCHAR":                       false,
-		"VARYING":                       false,
-		"VERSIONING":                    false,
-		"WHEN":                          false,
-		"WHERE":                         false,
-		"WHILE":                         false,
-		"WINDOW":                        false,
-		"WITH":                          false,
-		"WITHOUT":                       false,
-		"WRITE":                         false,
-		"XOR":                           false,
-		"YEAR_MONTH":                    false,
-		"ZEROFILL":                      false,
+// IsNumericLiteral returns a boolean indicating if the supplied string
+// is considered to be a numeric literal in MariaDB
+func (d MariaDBDialect) IsNumericLiteral(s string) bool {
+	return isNumericLiteral(d.numberForm(), s)
+}
+
+// ParseNumericLiteral returns the parsed form of the supplied MariaDB
+// numeric literal
+func (d MariaDBDialect) ParseNumericLiteral(s string) (NumericLiteral, error) {
+	return parseNumericLiteral(d.numberForm(), s)
+}
+
+var mariadbDatatypes = map[string]bool{
+
+	"bigint (n) signed":               true,
+	"bigint (n)":                      true,
+	"bigint (n) unsigned":             true,
+	"bigint (n) zerofill":             true,
+	"bigint signed":                   true,
+	"bigint":                          true,
+	"bigint unsigned":                 true,
+	"bigint zerofill":                 true,
+	"binary (n)":                      true,
+	"binary":                          true,
+	"bit (n)":                         true,
+	"bit":                             true,
+	"blob (n)":                        true,
+	"blob":                            true,
+	"boolean":                         true,
+	"bool":                            true,
+	"char byte (n)":                   true, // compatibility feature
+	"char byte":                       true, // compatibility feature
+	"char (n)":                        true,
+	"char":                            true,
+	"datetime":                        true, // [(fsp)]
+	"datetime (n)":                    true, // [(fsp)]
+	"date":                            true,
+	"decimal (n,n) signed":            true,
+	"decimal (n,n)":                   true,
+	"decimal (n,n) unsigned":          true,
+	"decimal (n,n) zerofill":          true,
+	"decimal (n) signed":              true,
+	"decimal (n)":                     true,
+	"decimal (n) unsigned":            true,
+	"decimal (n) zerofill":            true,
+	"decimal signed":                  true,
+	"decimal":                         true,
+	"decimal unsigned":                true,
+	"decimal zerofill":                true,
+	"dec (n,n) signed":                true, // synonym for decimal
+	"dec (n,n)":                       true, // synonym for decimal
+	"dec (n,n) unsigned":              true, // synonym for decimal
+	"dec (n,n) zerofill":              true, // synonym for decimal
+	"dec (n) signed":                  true, // synonym for decimal
+	"dec (n)":                         true, // synonym for decimal
+	"dec (n) unsigned":                true, // synonym for decimal
+	"dec (n) zerofill":                true, // synonym for decimal
+	"dec signed":                      true, // synonym for decimal
+	"dec":                             true, // synonym for decimal
+	"dec unsigned":                    true, // synonym for decimal
+	"dec zerofill":                    true, // synonym for decimal
+	"double (n,n) signed":             true,
+	"double (n,n)":                    true,
+	"double (n,n) unsigned":           true,
+	"double (n,n) zerofill":           true,
+	"double precision (n,n) signed":   true,
+	"double precision (n,n)":          true,
+	"double precision (n,n) unsigned": true,
+	"double precision (n,n) zerofill": true,
+	"double precision signed":         true,
+	"double precision":                true,
+	"double precision unsigned":       true,
+	"double precision zerofill":       true,
+	"double signed":                   true,
+	"double":                          true,
+	"double unsigned":                 true,
+	"double zerofill":                 true,
+	"enum":                            true,
+	"enum (s)":                        true,
+	"fixed (n,n) signed":              true, // other DB compatibility synonym for decimal
+	"fixed (n,n)":                     true, // other DB compatibility synonym for decimal
+	"fixed (n,n) unsigned":            true, // other DB compatibility synonym for decimal
+	"fixed (n,n) zerofill":            true, // other DB compatibility synonym for decimal
+	"fixed (n) signed":                true, // other DB compatibility synonym for decimal
+	"fixed (n)":                       true, // other DB compatibility synonym for decimal
+	"fixed (n) unsigned":              true, // other DB compatibility synonym for decimal
+	"fixed (n) zerofill":              true, // other DB compatibility synonym for decimal
+	"fixed signed":                    true, // other DB compatibility synonym for decimal
+	"fixed":                           true, // other DB compatibility synonym for decimal
+	"fixed unsigned":                  true, // other DB compatibility synonym for decimal
+	"fixed zerofill":                  true, // other DB compatibility synonym for decimal
+	"float (n) signed":                true,
+	"float (n)":                       true,
+	"float (n) unsigned":              true,
+	"float (n) zerofill":              true,
+	"float (n,n) signed":              true,
+	"float (n,n)":                     true,
+	"float (n,n) unsigned":            true,
+	"float (n,n) zerofill":            true,
+	"float signed":                    true,
+	"float":                           true,
+	"float unsigned":                  true,
+	"float zerofill":                  true,
+	"integer (n) signed":              true,
+	"integer (n)":                     true,
+	"integer (n) unsigned":            true,
+	"integer (n) zerofill":            true,
+	"integer signed":                  true,
+	"integer":                         true,
+	"integer unsigned":                true,
+	"integer zerofill":                true,
+	"int (n) signed":                  true,
+	"int (n)":                         true,
+	"int (n) unsigned":                true,
+	"int (n) zerofill":                true,
+	"int signed":                      true,
+	"int":                             true,
+	"int unsigned":                    true,
+	"int zerofill":                    true,
+	"longblob":                        true,
+	"longtext":                        true,
+	"mediumblob":                      true,
+	"mediumint (n) signed":            true,
+	"mediumint (n)":                   true,
+	"mediumint (n) unsigned":          true,
+	"mediumint (n) zerofill":          true,
+	"mediumint signed":                true,
+	"mediumint":                       true,
+	"mediumint unsigned":              true,
+	"mediumint zerofill":              true,
+	"mediumtext":                      true,
+	"national char (n)":               true,
+	"national char":                   true,
+	"national varchar (n)":            true,
+	"national varchar":                true,
+	"number (n,n) signed":             true, // Oracle mode synonym for decimal
+	"number (n,n)":                    true, // Oracle mode synonym for decimal
+	"number (n,n) unsigned":           true, // Oracle mode synonym for decimal
+	"number (n,n) zerofill":           true, // Oracle mode synonym for decimal
+	"number (n) signed":               true, // Oracle mode synonym for decimal
+	"number (n)":                      true, // Oracle mode synonym for decimal
+	"number (n) unsigned":             true, // Oracle mode synonym for decimal
+	"number (n) zerofill":             true, // Oracle mode synonym for decimal
+	"number signed":                   true, // Oracle mode synonym for decimal
+	"number":                          true, // Oracle mode synonym for decimal
+	"number unsigned":                 true, // Oracle mode synonym for decimal
+	"number zerofill":                 true, // Oracle mode synonym for decimal
+	"numeric (n,n) signed":            true, // synonym for decimal
+	"numeric (n,n)":                   true, // synonym for decimal
+	"numeric (n,n) unsigned":          true, // synonym for decimal
+	"numeric (n,n) zerofill":          true, // synonym for decimal
+	"numeric (n) signed":              true, // synonym for decimal
+	"numeric (n)":                     true, // synonym for decimal
+	"numeric (n) unsigned":            true, // synonym for decimal
+	"numeric (n) zerofill":            true, // synonym for decimal
+	"numeric signed":                  true, // synonym for decimal
+	"numeric":                         true, // synonym for decimal
+	"numeric unsigned":                true, // synonym for decimal
+	"numeric zerofill":                true, // synonym for decimal
+	"real (n,n) signed":               true,
+	"real (n,n)":                      true,
+	"real (n,n) unsigned":             true,
+	"real (n,n) zerofill":             true,
+	"real signed":                     true,
+	"real":                            true,
+	"real unsigned":                   true,
+	"real zerofill":                   true,
+	"set":                             true,
+	"set (s)":                         true,
+	"smallint (n) signed":             true,
+	"smallint (n)":                    true,
+	"smallint (n) unsigned":           true,
+	"smallint (n) zerofill":           true,
+	"smallint signed":                 true,
+	"smallint":                        true,
+	"smallint unsigned":               true,
+	"smallint zerofill":               true,
+	"text (n)":                        true,
+	"text":                            true,
+	"timestamp":                       true, // [(fsp)]
+	"timestamp (n)":                   true, // [(fsp)]
+	"time":                            true, // [(fsp)]
+	"time (n)":                        true, // [(fsp)]
+	"tinyblob":                        true,
+	"tinyint (n) signed":              true,
+	"tinyint (n)":                     true,
+	"tinyint (n) unsigned":            true,
+	"tinyint (n) zerofill":            true,
+	"tinyint signed":                  true,
+	"tinyint":                         true,
+	"tinyint unsigned":                true,
+	"tinyint zerofill":                true,
+	"tinytext":                        true,
+	"varbinary (n)":                   true,
+	"varbinary":                       true,
+	"varchar (n)":                     true,
+	"varchar":                         true,
+	"vector (n)":                      true,
+	"vector":                          true,
+	"year":                            true,
+}
+
+// mariadbLengthDatatypes is the set of MariaDB datatypes whose
+// parameter is a length (as opposed to a precision)
+var mariadbLengthDatatypes = map[string]bool{
+	"binary":           true,
+	"bit":              true,
+	"blob":             true,
+	"char":             true,
+	"char byte":        true,
+	"national char":    true,
+	"national varchar": true,
+	"text":             true,
+	"varbinary":        true,
+	"varchar":          true,
+	"vector":           true,
+}
+
+// mariadbNumericDatatypes is the set of MariaDB numeric datatypes that
+// may be signed, unsigned or zerofill
+var mariadbNumericDatatypes = map[string]bool{
+	"tinyint":          true,
+	"smallint":         true,
+	"mediumint":        true,
+	"int":              true,
+	"integer":          true,
+	"bigint":           true,
+	"decimal":          true,
+	"dec":              true,
+	"numeric":          true,
+	"fixed":            true,
+	"number":           true,
+	"float":            true,
+	"double":           true,
+	"double precision": true,
+	"real":             true,
+}
+
+// mariadbDatatypeParts is the set of words used in MariaDB datatypes
+var mariadbDatatypeParts = datatypeParts(mariadbDatatypes)
+
+// mariadbDatatypeLimits is the range of the parameters of the MariaDB
+// datatypes
+var mariadbDatatypeLimits = map[string]paramLimits{
+	"char":             {maxLength: 255},
+	"character":        {maxLength: 255},
+	"nchar":            {maxLength: 255},
+	"varchar":          {maxLength: 65532},
+	"nvarchar":         {maxLength: 65532},
+	"binary":           {maxLength: 255},
+	"varbinary":        {maxLength: 65532},
+	"bit":              {minLength: 1, maxLength: 64},
+	"tinyint":          {minPrecision: 1, maxPrecision: 255},
+	"smallint":         {minPrecision: 1, maxPrecision: 255},
+	"mediumint":        {minPrecision: 1, maxPrecision: 255},
+	"int":              {minPrecision: 1, maxPrecision: 255},
+	"integer":          {minPrecision: 1, maxPrecision: 255},
+	"bigint":           {minPrecision: 1, maxPrecision: 255},
+	"decimal":          {minPrecision: 1, maxPrecision: 65, maxScale: 38, scaleToPrecision: true},
+	"dec":              {minPrecision: 1, maxPrecision: 65, maxScale: 38, scaleToPrecision: true},
+	"numeric":          {minPrecision: 1, maxPrecision: 65, maxScale: 38, scaleToPrecision: true},
+	"fixed":            {minPrecision: 1, maxPrecision: 65, maxScale: 38, scaleToPrecision: true},
+	"float":            {maxPrecision: 255, maxSinglePrecision: 53, maxScale: 30, scaleToPrecision: true},
+	"double":           {minPrecision: 1, maxPrecision: 255, maxScale: 30, scaleToPrecision: true},
+	"double precision": {minPrecision: 1, maxPrecision: 255, maxScale: 30, scaleToPrecision: true},
+	"real":             {minPrecision: 1, maxPrecision: 255, maxScale: 30, scaleToPrecision: true},
+	"datetime":         {maxPrecision: 6},
+	"time":             {maxPrecision: 6},
+	"timestamp":        {maxPrecision: 6},
+}
+
+// mariadbDatatypeInfo is the metadata for the MariaDB datatypes
+var mariadbDatatypeInfo = map[string]DatatypeInfo{
+	"bigint":           bigintInfo,
+	"binary":           binaryInfo,
+	"bit":              binaryInfo,
+	"blob":             lobInfo,
+	"bool":             booleanInfo,
+	"boolean":          booleanInfo,
+	"char":             charInfo,
+	"char byte":        binaryInfo,
+	"date":             {Category: TemporalCategory, Size: 3, MinValue: "1000-01-01", MaxValue: "9999-12-31"},
+	"datetime":         {Category: TemporalCategory, Size: 5, MinValue: "1000-01-01 00:00:00", MaxValue: "9999-12-31 23:59:59.999999"},
+	"dec":              decimalInfo,
+	"decimal":          decimalInfo,
+	"double":           doubleInfo,
+	"double precision": doubleInfo,
+	"enum":             charInfo,
+	"fixed":            decimalInfo,
+	"float":            realInfo,
+	"int":              integerInfo,
+	"integer":          integerInfo,
+	"longblob":         lobInfo,
+	"longtext":         lobInfo,
+	"mediumblob":       lobInfo,
+	"mediumint":        {Category: ExactNumericCategory, Size: 3, MinValue: "-8388608", MaxValue: "8388607"},
+	"mediumtext":       lobInfo,
+	"national char":    charInfo,
+	"national varchar": charInfo,
+	"number":           decimalInfo,
+	"numeric":          decimalInfo,
+	"real":             doubleInfo,
+	"set":              charInfo,
+	"smallint":         smallintInfo,
+	"text":             lobInfo,
+	"time":             {Category: TemporalCategory, Size: 3, MinValue: "-838:59:59", MaxValue: "838:59:59"},
+	"timestamp":        {Category: TemporalCategory, Size: 4, MinValue: "1970-01-01 00:00:01", MaxValue: "2038-01-19 03:14:07"},
+	"tinyblob":         lobInfo,
+	"tinyint":          tinyintInfo,
+	"tinytext":         lobInfo,
+	"varbinary":        binaryInfo,
+	"varchar":          charInfo,
+	"vector":           otherInfo,
+	"year":             {Category: TemporalCategory, Size: 1, MinValue: "1901", MaxValue: "2155"},
+}
+
+// mariadbDatatypeAliases maps the MariaDB datatype synonyms to their
+// canonical form. This assumes the default SQL mode as real is a
+// synonym for float rather than double when REAL_AS_FLOAT is enabled
+var mariadbDatatypeAliases = map[string]string{
+	"bool":             "tinyint(1)",
+	"boolean":          "tinyint(1)",
+	"dec":              "decimal",
+	"double precision": "double",
+	"fixed":            "decimal",
+	"integer":          "int",
+	"number":           "decimal",
+	"numeric":          "decimal",
+	"real":             "double",
+}
+
+// IsDatatype returns a boolean indicating if the supplied string
+// (or string slice) is considered to be a datatype in MariaDB
+func (d MariaDBDialect) IsDatatype(s ...string) bool {
+	_, err := d.ParseDatatype(s...)
+	return err == nil
+}
+
+// ParseDatatype returns the parsed form of the supplied datatype
+// tokens, or an error if they are not a valid MariaDB datatype
+func (d MariaDBDialect) ParseDatatype(tokens ...string) (Datatype, error) {
+	return parseDatatype(d, d.parseRules(), tokens)
+}
+
+// parseRules returns the rules for parsing MariaDB datatypes
+func (d MariaDBDialect) parseRules() datatypeRules {
+	return datatypeRules{
+		types:     mariadbDatatypes,
+		modifiers: true,
+		lengths:   mariadbLengthDatatypes,
+		numerics:  mariadbNumericDatatypes,
+		limits:    mariadbDatatypeLimits,
 	}
+}
+
+// IsDatatypePart returns a boolean indicating if the supplied string
+// is considered to be part of a datatype definition in MariaDB
+func (d MariaDBDialect) IsDatatypePart(s string) bool {
+	return mariadbDatatypeParts[strings.ToLower(s)]
+}
+
+// DatatypeInfo returns the metadata for the named MariaDB datatype
+func (d MariaDBDialect) DatatypeInfo(name string) (DatatypeInfo, bool) {
+	return datatypeInfo(d, mariadbDatatypeInfo, name)
+}
+
+// Datatypes returns the metadata for all of the MariaDB datatypes
+func (d MariaDBDialect) Datatypes() []DatatypeInfo {
+	return datatypeInfos(mariadbDatatypeInfo)
+}
+
+// CanonicalDatatype returns the supplied datatype with any synonym
+// replaced by the canonical MariaDB form (e.g. integer becomes int)
+func (d MariaDBDialect) CanonicalDatatype(t Datatype) Datatype {
+	return canonicalDatatype(d, mariadbDatatypeAliases, t)
+}
+
+/*
+   MariaDB keywords
+
+   https://mariadb.com/kb/en/library/reserved-words/
+
+    * Some keywords are exceptions for historical reasons, and are permitted as unquoted identifiers.
+    * In Oracle mode, from MariaDB 10.3, there are a number of extra reserved words.
+
+   The isReserved value is set to false as there is no indication (from
+   the above link) if the keywords are reserved or not.
+
+*/
+
+// map[keyword]isReserved
+var mariadbKeywords = map[string]bool{
+	"ACCESSIBLE":                    false,
+	"ACTION":                        false,
+	"ADD":                           false,
+	"ALL":                           false,
+	"ALTER":                         false,
+	"ANALYZE":                       false,
+	"AND":                           false,
+	"ASC":                           false,
+	"ASENSITIVE":                    false,
+	"AS":                            false,
+	"BEFORE":                        false,
+	"BETWEEN":                       false,
+	"BIGINT":                        false,
+	"BINARY":                        false,
+	"BIT":                           false,
+	"BLOB":                          false,
+	"BODY":                          false,
+	"BOTH":                          false,
+	"BY":                            false,
+	"CALL":                          false,
+	"CASCADE":                       false,
+	"CASE":                          false,
+	"CHANGE":                        false,
+	"CHARACTER":                     false,
+	"CHAR":                          false,
+	"CHECK":                         false,
+	"COLLATE":                       false,
+	"COLUMN":                        false,
+	"CONDITION":                     false,
+	"CONSTRAINT":                    false,
+	"CONTINUE":                      false,
+	"CONVERT":                       false,
+	"CREATE":                        false,
+	"CROSS":                         false,
+	"CURRENT_DATE":                  false,
+	"CURRENT_ROLE":                  false,
+	"CURRENT_TIME":                  false,
+	"CURRENT_TIMESTAMP":             false,
+	"CURRENT_USER":                  false,
+	"CURSOR":                        false,
+	"DATABASE":                      false,
+	"DATABASES":                     false,
+	"DATE":                          false,
+	"DAY_HOUR":                      false,
+	"DAY_MICROSECOND":               false,
+	"DAY_MINUTE":                    false,
+	"DAY_SECOND":                    false,
+	"DEC":                           false,
+	"DECIMAL":                       false,
+	"DECLARE":                       false,
+	"DEFAULT":                       false,
+	"DELAYED":                       false,
+	"DELETE":                        false,
+	"DESC":                          false,
+	"DESCRIBE":                      false,
+	"DETERMINISTIC":                 false,
+	"DISTINCT":                      false,
+	"DISTINCTROW":                   false,
+	"DIV":                           false,
+	"DO_DOMAIN_IDS":                 false,
+	"DOUBLE":                        false,
+	"DROP":                          false,
+	"DUAL":                          false,
+	"EACH":                          false,
+	"ELSE":                          false,
+	"ELSEIF":                        false,
+	"ELSIF":                         false,
+	"ENCLOSED":                      false,
+	"ENUM":                          false,
+	"ESCAPED":                       false,
+	"EXCEPT":                        false,
+	"EXISTS":                        false,
+	"EXIT":                          false,
+	"EXPLAIN":                       false,
+	"FALSE":                         false,
+	"FETCH":                         false,
+	"FLOAT4":                        false,
+	"FLOAT8":                        false,
+	"FLOAT":                         false,
+	"FORCE":                         false,
+	"FOREIGN":                       false,
+	"FOR":                           false,
+	"FROM":                          false,
+	"FULLTEXT":                      false,
+	"GENERAL":                       false,
+	"GOTO":                          false,
+	"GRANT":                         false,
+	"GROUP":                         false,
+	"HAVING":                        false,
+	"HIGH_PRIORITY":                 false,
+	"HISTORY":                       false,
+	"HOUR_MICROSECOND":              false,
+	"HOUR_MINUTE":                   false,
+	"HOUR_SECOND":                   false,
+	"IF":                            false,
+	"IGNORE_DOMAIN_IDS":             false,
+	"IGNORE":                        false,
+	"IGNORE_SERVER_IDS":             false,
+	"INDEX":                         false,
+	"IN":                            false,
+	"INFILE":                        false,
+	"INNER":                         false,
+	"INOUT":                         false,
+	"INSENSITIVE":                   false,
+	"INSERT":                        false,
+	"INT1":                          false,
+	"INT2":                          false,
+	"INT3":                          false,
+	"INT4":                          false,
+	"INT8":                          false,
+	"INTEGER":                       false,
+	"INTERSECT":                     false,
+	"INTERVAL":                      false,
+	"INT":                           false,
+	"INTO":                          false,
+	"IS":                            false,
+	"ITERATE":                       false,
+	"JOIN":                          false,
+	"KEY":                           false,
+	"KEYS":                          false,
+	"KILL":                          false,
+	"LEADING":                       false,
+	"LEAVE":                         false,
+	"LEFT":                          false,
+	"LIKE":                          false,
+	"LIMIT":                         false,
+	"LINEAR":                        false,
+	"LINES":                         false,
+	"LOAD":                          false,
+	"LOCALTIME":                     false,
+	"LOCALTIMESTAMP":                false,
+	"LOCK":                          false,
+	"LONGBLOB":                      false,
+	"LONG":                          false,
+	"LONGTEXT":                      false,
+	"LOOP":                          false,
+	"LOW_PRIORITY":                  false,
+	"MASTER_HEARTBEAT_PERIOD":       false,
+	"MASTER_SSL_VERIFY_SERVER_CERT": false,
+	"MATCH":                         false,
+	"MAXVALUE":                      false,
+	"MEDIUMBLOB":                    false,
+	"MEDIUMINT":                     false,
+	"MEDIUMTEXT":                    false,
+	"MIDDLEINT":                     false,
+	"MINUTE_MICROSECOND":            false,
+	"MINUTE_SECOND":                 false,
+	"MOD":                           false,
+	"MODIFIES":                      false,
+	"NATURAL":                       false,
+	"NO":                            false,
+	"NOT":                           false,
+	"NO_WRITE_TO_BINLOG":            false,
+	"NULL":                          false,
+	"NUMERIC":                       false,
+	"ON":                            false,
+	"OPTIMIZE":                      false,
+	"OPTIONALLY":                    false,
+	"OPTION":                        false,
+	"ORDER":                         false,
+	"OR":                            false,
+	"OTHERS":                        false,
+	"OUTER":                         false,
+	"OUT":                           false,
+	"OUTFILE":                       false,
+	"OVER":                          false,
+	"PACKAGE":                       false,
+	"PAGE_CHECKSUM":                 false,
+	"PARSE_VCOL_EXPR":               false,
+	"PARTITION":                     false,
+	"PERIOD":                        false,
+	"PRECISION":                     false,
+	"PRIMARY":                       false,
+	"PROCEDURE":                     false,
+	"PURGE":                         false,
+	"RAISE":                         false,
+	"RANGE":                         false,
+	"READ":                          false,
+	"READS":                         false,
+	"READ_WRITE":                    false,
+	"REAL":                          false,
+	"RECURSIVE":                     false,
+	"REFERENCES":                    false,
+	"REF_SYSTEM_ID":                 false,
+	"REGEXP":                        false,
+	"RELEASE":                       false,
+	"RENAME":                        false,
+	"REPEAT":                        false,
+	"REPLACE":                       false,
+	"REQUIRE":                       false,
+	"RESIGNAL":                      false,
+	"RESTRICT":                      false,
+	"RETURN":                        false,
+	"RETURNING":                     false,
+	"REVOKE":                        false,
+	"RIGHT":                         false,
+	"RLIKE":                         false,
+	"ROWS":                          false,
+	"ROWTYPE":                       false,
+	"SCHEMA":                        false,
+	"SCHEMAS":                       false,
+	"SECOND_MICROSECOND":            false,
+	"SELECT":                        false,
+	"SENSITIVE":                     false,
+	"SEPARATOR":                     false,
+	"SET":                           false,
+	"SHOW":                          false,
+	"SIGNAL":                        false,
+	"SLOW":                          false,
+	"SMALLINT":                      false,
+	"SPATIAL":                       false,
+	"SPECIFIC":                      false,
+	"SQL_BIG_RESULT":                false,
+	"SQL_CALC_FOUND_ROWS":           false,
+	"SQLEXCEPTION":                  false,
+	"SQL":                           false,
+	"SQL_SMALL_RESULT":              false,
+	"SQLSTATE":                      false,
+	"SQLWARNING":                    false,
+	"SSL":                           false,
+	"STARTING":                      false,
+	"STATS_AUTO_RECALC":             false,
+	"STATS_PERSISTENT":              false,
+	"STATS_SAMPLE_PAGES":            false,
+	"STRAIGHT_JOIN":                 false,
+	"SYSTEM":                        false,
+	"SYSTEM_TIME":                   false,
+	"TABLE":                         false,
+	"TERMINATED":                    false,
+	"TEXT":                          false,
+	"THEN":                          false,
+	"TIME":                          false,
+	"TIMESTAMP":                     false,
+	"TINYBLOB":                      false,
+	"TINYINT":                       false,
+	"TINYTEXT":                      false,
+	"TO":                            false,
+	"TRAILING":                      false,
+	"TRIGGER":                       false,
+	"TRUE":                          false,
+	"UNDO":                          false,
+	"UNION":                         false,
+	"UNIQUE":                        false,
+	"UNLOCK":                        false,
+	"UNSIGNED":                      false,
+	"UPDATE":                        false,
+	"USAGE":                         false,
+	"USE":                           false,
+	"USING":                         false,
+	"UTC_DATE":                      false,
+	"UTC_TIME":                      false,
+	"UTC_TIMESTAMP":                 false,
+	"VALUES":                        false,
+	"VARBINARY":                     false,
+	"VARCHARACTER":                  false,
+	"VARCHAR":                       false,
+	"VARYING":                       false,
+	"VERSIONING":                    false,
+	"WHEN":                          false,
+	"WHERE":                         false,
+	"WHILE":                         false,
+	"WINDOW":                        false,
+	"WITH":                          false,
+	"WITHOUT":                       false,
+	"WRITE":                         false,
+	"XOR":                           false,
+	"YEAR_MONTH":                    false,
+	"ZEROFILL":                      false,
+}
+
+func (d MariaDBDialect) keyword(s string) (bool, bool) {
 
 	v, ok := mariadbKeywords[strings.ToUpper(s)]
 
@@ -565,29 +871,29 @@ func (d MariaDBDialect) IsReservedKeyword(s string) bool {
 	return false
 }
 
+var mariadbOperators = map[string]bool{
+	"<":   true,
+	"<=":  true,
+	"<=>": true,
+	"=":   true,
+	">":   true,
+	">=":  true,
+	"||":  true,
+	"-":   true,
+	":=":  true,
+	"!":   true,
+	"!=":  true,
+	"/":   true,
+	"*":   true,
+	"&&":  true,
+	"%":   true,
+	"+":   true,
+}
+
 // IsOperator returns a boolean indicating if the supplied string
 // is considered to be an operator in MariaDB
 func (d MariaDBDialect) IsOperator(s string) bool {
 
-	var mariadbOperators = map[string]bool{
-		"<":   true,
-		"<=":  true,
-		"<=>": true,
-		"=":   true,
-		">":   true,
-		">=":  true,
-		"||":  true,
-		"-":   true,
-		":=":  true,
-		"!":   true,
-		"!=":  true,
-		"/":   true,
-		"*":   true,
-		"&&":  true,
-		"%":   true,
-		"+":   true,
-	}
-
 	_, ok := mariadbOperators[strings.ToUpper(s)]
 	return ok
 }
@@ -655,3 +961,15 @@ func (d MariaDBDialect) IsIdentifier(s string) bool {
 
 	return true
 }
+
+// PlaceholderStyles returns the bind parameter placeholder styles
+// supported by MariaDB, in order of preference
+func (d MariaDBDialect) PlaceholderStyles() []int {
+	return []int{QuestionPlaceholder}
+}
+
+// IsPlaceholder returns a boolean indicating if the supplied string
+// is considered to be a bind parameter placeholder in MariaDB
+func (d MariaDBDialect) IsPlaceholder(s string) bool {
+	return isPlaceholder(d, s)
+}
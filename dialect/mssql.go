@@ -1,7 +1,6 @@
 package dialect
 
 import (
-	"regexp"
 	"strings"
 )
 
@@ -41,294 +40,507 @@ func (d MSSQLDialect) MaxOperatorLength() int {
 	return 2
 }
 
-// IsDatatype returns a boolean indicating if the supplied string
-// (or string slice) is considered to be a datatype in MSSQL
-func (d MSSQLDialect) IsDatatype(s ...string) bool {
+// LineCommentChars returns the character sequences that start a
+// comment that runs to the end of the line
+func (d MSSQLDialect) LineCommentChars() []string {
+	return []string{"--"}
+}
+
+// BlockCommentChars returns the character sequences that start and
+// end a block comment
+func (d MSSQLDialect) BlockCommentChars() (string, string) {
+	return "/*", "*/"
+}
+
+// NestedComments returns a boolean indicating if block comments
+// may be nested in MSSQL
+func (d MSSQLDialect) NestedComments() bool {
+	return true
+}
+
+// IsComment returns a boolean indicating if the supplied string
+// is considered to be a complete comment in MSSQL
+func (d MSSQLDialect) IsComment(s string) bool {
+	return isComment(d, s)
+}
+
+// IsHint returns a boolean indicating if the supplied string
+// is considered to be an optimizer hint in MSSQL
+func (d MSSQLDialect) IsHint(s string) bool {
+	return false
+}
+
+// IsExecutableComment returns a boolean indicating if the supplied
+// string is considered to be an executable comment in MSSQL
+func (d MSSQLDialect) IsExecutableComment(s string) bool {
+	return false
+}
 
-	var mssqlDatatypes = map[string]bool{
-		"bigint":           true,
-		"binary":           true,
-		"binary (n)":       true,
-		"bit":              true,
-		"char":             true,
-		"char (n)":         true,
-		"cursor":           true,
-		"datetime2":        true,
-		"datetimeoffset":   true,
-		"datetime":         true,
-		"date":             true,
-		"decimal":          true,
-		"decimal (n)":      true,
-		"decimal (n,n)":    true,
-		"float":            true,
-		"float (n)":        true,
-		"image":            true,
-		"int":              true,
-		"json":             true,
-		"money":            true,
-		"nchar":            true,
-		"nchar (n)":        true,
-		"ntext":            true,
-		"numeric":          true,
-		"numeric (n)":      true,
-		"numeric (n,n)":    true,
-		"nvarchar":         true,
-		"nvarchar (n)":     true,
-		"nvarchar (max)":   true,
-		"real":             true, // (n)???
-		"smalldatetime":    true,
-		"smallint":         true,
-		"smallmoney":       true,
-		"sql_variant":      true,
-		"table":            true,
-		"text":             true,
-		"timestamp":        true,
-		"time":             true,
-		"tinyint":          true,
-		"uniqueidentifier": true,
-		"varbinary":        true,
-		"varbinary (n)":    true,
-		"varbinary (max)":  true,
-		"varchar":          true,
-		"varchar (n)":      true,
-		"varchar (max)":    true,
-		"vector (n)":       true,
-		"xml":              true,
-		"geography": true,  // GIS extension
-		"geometry": true, // GIS extension
+// stringForms returns the forms of string literal supported by
+// MSSQL
+func (d MSSQLDialect) stringForms() []stringForm {
+	return []stringForm{
+		{"", '\'', stringPlain},
+		{"N", '\'', stringPlain},
 	}
+}
 
-	var z []string
-	rn := regexp.MustCompile(`^[0-9]+$`)
-
-	for i, v := range s {
-		switch strings.ToLower(v) {
-		case "(":
-			z = append(z, " "+v)
-		case ")", ",", "max":
-			z = append(z, v)
-		default:
-			switch {
-			case rn.MatchString(v):
-				z = append(z, "n")
-			case i == 0:
-				z = append(z, v)
-			default:
-				z = append(z, " "+v)
-			}
-		}
+// IsStringLiteral returns a boolean indicating if the supplied string
+// is considered to be a complete string literal in MSSQL
+func (d MSSQLDialect) IsStringLiteral(s string) bool {
+	return isStringLiteral(d.stringForms(), s)
+}
+
+// UnquoteString returns the value of the supplied MSSQL string literal
+func (d MSSQLDialect) UnquoteString(s string) (string, error) {
+	return unquoteString(d.stringForms(), s)
+}
+
+// QuoteString returns the supplied string quoted as a string literal
+// for MSSQL with any special characters escaped
+func (d MSSQLDialect) QuoteString(s string) string {
+	return quoteNational(s)
+}
+
+// identQuotes returns the forms of quoted identifier supported by
+// MSSQL, with the preferred form first
+func (d MSSQLDialect) identQuotes() []identQuote {
+	return []identQuote{
+		{'[', ']', true},
+		{'"', '"', true},
 	}
+}
+
+// QuoteIdentifier returns the supplied identifier quoted for MSSQL
+// with any embedded quote characters escaped
+func (d MSSQLDialect) QuoteIdentifier(s string) (string, error) {
+	return quoteIdentifier(d.identQuotes()[0], s)
+}
+
+// UnquoteIdentifier returns the name of the supplied quoted MSSQL
+// identifier
+func (d MSSQLDialect) UnquoteIdentifier(s string) (string, error) {
+	return unquoteIdentifier(d.identQuotes(), s)
+}
+
+// FormatIdentifier returns the supplied identifier as it should be
+// written in MSSQL, quoting it only when needed
+func (d MSSQLDialect) FormatIdentifier(s string) (string, error) {
 
-	k := strings.ToLower(strings.Join(z, ""))
-	if _, ok := mssqlDatatypes[k]; ok {
-		return true
+	// The keyword list does not indicate which keywords are reserved
+	// so any keyword is quoted
+	if d.IsKeyword(s) {
+		return d.QuoteIdentifier(s)
 	}
+	return formatIdentifier(d, s)
+}
 
-	return false
+// numberForm returns the forms of numeric literal supported by
+// MSSQL
+func (d MSSQLDialect) numberForm() numberForm {
+
+	// 0x1F is a binary constant while $12.50 is a money constant
+	return numberForm{hex: true, money: true}
 }
 
-func (d MSSQLDialect) keyword(s string) (bool, bool) {
+// IsNumericLiteral returns a boolean indicating if the supplied string
+// is considered to be a numeric literal in MSSQL
+func (d MSSQLDialect) IsNumericLiteral(s string) bool {
+	return isNumericLiteral(d.numberForm(), s)
+}
 
-	/*
-	   Microsoft SQL-Server keywords
+// ParseNumericLiteral returns the parsed form of the supplied MSSQL
+// numeric literal
+func (d MSSQLDialect) ParseNumericLiteral(s string) (NumericLiteral, error) {
+	return parseNumericLiteral(d.numberForm(), s)
+}
 
-	   https://docs.microsoft.com/en-us/sql/t-sql/language-elements/reserved-keywords-transact-sql?view=sql-server-ver15
+var mssqlDatatypes = map[string]bool{
+	"bigint":             true,
+	"binary":             true,
+	"binary (n)":         true,
+	"bit":                true,
+	"char":               true,
+	"char (n)":           true,
+	"cursor":             true,
+	"datetime2":          true,
+	"datetime2 (n)":      true,
+	"datetimeoffset":     true,
+	"datetimeoffset (n)": true,
+	"datetime":           true,
+	"date":               true,
+	"decimal":            true,
+	"decimal (n)":        true,
+	"decimal (n,n)":      true,
+	"float":              true,
+	"float (n)":          true,
+	"image":              true,
+	"int":                true,
+	"json":               true,
+	"money":              true,
+	"nchar":              true,
+	"nchar (n)":          true,
+	"ntext":              true,
+	"numeric":            true,
+	"numeric (n)":        true,
+	"numeric (n,n)":      true,
+	"nvarchar":           true,
+	"nvarchar (n)":       true,
+	"nvarchar (max)":     true,
+	"real":               true, // (n)???
+	"smalldatetime":      true,
+	"smallint":           true,
+	"smallmoney":         true,
+	"sql_variant":        true,
+	"table":              true,
+	"text":               true,
+	"timestamp":          true,
+	"time":               true,
+	"time (n)":           true,
+	"tinyint":            true,
+	"uniqueidentifier":   true,
+	"varbinary":          true,
+	"varbinary (n)":      true,
+	"varbinary (max)":    true,
+	"varchar":            true,
+	"varchar (n)":        true,
+	"varchar (max)":      true,
+	"vector (n)":         true,
+	"xml":                true,
+	"geography": true,  // GIS extension
+	"geometry": true, // GIS extension
+}
 
-	   The isReserved value is set to false as there is no indication (from
-	   the above link) if the keywords are reserved or not.
+// mssqlLengthDatatypes is the set of MSSQL datatypes whose parameter
+// is a length (as opposed to a precision)
+var mssqlLengthDatatypes = map[string]bool{
+	"binary":    true,
+	"char":      true,
+	"nchar":     true,
+	"nvarchar":  true,
+	"varbinary": true,
+	"varchar":   true,
+	"vector":    true,
+}
 
-	*/
+// mssqlDatatypeParts is the set of words used in MSSQL datatypes
+var mssqlDatatypeParts = datatypeParts(mssqlDatatypes)
+
+// mssqlDatatypeLimits is the range of the parameters of the MSSQL
+// datatypes
+var mssqlDatatypeLimits = map[string]paramLimits{
+	"char":           {minLength: 1, maxLength: 8000},
+	"varchar":        {minLength: 1, maxLength: 8000},
+	"binary":         {minLength: 1, maxLength: 8000},
+	"varbinary":      {minLength: 1, maxLength: 8000},
+	"nchar":          {minLength: 1, maxLength: 4000},
+	"nvarchar":       {minLength: 1, maxLength: 4000},
+	"decimal":        {minPrecision: 1, maxPrecision: 38, maxScale: 38, scaleToPrecision: true},
+	"numeric":        {minPrecision: 1, maxPrecision: 38, maxScale: 38, scaleToPrecision: true},
+	"float":          {minPrecision: 1, maxPrecision: 53},
+	"datetime2":      {maxPrecision: 7},
+	"datetimeoffset": {maxPrecision: 7},
+	"time":           {maxPrecision: 7},
+	"vector":         {minLength: 1, maxLength: 1998},
+}
+
+// mssqlDatatypeInfo is the metadata for the MSSQL datatypes
+var mssqlDatatypeInfo = map[string]DatatypeInfo{
+	"bigint":           bigintInfo,
+	"binary":           binaryInfo,
+	"bit":              {Category: BooleanCategory, Size: 1, MinValue: "0", MaxValue: "1"},
+	"char":             charInfo,
+	"cursor":           otherInfo,
+	"date":             {Category: TemporalCategory, Size: 3, MinValue: "0001-01-01", MaxValue: "9999-12-31"},
+	"datetime":         {Category: TemporalCategory, Size: 8, MinValue: "1753-01-01 00:00:00", MaxValue: "9999-12-31 23:59:59.997"},
+	"datetime2":        {Category: TemporalCategory, Size: 8, MinValue: "0001-01-01 00:00:00", MaxValue: "9999-12-31 23:59:59.9999999"},
+	"datetimeoffset":   {Category: TemporalCategory, Size: 10, MinValue: "0001-01-01 00:00:00", MaxValue: "9999-12-31 23:59:59.9999999"},
+	"decimal":          decimalInfo,
+	"float":            doubleInfo,
+	"geography":        spatialInfo,
+	"geometry":         spatialInfo,
+	"image":            deprecated(lobInfo),
+	"int":              integerInfo,
+	"json":             jsonInfo,
+	"money":            {Category: ExactNumericCategory, Size: 8, MinValue: "-922337203685477.5808", MaxValue: "922337203685477.5807"},
+	"nchar":            charInfo,
+	"ntext":            deprecated(lobInfo),
+	"numeric":          decimalInfo,
+	"nvarchar":         charInfo,
+	"real":             realInfo,
+	"smalldatetime":    {Category: TemporalCategory, Size: 4, MinValue: "1900-01-01 00:00:00", MaxValue: "2079-06-06 23:59:00"},
+	"smallint":         smallintInfo,
+	"smallmoney":       {Category: ExactNumericCategory, Size: 4, MinValue: "-214748.3648", MaxValue: "214748.3647"},
+	"sql_variant":      otherInfo,
+	"table":            otherInfo,
+	"text":             deprecated(lobInfo),
+	"time":             {Category: TemporalCategory, Size: 5, MinValue: "00:00:00", MaxValue: "23:59:59.9999999"},
+	"timestamp":        deprecated(DatatypeInfo{Category: OtherCategory, Size: 8}), // synonym for rowversion
+	"tinyint":          {Category: ExactNumericCategory, Size: 1, MinValue: "0", MaxValue: "255"},
+	"uniqueidentifier": {Category: OtherCategory, Size: 16},
+	"varbinary":        binaryInfo,
+	"varchar":          charInfo,
+	"vector":           otherInfo,
+	"xml":              xmlInfo,
+}
+
+// mssqlDatatypeAliases maps the MSSQL datatype synonyms to their
+// canonical form
+var mssqlDatatypeAliases = map[string]string{
+	"numeric": "decimal",
+}
+
+// IsDatatype returns a boolean indicating if the supplied string
+// (or string slice) is considered to be a datatype in MSSQL
+func (d MSSQLDialect) IsDatatype(s ...string) bool {
+	_, err := d.ParseDatatype(s...)
+	return err == nil
+}
+
+// ParseDatatype returns the parsed form of the supplied datatype
+// tokens, or an error if they are not a valid MSSQL datatype
+func (d MSSQLDialect) ParseDatatype(tokens ...string) (Datatype, error) {
+	return parseDatatype(d, d.parseRules(), tokens)
+}
+
+// parseRules returns the rules for parsing MSSQL datatypes
+func (d MSSQLDialect) parseRules() datatypeRules {
+	return datatypeRules{types: mssqlDatatypes, lengths: mssqlLengthDatatypes, limits: mssqlDatatypeLimits}
+}
+
+// IsDatatypePart returns a boolean indicating if the supplied string
+// is considered to be part of a datatype definition in MSSQL
+func (d MSSQLDialect) IsDatatypePart(s string) bool {
+	return mssqlDatatypeParts[strings.ToLower(s)]
+}
+
+// DatatypeInfo returns the metadata for the named MSSQL datatype
+func (d MSSQLDialect) DatatypeInfo(name string) (DatatypeInfo, bool) {
+	return datatypeInfo(d, mssqlDatatypeInfo, name)
+}
 
-	// map[keyword]isReserved
-	var mssqlKeywords = map[string]bool{
-		"ADD":                            false,
-		"ALL":                            false,
-		"ALTER":                          false,
-		"AND":                            false,
-		"ANY":                            false,
-		"AS":                             false,
-		"ASC":                            false,
-		"AUTHORIZATION":                  false,
-		"BACKUP":                         false,
-		"BEGIN":                          false,
-		"BETWEEN":                        false,
-		"BREAK":                          false,
-		"BROWSE":                         false,
-		"BULK":                           false,
-		"BY":                             false,
-		"CASCADE":                        false,
-		"CASE":                           false,
-		"CHECK":                          false,
-		"CHECKPOINT":                     false,
-		"CLOSE":                          false,
-		"CLUSTERED":                      false,
-		"COALESCE":                       false,
-		"COLLATE":                        false,
-		"COLUMN":                         false,
-		"COMMIT":                         false,
-		"COMPUTE":                        false,
-		"CONSTRAINT":                     false,
-		"CONTAINS":                       false,
-		"CONTAINSTABLE":                  false,
-		"CONTINUE":                       false,
-		"CONVERT":                        false,
-		"CREATE":                         false,
-		"CROSS":                          false,
-		"CURRENT":                        false,
-		"CURRENT_DATE":                   false,
-		"CURRENT_TIME":                   false,
-		"CURRENT_TIMESTAMP":              false,
-		"CURRENT_USER":                   false,
-		"CURSOR":                         false,
-		"DATABASE":                       false,
-		"DBCC":                           false,
-		"DEALLOCATE":                     false,
-		"DECLARE":                        false,
-		"DEFAULT":                        false,
-		"DELETE":                         false,
-		"DENY":                           false,
-		"DESC":                           false,
-		"DISK":                           false,
-		"DISTINCT":                       false,
-		"DISTRIBUTED":                    false,
-		"DOUBLE":                         false,
-		"DROP":                           false,
-		"DUMP":                           false,
-		"ELSE":                           false,
-		"END":                            false,
-		"ERRLVL":                         false,
-		"ESCAPE":                         false,
-		"EXCEPT":                         false,
-		"EXEC":                           false,
-		"EXECUTE":                        false,
-		"EXISTS":                         false,
-		"EXIT":                           false,
-		"EXTERNAL":                       false,
-		"FETCH":                          false,
-		"FILE":                           false,
-		"FILLFACTOR":                     false,
-		"FOR":                            false,
-		"FOREIGN":                        false,
-		"FREETEXT":                       false,
-		"FREETEXTTABLE":                  false,
-		"FROM":                           false,
-		"FULL":                           false,
-		"FUNCTION":                       false,
-		"GOTO":                           false,
-		"GRANT":                          false,
-		"GROUP":                          false,
-		"HAVING":                         false,
-		"HOLDLOCK":                       false,
-		"IDENTITY":                       false,
-		"IDENTITYCOL":                    false,
-		"IDENTITY_INSERT":                false,
-		"IF":                             false,
-		"IN":                             false,
-		"INDEX":                          false,
-		"INNER":                          false,
-		"INSERT":                         false,
-		"INTERSECT":                      false,
-		"INTO":                           false,
-		"IS":                             false,
-		"JOIN":                           false,
-		"KEY":                            false,
-		"KILL":                           false,
-		"LABEL":                          false,
-		"LEFT":                           false,
-		"LIKE":                           false,
-		"LINENO":                         false,
-		"LOAD":                           false,
-		"MERGE":                          false,
-		"NATIONAL":                       false,
-		"NOCHECK":                        false,
-		"NONCLUSTERED":                   false,
-		"NOT":                            false,
-		"NULL":                           false,
-		"NULLIF":                         false,
-		"OF":                             false,
-		"OFF":                            false,
-		"OFFSETS":                        false,
-		"ON":                             false,
-		"OPEN":                           false,
-		"OPENDATASOURCE":                 false,
-		"OPENQUERY":                      false,
-		"OPENROWSET":                     false,
-		"OPENXML":                        false,
-		"OPTION":                         false,
-		"OR":                             false,
-		"ORDER":                          false,
-		"OUTER":                          false,
-		"OVER":                           false,
-		"PERCENT":                        false,
-		"PIVOT":                          false,
-		"PLAN":                           false,
-		"PRECISION":                      false,
-		"PRIMARY":                        false,
-		"PRINT":                          false,
-		"PROC":                           false,
-		"PROCEDURE":                      false,
-		"PUBLIC":                         false,
-		"RAISERROR":                      false,
-		"READ":                           false,
-		"READTEXT":                       false,
-		"RECONFIGURE":                    false,
-		"REFERENCES":                     false,
-		"REPLICATION":                    false,
-		"RESTORE":                        false,
-		"RESTRICT":                       false,
-		"RETURN":                         false,
-		"REVERT":                         false,
-		"REVOKE":                         false,
-		"RIGHT":                          false,
-		"ROLLBACK":                       false,
-		"ROWCOUNT":                       false,
-		"ROWGUIDCOL":                     false,
-		"RULE":                           false,
-		"SAVE":                           false,
-		"SCHEMA":                         false,
-		"SECURITYAUDIT":                  false,
-		"SELECT":                         false,
-		"SEMANTICKEYPHRASETABLE":         false,
-		"SEMANTICSIMILARITYDETAILSTABLE": false,
-		"SEMANTICSIMILARITYTABLE":        false,
-		"SESSION_USER":                   false,
-		"SET":                            false,
-		"SETUSER":                        false,
-		"SHUTDOWN":                       false,
-		"SOME":                           false,
-		"STATISTICS":                     false,
-		"SYSTEM_USER":                    false,
-		"TABLE":                          false,
-		"TABLESAMPLE":                    false,
-		"TEXTSIZE":                       false,
-		"THEN":                           false,
-		"TO":                             false,
-		"TOP":                            false,
-		"TRAN":                           false,
-		"TRANSACTION":                    false,
-		"TRIGGER":                        false,
-		"TRUNCATE":                       false,
-		"TRY_CONVERT":                    false,
-		"TSEQUAL":                        false,
-		"UNION":                          false,
-		"UNIQUE":                         false,
-		"UNPIVOT":                        false,
-		"UPDATE":                         false,
-		"UPDATETEXT":                     false,
-		"USE":                            false,
-		"USER":                           false,
-		"VALUES":                         false,
-		"VARYING":                        false,
-		"VIEW":                           false,
-		"WAITFOR":                        false,
-		"WHEN":                           false,
-		"WHERE":                          false,
-		"WHILE":                          false,
-		"WITH":                           false,
-		"WITHIN GROUP":                   false,
-		"WRITETEXT":                      false,
+// Datatypes returns the metadata for all of the MSSQL datatypes
+func (d MSSQLDialect) Datatypes() []DatatypeInfo {
+	return datatypeInfos(mssqlDatatypeInfo)
+}
+
+// CanonicalDatatype returns the supplied datatype with any synonym
+// replaced by the canonical MSSQL form (e.g. numeric becomes decimal)
+func (d MSSQLDialect) CanonicalDatatype(t Datatype) Datatype {
+
+	if t.Name == "float" && t.HasPrecision {
+		// float (1) to float (24) is real, float (25) to float (53) is float
+		if t.Precision <= 24 {
+			return Datatype{Name: "real"}
+		}
+		return Datatype{Name: "float"}
 	}
 
+	return canonicalDatatype(d, mssqlDatatypeAliases, t)
+}
+
+/*
+   Microsoft SQL-Server keywords
+
+   https://docs.microsoft.com/en-us/sql/t-sql/language-elements/reserved-keywords-transact-sql?view=sql-server-ver15
+
+   The isReserved value is set to false as there is no indication (from
+   the above link) if the keywords are reserved or not.
+
+*/
+
+// map[keyword]isReserved
+var mssqlKeywords = map[string]bool{
+	"ADD":                            false,
+	"ALL":                            false,
+	"ALTER":                          false,
+	"AND":                            false,
+	"ANY":                            false,
+	"AS":                             false,
+	"ASC":                            false,
+	"AUTHORIZATION":                  false,
+	"BACKUP":                         false,
+	"BEGIN":                          false,
+	"BETWEEN":                        false,
+	"BREAK":                          false,
+	"BROWSE":                         false,
+	"BULK":                           false,
+	"BY":                             false,
+	"CASCADE":                        false,
+	"CASE":                           false,
+	"CHECK":                          false,
+	"CHECKPOINT":                     false,
+	"CLOSE":                          false,
+	"CLUSTERED":                      false,
+	"COALESCE":                       false,
+	"COLLATE":                        false,
+	"COLUMN":                         false,
+	"COMMIT":                         false,
+	"COMPUTE":                        false,
+	"CONSTRAINT":                     false,
+	"CONTAINS":                       false,
+	"CONTAINSTABLE":                  false,
+	"CONTINUE":                       false,
+	"CONVERT":                        false,
+	"CREATE":                         false,
+	"CROSS":                          false,
+	"CURRENT":                        false,
+	"CURRENT_DATE":                   false,
+	"CURRENT_TIME":                   false,
+	"CURRENT_TIMESTAMP":              false,
+	"CURRENT_USER":                   false,
+	"CURSOR":                         false,
+	"DATABASE":                       false,
+	"DBCC":                           false,
+	"DEALLOCATE":                     false,
+	"DECLARE":                        false,
+	"DEFAULT":                        false,
+	"DELETE":                         false,
+	"DENY":                           false,
+	"DESC":                           false,
+	"DISK":                           false,
+	"DISTINCT":                       false,
+	"DISTRIBUTED":                    false,
+	"DOUBLE":                         false,
+	"DROP":                           false,
+	"DUMP":                           false,
+	"ELSE":                           false,
+	"END":                            false,
+	"ERRLVL":                         false,
+	"ESCAPE":                         false,
+	"EXCEPT":                         false,
+	"EXEC":                           false,
+	"EXECUTE":                        false,
+	"EXISTS":                         false,
+	"EXIT":                           false,
+	"EXTERNAL":                       false,
+	"FETCH":                          false,
+	"FILE":                           false,
+	"FILLFACTOR":                     false,
+	"FOR":                            false,
+	"FOREIGN":                        false,
+	"FREETEXT":                       false,
+	"FREETEXTTABLE":                  false,
+	"FROM":                           false,
+	"FULL":                           false,
+	"FUNCTION":                       false,
+	"GOTO":                           false,
+	"GRANT":                          false,
+	"GROUP":                          false,
+	"HAVING":                         false,
+	"HOLDLOCK":                       false,
+	"IDENTITY":                       false,
+	"IDENTITYCOL":                    false,
+	"IDENTITY_INSERT":                false,
+	"IF":                             false,
+	"IN":                             false,
+	"INDEX":                          false,
+	"INNER":                          false,
+	"INSERT":                         false,
+	"INTERSECT":                      false,
+	"INTO":                           false,
+	"IS":                             false,
+	"JOIN":                           false,
+	"KEY":                            false,
+	"KILL":                           false,
+	"LABEL":                          false,
+	"LEFT":                           false,
+	"LIKE":                           false,
+	"LINENO":                         false,
+	"LOAD":                           false,
+	"MERGE":                          false,
+	"NATIONAL":                       false,
+	"NOCHECK":                        false,
+	"NONCLUSTERED":                   false,
+	"NOT":                            false,
+	"NULL":                           false,
+	"NULLIF":                         false,
+	"OF":                             false,
+	"OFF":                            false,
+	"OFFSETS":                        false,
+	"ON":                             false,
+	"OPEN":                           false,
+	"OPENDATASOURCE":                 false,
+	"OPENQUERY":                      false,
+	"OPENROWSET":                     false,
+	"OPENXML":                        false,
+	"OPTION":                         false,
+	"OR":                             false,
+	"ORDER":                          false,
+	"OUTER":                          false,
+	"OVER":                           false,
+	"PERCENT":                        false,
+	"PIVOT":                          false,
+	"PLAN":                           false,
+	"PRECISION":                      false,
+	"PRIMARY":                        false,
+	"PRINT":                          false,
+	"PROC":                           false,
+	"PROCEDURE":                      false,
+	"PUBLIC":                         false,
+	"RAISERROR":                      false,
+	"READ":                           false,
+	"READTEXT":                       false,
+	"RECONFIGURE":                    false,
+	"REFERENCES":                     false,
+	"REPLICATION":                    false,
+	"RESTORE":                        false,
+	"RESTRICT":                       false,
+	"RETURN":                         false,
+	"REVERT":                         false,
+	"REVOKE":                         false,
+	"RIGHT":                          false,
+	"ROLLBACK":                       false,
+	"ROWCOUNT":                       false,
+	"ROWGUIDCOL":                     false,
+	"RULE":                           false,
+	"SAVE":                           false,
+	"SCHEMA":                         false,
+	"SECURITYAUDIT":                  false,
+	"SELECT":                         false,
+	"SEMANTICKEYPHRASETABLE":         false,
+	"SEMANTICSIMILARITYDETAILSTABLE": false,
+	"SEMANTICSIMILARITYTABLE":        false,
+	"SESSION_USER":                   false,
+	"SET":                            false,
+	"SETUSER":                        false,
+	"SHUTDOWN":                       false,
+	"SOME":                           false,
+	"STATISTICS":                     false,
+	"SYSTEM_USER":                    false,
+	"TABLE":                          false,
+	"TABLESAMPLE":                    false,
+	"TEXTSIZE":                       false,
+	"THEN":                           false,
+	"TO":                             false,
+	"TOP":                            false,
+	"TRAN":                           false,
+	"TRANSACTION":                    false,
+	"TRIGGER":                        false,
+	"TRUNCATE":                       false,
+	"TRY_CONVERT":                    false,
+	"TSEQUAL":                        false,
+	"UNION":                          false,
+	"UNIQUE":                         false,
+	"UNPIVOT":                        false,
+	"UPDATE":                         false,
+	"UPDATETEXT":                     false,
+	"USE":                            false,
+	"USER":                           false,
+	"VALUES":                         false,
+	"VARYING":                        false,
+	"VIEW":                           false,
+	"WAITFOR":                        false,
+	"WHEN":                           false,
+	"WHERE":                          false,
+	"WHILE":                          false,
+	"WITH":                           false,
+	"WITHIN GROUP":                   false,
+	"WRITETEXT":                      false,
+}
+
+func (d MSSQLDialect) keyword(s string) (bool, bool) {
+
 	v, ok := mssqlKeywords[strings.ToUpper(s)]
 
 	return ok, v
@@ -352,40 +564,40 @@ func (d MSSQLDialect) IsReservedKeyword(s string) bool {
 	return false
 }
 
+var mssqlOperators = map[string]bool{
+	"^":  true,
+	"^=": true,
+	"~":  true,
+	"<":  true,
+	"<=": true,
+	"<>": true,
+	"=":  true,
+	">":  true,
+	">=": true,
+	"|":  true,
+	"|=": true,
+	"-":  true,
+	"-=": true,
+	"::": true,
+	"!<": true,
+	"!=": true,
+	"!>": true,
+	"/":  true,
+	"/=": true,
+	"*":  true,
+	"*=": true,
+	"&":  true,
+	"&=": true,
+	"%":  true,
+	"%=": true,
+	"+":  true,
+	"+=": true,
+}
+
 // IsOperator returns a boolean indicating if the supplied string
 // is considered to be an operator in MSSQL
 func (d MSSQLDialect) IsOperator(s string) bool {
 
-	var mssqlOperators = map[string]bool{
-		"^":  true,
-		"^=": true,
-		"~":  true,
-		"<":  true,
-		"<=": true,
-		"<>": true,
-		"=":  true,
-		">":  true,
-		">=": true,
-		"|":  true,
-		"|=": true,
-		"-":  true,
-		"-=": true,
-		"::": true,
-		"!<": true,
-		"!=": true,
-		"!>": true,
-		"/":  true,
-		"/=": true,
-		"*":  true,
-		"*=": true,
-		"&":  true,
-		"&=": true,
-		"%":  true,
-		"%=": true,
-		"+":  true,
-		"+=": true,
-	}
-
 	_, ok := mssqlOperators[s]
 	return ok
 }
@@ -458,3 +670,18 @@ func (d MSSQLDialect) IsIdentifier(s string) bool {
 
 	return true
 }
+
+// PlaceholderStyles returns the bind parameter placeholder styles
+// supported by MSSQL, in order of preference
+func (d MSSQLDialect) PlaceholderStyles() []int {
+
+	// Parameters are named, with positional parameters conventionally
+	// named @p1, @p2, etc.
+	return []int{AtNamePlaceholder}
+}
+
+// IsPlaceholder returns a boolean indicating if the supplied string
+// is considered to be a bind parameter placeholder in MSSQL
+func (d MSSQLDialect) IsPlaceholder(s string) bool {
+	return isPlaceholder(d, s)
+}
@@ -1,13 +1,16 @@
 package dialect
 
 import (
-	"regexp"
 	"strings"
 )
 
 type MySQLDialect struct {
 	dialect int
 	name    string
+
+	// noBackslashEscapes indicates that the NO_BACKSLASH_ESCAPES SQL
+	// mode is enabled so backslash is an ordinary character in strings
+	noBackslashEscapes bool
 }
 
 func NewMySQLDialect() *MySQLDialect {
@@ -19,6 +22,13 @@ func NewMySQLDialect() *MySQLDialect {
 	return &d
 }
 
+// SetNoBackslashEscapes sets whether the NO_BACKSLASH_ESCAPES SQL mode
+// is enabled, in which case backslash is not an escape character in
+// string literals
+func (d *MySQLDialect) SetNoBackslashEscapes(b bool) {
+	d.noBackslashEscapes = b
+}
+
 func (d MySQLDialect) Dialect() int {
 	return d.dialect
 }
@@ -41,748 +51,1034 @@ func (d MySQLDialect) MaxOperatorLength() int {
 	return 3
 }
 
-// IsDatatype returns a boolean indicating if the supplied string
-// (or string slice) is considered to be a datatype in MySQL
-func (d MySQLDialect) IsDatatype(s ...string) bool {
+// LineCommentChars returns the character sequences that start a
+// comment that runs to the end of the line
+func (d MySQLDialect) LineCommentChars() []string {
+	return []string{"--", "#"}
+}
+
+// BlockCommentChars returns the character sequences that start and
+// end a block comment
+func (d MySQLDialect) BlockCommentChars() (string, string) {
+	return "/*", "*/"
+}
+
+// NestedComments returns a boolean indicating if block comments
+// may be nested in MySQL
+func (d MySQLDialect) NestedComments() bool {
+	return false
+}
+
+// IsComment returns a boolean indicating if the supplied string
+// is considered to be a complete comment in MySQL
+func (d MySQLDialect) IsComment(s string) bool {
 
-	var mysqlDatatypes = map[string]bool{
-		"bigint":                 true, // [(n)]
-		"bigint (n)":             true, // [(n)]
-		"binary":                 true, // [(n)]
-		"binary (n)":             true, // [(n)]
-		"bit":                    true,
-		"blob":                   true,
-		"boolean":                true,
-		"bool":                   true,
-		"char":                   true, // (n)
-		"char (n)":               true, // (n)
-		"character":              true, // (n)
-		"character (n)":          true, // (n)
-		"datetime":               true,
-		"date":                   true,
-		"decimal":                true, // [(p[,s])]
-		"decimal (n)":            true, // [(p[,s])]
-		"decimal (n,n)":          true, // [(p[,s])]
-		"dec":                    true, // [(p[,s])]
-		"dec (n)":                true, // [(p[,s])]
-		"dec (n,n)":              true, // [(p[,s])]
-		"double precision":       true, // [(p[,s])]
-		"double precision (n)":   true, // [(p[,s])]
-		"double precision (n,n)": true, // [(p[,s])]
-		"double":                 true, // [(p[,s])]
-		"double (n)":             true, // [(p[,s])]
-		"double (n,n)":           true, // [(p[,s])]
-		"enum":                   true,
-		"float":                  true, // [(p[,s])]
-		"float (n)":              true, // [(p[,s])]
-		"float (n,n)":            true, // [(p[,s])]
-		"integer":                true, // [(n)]
-		"integer (n)":            true, // [(n)]
-		"int":                    true, // [(n)]
-		"int (n)":                true, // [(n)]
-		"longblob":               true,
-		"longtext":               true,
-		"mediumblob":             true,
-		"mediumint":              true, // (n)
-		"mediumint (n)":          true, // (n)
-		"mediumtext":             true,
-		"nchar":                  true, // (n)
-		"nchar (n)":              true, // (n)
-		"nvarchar":               true, // (n)
-		"nvarchar (n)":           true, // (n)
-		"numeric":                true, // (p,s)
-		"numeric (n)":            true, // (p,s)
-		"numeric (n,n)":          true, // (p,s)
-		"real":                   true, // (p,s)
-		"real (n)":               true, // (p,s)
-		"real (n,n)":             true, // (p,s)
-		"set":                    true,
-		"smallint":               true, // [(n)]
-		"smallint (n)":           true, // [(n)]
-		"text":                   true,
-		"timestamp":              true,
-		"time":                   true,
-		"tinyblob":               true,
-		"tinyint":                true,
-		"tinytext":               true,
-		"varbinary":              true, // (n)
-		"varbinary (n)":          true, // (n)
-		"varchar":                true,
-		"year":                   true,
-		"geometry":               true, //GIS extension
-		"geometrycollection":     true, //GIS extension
-		"linestring":             true, //GIS extension
-		"multilinestring":        true, //GIS extension
-		"multipoint":             true, //GIS extension
-		"multipolygon":           true, //GIS extension
-		"point":                  true, //GIS extension
-		"polygon":                true, //GIS extension
+	// "The -- (double-dash) comment style requires the second dash to
+	// be followed by at least one whitespace or control character"
+	if strings.HasPrefix(s, "--") && len(s) > 2 && s[2] > ' ' {
+		return false
 	}
+	return isComment(d, s)
+}
 
-	var z []string
-	rn := regexp.MustCompile(`^[0-9]+$`)
-
-	for i, v := range s {
-		switch v {
-		case "(":
-			z = append(z, " "+v)
-		case ")", ",":
-			z = append(z, v)
-		default:
-			switch {
-			case rn.MatchString(v):
-				z = append(z, "n")
-			case i == 0:
-				z = append(z, v)
-			default:
-				z = append(z, " "+v)
-			}
-		}
+// IsHint returns a boolean indicating if the supplied string
+// is considered to be an optimizer hint in MySQL
+func (d MySQLDialect) IsHint(s string) bool {
+	return d.IsComment(s) && strings.HasPrefix(s, "/*+")
+}
+
+// IsExecutableComment returns a boolean indicating if the supplied
+// string is considered to be an executable comment in MySQL
+func (d MySQLDialect) IsExecutableComment(s string) bool {
+	return d.IsComment(s) && strings.HasPrefix(s, "/*!")
+}
+
+// stringForms returns the forms of string literal supported by
+// MySQL
+func (d MySQLDialect) stringForms() []stringForm {
+
+	style := stringBackslash
+	if d.noBackslashEscapes {
+		style = stringPlain
+	}
+
+	// Double quoted strings are strings unless the ANSI_QUOTES SQL mode
+	// is enabled, "_" is a character set introducer (e.g. _utf8mb4'...')
+	return []stringForm{
+		{"", '\'', style},
+		{"", '"', style},
+		{"N", '\'', style},
+		{"_", '\'', style},
+		{"_", '"', style},
+		{"X", '\'', stringHex},
+		{"B", '\'', stringBit},
 	}
+}
+
+// IsStringLiteral returns a boolean indicating if the supplied string
+// is considered to be a complete string literal in MySQL
+func (d MySQLDialect) IsStringLiteral(s string) bool {
+	return isStringLiteral(d.stringForms(), s)
+}
+
+// UnquoteString returns the value of the supplied MySQL string literal
+func (d MySQLDialect) UnquoteString(s string) (string, error) {
+	return unquoteString(d.stringForms(), s)
+}
 
-	k := strings.ToLower(strings.Join(z, ""))
-	if _, ok := mysqlDatatypes[k]; ok {
-		return true
+// QuoteString returns the supplied string quoted as a string literal
+// for MySQL with any special characters escaped
+func (d MySQLDialect) QuoteString(s string) string {
+
+	if d.noBackslashEscapes {
+		return quotePlain(s)
 	}
+	return quoteBackslash(s)
+}
 
-	return false
+// identQuotes returns the forms of quoted identifier supported by
+// MySQL, with the preferred form first
+func (d MySQLDialect) identQuotes() []identQuote {
+	return []identQuote{
+		{'`', '`', true},
+	}
 }
 
-func (d MySQLDialect) keyword(s string) (bool, bool) {
+// QuoteIdentifier returns the supplied identifier quoted for MySQL
+// with any embedded quote characters escaped
+func (d MySQLDialect) QuoteIdentifier(s string) (string, error) {
+	return quoteIdentifier(d.identQuotes()[0], s)
+}
 
-	/*
-	   MySQL keywords
+// UnquoteIdentifier returns the name of the supplied quoted MySQL
+// identifier
+func (d MySQLDialect) UnquoteIdentifier(s string) (string, error) {
+	return unquoteIdentifier(d.identQuotes(), s)
+}
 
-	   https://dev.mysql.com/doc/refman/5.7/en/keywords.html
+// FormatIdentifier returns the supplied identifier as it should be
+// written in MySQL, quoting it only when needed
+func (d MySQLDialect) FormatIdentifier(s string) (string, error) {
+	return formatIdentifier(d, s)
+}
 
-	   Those keywords that had no indicator if they were reserved or not were set to false.
+// numberForm returns the forms of numeric literal supported by
+// MySQL
+func (d MySQLDialect) numberForm() numberForm {
 
-	*/
+	// X'1F' and B'101' are hexadecimal and bit-value literals that are
+	// treated as numbers in numeric contexts (and as binary strings
+	// otherwise)
+	return numberForm{hex: true, binary: true, quoted: true}
+}
+
+// IsNumericLiteral returns a boolean indicating if the supplied string
+// is considered to be a numeric literal in MySQL
+func (d MySQLDialect) IsNumericLiteral(s string) bool {
+	return isNumericLiteral(d.numberForm(), s)
+}
+
+// ParseNumericLiteral returns the parsed form of the supplied MySQL
+// numeric literal
+func (d MySQLDialect) ParseNumericLiteral(s string) (NumericLiteral, error) {
+	return parseNumericLiteral(d.numberForm(), s)
+}
+
+var mysqlDatatypes = map[string]bool{
+	"bigint":                 true, // [(n)]
+	"bigint (n)":             true, // [(n)]
+	"binary":                 true, // [(n)]
+	"binary (n)":             true, // [(n)]
+	"bit":                    true, // [(n)]
+	"bit (n)":                true, // [(n)]
+	"blob":                   true, // [(n)]
+	"blob (n)":               true, // [(n)]
+	"boolean":                true,
+	"bool":                   true,
+	"char":                   true, // (n)
+	"char (n)":               true, // (n)
+	"character":              true, // (n)
+	"character (n)":          true, // (n)
+	"datetime":               true, // [(fsp)]
+	"datetime (n)":           true, // [(fsp)]
+	"date":                   true,
+	"decimal":                true, // [(p[,s])]
+	"decimal (n)":            true, // [(p[,s])]
+	"decimal (n,n)":          true, // [(p[,s])]
+	"dec":                    true, // [(p[,s])]
+	"dec (n)":                true, // [(p[,s])]
+	"dec (n,n)":              true, // [(p[,s])]
+	"double precision":       true, // [(p[,s])]
+	"double precision (n)":   true, // [(p[,s])]
+	"double precision (n,n)": true, // [(p[,s])]
+	"double":                 true, // [(p[,s])]
+	"double (n)":             true, // [(p[,s])]
+	"double (n,n)":           true, // [(p[,s])]
+	"enum":                   true,
+	"enum (s)":               true,
+	"float":                  true, // [(p[,s])]
+	"float (n)":              true, // [(p[,s])]
+	"float (n,n)":            true, // [(p[,s])]
+	"integer":                true, // [(n)]
+	"integer (n)":            true, // [(n)]
+	"int":                    true, // [(n)]
+	"int (n)":                true, // [(n)]
+	"longblob":               true,
+	"longtext":               true,
+	"mediumblob":             true,
+	"mediumint":              true, // (n)
+	"mediumint (n)":          true, // (n)
+	"mediumtext":             true,
+	"nchar":                  true, // (n)
+	"nchar (n)":              true, // (n)
+	"nvarchar":               true, // (n)
+	"nvarchar (n)":           true, // (n)
+	"numeric":                true, // (p,s)
+	"numeric (n)":            true, // (p,s)
+	"numeric (n,n)":          true, // (p,s)
+	"real":                   true, // (p,s)
+	"real (n)":               true, // (p,s)
+	"real (n,n)":             true, // (p,s)
+	"set":                    true,
+	"set (s)":                true,
+	"smallint":               true, // [(n)]
+	"smallint (n)":           true, // [(n)]
+	"text":                   true, // [(n)]
+	"text (n)":               true, // [(n)]
+	"timestamp":              true, // [(fsp)]
+	"timestamp (n)":          true, // [(fsp)]
+	"time":                   true, // [(fsp)]
+	"time (n)":               true, // [(fsp)]
+	"tinyblob":               true,
+	"tinyint":                true, // [(n)]
+	"tinyint (n)":            true, // [(n)]
+	"tinytext":               true,
+	"varbinary":              true, // (n)
+	"varbinary (n)":          true, // (n)
+	"varchar":                true, // (n)
+	"varchar (n)":            true, // (n)
+	"year":                   true,
+	"geometry":               true, //GIS extension
+	"geometrycollection":     true, //GIS extension
+	"linestring":             true, //GIS extension
+	"multilinestring":        true, //GIS extension
+	"multipoint":             true, //GIS extension
+	"multipolygon":           true, //GIS extension
+	"point":                  true, //GIS extension
+	"polygon":                true, //GIS extension
+}
+
+// mysqlLengthDatatypes is the set of MySQL datatypes whose parameter
+// is a length (as opposed to a precision)
+var mysqlLengthDatatypes = map[string]bool{
+	"binary":    true,
+	"bit":       true,
+	"blob":      true,
+	"char":      true,
+	"character": true,
+	"nchar":     true,
+	"nvarchar":  true,
+	"text":      true,
+	"varbinary": true,
+	"varchar":   true,
+}
+
+// mysqlNumericDatatypes is the set of MySQL numeric datatypes that
+// may be signed, unsigned or zerofill
+var mysqlNumericDatatypes = map[string]bool{
+	"tinyint":          true,
+	"smallint":         true,
+	"mediumint":        true,
+	"int":              true,
+	"integer":          true,
+	"bigint":           true,
+	"decimal":          true,
+	"dec":              true,
+	"numeric":          true,
+	"float":            true,
+	"double":           true,
+	"double precision": true,
+	"real":             true,
+}
+
+// mysqlDatatypeParts is the set of words used in MySQL datatypes
+var mysqlDatatypeParts = datatypeParts(mysqlDatatypes, "signed", "unsigned", "zerofill")
+
+// mysqlDatatypeLimits is the range of the parameters of the MySQL
+// datatypes
+var mysqlDatatypeLimits = map[string]paramLimits{
+	"char":             {maxLength: 255},
+	"character":        {maxLength: 255},
+	"nchar":            {maxLength: 255},
+	"varchar":          {maxLength: 65535},
+	"nvarchar":         {maxLength: 65535},
+	"binary":           {maxLength: 255},
+	"varbinary":        {maxLength: 65535},
+	"bit":              {minLength: 1, maxLength: 64},
+	"tinyint":          {minPrecision: 1, maxPrecision: 255},
+	"smallint":         {minPrecision: 1, maxPrecision: 255},
+	"mediumint":        {minPrecision: 1, maxPrecision: 255},
+	"int":              {minPrecision: 1, maxPrecision: 255},
+	"integer":          {minPrecision: 1, maxPrecision: 255},
+	"bigint":           {minPrecision: 1, maxPrecision: 255},
+	"decimal":          {minPrecision: 1, maxPrecision: 65, maxScale: 30, scaleToPrecision: true},
+	"dec":              {minPrecision: 1, maxPrecision: 65, maxScale: 30, scaleToPrecision: true},
+	"numeric":          {minPrecision: 1, maxPrecision: 65, maxScale: 30, scaleToPrecision: true},
+	"fixed":            {minPrecision: 1, maxPrecision: 65, maxScale: 30, scaleToPrecision: true},
+	"float":            {maxPrecision: 255, maxSinglePrecision: 53, maxScale: 30, scaleToPrecision: true},
+	"double":           {minPrecision: 1, maxPrecision: 255, maxScale: 30, scaleToPrecision: true},
+	"double precision": {minPrecision: 1, maxPrecision: 255, maxScale: 30, scaleToPrecision: true},
+	"real":             {minPrecision: 1, maxPrecision: 255, maxScale: 30, scaleToPrecision: true},
+	"datetime":         {maxPrecision: 6},
+	"time":             {maxPrecision: 6},
+	"timestamp":        {maxPrecision: 6},
+}
+
+// mysqlDatatypeInfo is the metadata for the MySQL datatypes
+var mysqlDatatypeInfo = map[string]DatatypeInfo{
+	"bigint":             bigintInfo,
+	"binary":             binaryInfo,
+	"bit":                binaryInfo,
+	"blob":               lobInfo,
+	"bool":               booleanInfo,
+	"boolean":            booleanInfo,
+	"char":               charInfo,
+	"character":          charInfo,
+	"date":               {Category: TemporalCategory, Size: 3, MinValue: "1000-01-01", MaxValue: "9999-12-31"},
+	"datetime":           {Category: TemporalCategory, Size: 5, MinValue: "1000-01-01 00:00:00", MaxValue: "9999-12-31 23:59:59.999999"},
+	"dec":                decimalInfo,
+	"decimal":            decimalInfo,
+	"double":             doubleInfo,
+	"double precision":   doubleInfo,
+	"enum":               charInfo,
+	"float":              realInfo,
+	"geometry":           spatialInfo,
+	"geometrycollection": spatialInfo,
+	"int":                integerInfo,
+	"integer":            integerInfo,
+	"linestring":         spatialInfo,
+	"longblob":           lobInfo,
+	"longtext":           lobInfo,
+	"mediumblob":         lobInfo,
+	"mediumint":          {Category: ExactNumericCategory, Size: 3, MinValue: "-8388608", MaxValue: "8388607"},
+	"mediumtext":         lobInfo,
+	"multilinestring":    spatialInfo,
+	"multipoint":         spatialInfo,
+	"multipolygon":       spatialInfo,
+	"nchar":              charInfo,
+	"numeric":            decimalInfo,
+	"nvarchar":           charInfo,
+	"point":              spatialInfo,
+	"polygon":            spatialInfo,
+	"real":               doubleInfo,
+	"set":                charInfo,
+	"smallint":           smallintInfo,
+	"text":               lobInfo,
+	"time":               {Category: TemporalCategory, Size: 3, MinValue: "-838:59:59", MaxValue: "838:59:59"},
+	"timestamp":          {Category: TemporalCategory, Size: 4, MinValue: "1970-01-01 00:00:01", MaxValue: "2038-01-19 03:14:07"},
+	"tinyblob":           lobInfo,
+	"tinyint":            tinyintInfo,
+	"tinytext":           lobInfo,
+	"varbinary":          binaryInfo,
+	"varchar":            charInfo,
+	"year":               {Category: TemporalCategory, Size: 1, MinValue: "1901", MaxValue: "2155"},
+}
+
+// mysqlDatatypeAliases maps the MySQL datatype synonyms to their
+// canonical form. This assumes the default SQL mode as real is a
+// synonym for float rather than double when REAL_AS_FLOAT is enabled
+var mysqlDatatypeAliases = map[string]string{
+	"bool":             "tinyint(1)",
+	"boolean":          "tinyint(1)",
+	"character":        "char",
+	"dec":              "decimal",
+	"double precision": "double",
+	"integer":          "int",
+	"numeric":          "decimal",
+	"real":             "double",
+}
+
+// IsDatatype returns a boolean indicating if the supplied string
+// (or string slice) is considered to be a datatype in MySQL
+func (d MySQLDialect) IsDatatype(s ...string) bool {
+	_, err := d.ParseDatatype(s...)
+	return err == nil
+}
 
-	// map[keyword]isReserved
-	var mysqlKeywords = map[string]bool{
-		"ACCESSIBLE":                    true,
-		"ACCOUNT":                       false,
-		"ACTION":                        false,
-		"ADD":                           true,
-		"AFTER":                         false,
-		"AGAINST":                       false,
-		"AGGREGATE":                     false,
-		"ALGORITHM":                     false,
-		"ALL":                           true,
-		"ALTER":                         true,
-		"ALWAYS":                        false,
-		"ANALYZE":                       true,
-		"AND":                           true,
-		"ANY":                           false,
-		"ASCII":                         false,
-		"ASC":                           true,
-		"ASENSITIVE":                    true,
-		"AS":                            true,
-		"AT":                            false,
-		"AUTOEXTEND_SIZE":               false,
-		"AUTO_INCREMENT":                false,
-		"AVG":                           false,
-		"AVG_ROW_LENGTH":                false,
-		"BACKUP":                        false,
-		"BEFORE":                        true,
-		"BEGIN":                         false,
-		"BETWEEN":                       true,
-		"BIGINT":                        true,
-		"BINARY":                        true,
-		"BINLOG":                        false,
-		"BIT":                           false,
-		"BLOB":                          true,
-		"BLOCK":                         false,
-		"BOOLEAN":                       false,
-		"BOOL":                          false,
-		"BOTH":                          true,
-		"BTREE":                         false,
-		"BYTE":                          false,
-		"BY":                            true,
-		"CACHE":                         false,
-		"CALL":                          true,
-		"CASCADED":                      false,
-		"CASCADE":                       true,
-		"CASE":                          true,
-		"CATALOG_NAME":                  false,
-		"CHAIN":                         false,
-		"CHANGED":                       false,
-		"CHANGE":                        true,
-		"CHANNEL":                       false,
-		"CHARACTER":                     true,
-		"CHARSET":                       false,
-		"CHAR":                          true,
-		"CHECKSUM":                      false,
-		"CHECK":                         true,
-		"CIPHER":                        false,
-		"CLASS_ORIGIN":                  false,
-		"CLIENT":                        false,
-		"CLOSE":                         false,
-		"COALESCE":                      false,
-		"CODE":                          false,
-		"COLLATE":                       true,
-		"COLLATION":                     false,
-		"COLUMN_FORMAT":                 false,
-		"COLUMN_NAME":                   false,
-		"COLUMNS":                       false,
-		"COLUMN":                        true,
-		"COMMENT":                       false,
-		"COMMIT":                        false,
-		"COMMITTED":                     false,
-		"COMPACT":                       false,
-		"COMPLETION":                    false,
-		"COMPRESSED":                    false,
-		"COMPRESSION":                   false,
-		"CONCURRENT":                    false,
-		"CONDITION":                     true,
-		"CONNECTION":                    false,
-		"CONSISTENT":                    false,
-		"CONSTRAINT_CATALOG":            false,
-		"CONSTRAINT_NAME":               false,
-		"CONSTRAINT_SCHEMA":             false,
-		"CONSTRAINT":                    true,
-		"CONTAINS":                      false,
-		"CONTEXT":                       false,
-		"CONTINUE":                      true,
-		"CONVERT":                       true,
-		"CPU":                           false,
-		"CREATE":                        true,
-		"CROSS":                         true,
-		"CUBE":                          false,
-		"CURRENT_DATE":                  true,
-		"CURRENT":                       false,
-		"CURRENT_TIMESTAMP":             true,
-		"CURRENT_TIME":                  true,
-		"CURRENT_USER":                  true,
-		"CURSOR_NAME":                   false,
-		"CURSOR":                        true,
-		"DATABASES":                     true,
-		"DATABASE":                      true,
-		"DATA":                          false,
-		"DATAFILE":                      false,
-		"DATE":                          false,
-		"DATETIME":                      false,
-		"DAY":                           false,
-		"DAY_HOUR":                      true,
-		"DAY_MICROSECOND":               true,
-		"DAY_MINUTE":                    true,
-		"DAY_SECOND":                    true,
-		"DEALLOCATE":                    false,
-		"DECIMAL":                       true,
-		"DECLARE":                       true,
-		"DEC":                           true,
-		"DEFAULT_AUTH":                  false,
-		"DEFAULT":                       true,
-		"DEFINER":                       false,
-		"DELAYED":                       true,
-		"DELAY_KEY_WRITE":               false,
-		"DELETE":                        true,
-		"DESCRIBE":                      true,
-		"DESC":                          true,
-		"DES_KEY_FILE":                  false,
-		"DETERMINISTIC":                 true,
-		"DIAGNOSTICS":                   false,
-		"DIRECTORY":                     false,
-		"DISABLE":                       false,
-		"DISCARD":                       false,
-		"DISK":                          false,
-		"DISTINCTROW":                   true,
-		"DISTINCT":                      true,
-		"DIV":                           true,
-		"DO":                            false,
-		"DOUBLE":                        true,
-		"DROP":                          true,
-		"DUAL":                          true,
-		"DUMPFILE":                      false,
-		"DUPLICATE":                     false,
-		"DYNAMIC":                       false,
-		"EACH":                          true,
-		"ELSEIF":                        true,
-		"ELSE":                          true,
-		"ENABLE":                        false,
-		"ENCLOSED":                      true,
-		"ENCRYPTION":                    false,
-		"END":                           false,
-		"ENDS":                          false,
-		"ENGINE":                        false,
-		"ENGINES":                       false,
-		"ENUM":                          false,
-		"ERROR":                         false,
-		"ERRORS":                        false,
-		"ESCAPED":                       true,
-		"ESCAPE":                        false,
-		"EVENT":                         false,
-		"EVENTS":                        false,
-		"EVERY":                         false,
-		"EXCHANGE":                      false,
-		"EXECUTE":                       false,
-		"EXISTS":                        true,
-		"EXIT":                          true,
-		"EXPANSION":                     false,
-		"EXPIRE":                        false,
-		"EXPLAIN":                       true,
-		"EXPORT":                        false,
-		"EXTENDED":                      false,
-		"EXTENT_SIZE":                   false,
-		"FALSE":                         true,
-		"FAST":                          false,
-		"FAULTS":                        false,
-		"FETCH":                         true,
-		"FIELDS":                        false,
-		"FILE_BLOCK_SIZE":               false,
-		"FILE":                          false,
-		"FILTER":                        false,
-		"FIRST":                         false,
-		"FIXED":                         false,
-		"FLOAT4":                        true,
-		"FLOAT8":                        true,
-		"FLOAT":                         true,
-		"FLUSH":                         false,
-		"FOLLOWS":                       false,
-		"FORCE":                         true,
-		"FOREIGN":                       true,
-		"FORMAT":                        false,
-		"FOR":                           true,
-		"FOUND":                         false,
-		"FROM":                          true,
-		"FULL":                          false,
-		"FULLTEXT":                      true,
-		"FUNCTION":                      false,
-		"GENERAL":                       false,
-		"GENERATED":                     true,
-		"GEOMETRYCOLLECTION":            false,
-		"GEOMETRY":                      false,
-		"GET_FORMAT":                    false,
-		"GET":                           true,
-		"GLOBAL":                        false,
-		"GRANTS":                        false,
-		"GRANT":                         true,
-		"GROUP_REPLICATION":             false,
-		"GROUP":                         true,
-		"HANDLER":                       false,
-		"HASH":                          false,
-		"HAVING":                        true,
-		"HELP":                          false,
-		"HIGH_PRIORITY":                 true,
-		"HOST":                          false,
-		"HOSTS":                         false,
-		"HOUR":                          false,
-		"HOUR_MICROSECOND":              true,
-		"HOUR_MINUTE":                   true,
-		"HOUR_SECOND":                   true,
-		"IDENTIFIED":                    false,
-		"IF":                            true,
-		"IGNORE_SERVER_IDS":             false,
-		"IGNORE":                        true,
-		"IMPORT":                        false,
-		"INDEXES":                       false,
-		"INDEX":                         true,
-		"INFILE":                        true,
-		"INITIAL_SIZE":                  false,
-		"INNER":                         true,
-		"INOUT":                         true,
-		"INSENSITIVE":                   true,
-		"INSERT_METHOD":                 false,
-		"INSERT":                        true,
-		"INSTALL":                       false,
-		"INSTANCE":                      false,
-		"INT1":                          true,
-		"INT2":                          true,
-		"INT3":                          true,
-		"INT4":                          true,
-		"INT8":                          true,
-		"INTEGER":                       true,
-		"INTERVAL":                      true,
-		"INTO":                          true,
-		"IN":                            true,
-		"INT":                           true,
-		"INVOKER":                       false,
-		"IO_AFTER_GTIDS":                true,
-		"IO_BEFORE_GTIDS":               true,
-		"IO":                            false,
-		"IO_THREAD":                     false,
-		"IPC":                           false,
-		"ISOLATION":                     false,
-		"ISSUER":                        false,
-		"IS":                            true,
-		"ITERATE":                       true,
-		"JOIN":                          true,
-		"JSON":                          false,
-		"KEY_BLOCK_SIZE":                false,
-		"KEYS":                          true,
-		"KEY":                           true,
-		"KILL":                          true,
-		"LANGUAGE":                      false,
-		"LAST":                          false,
-		"LEADING":                       true,
-		"LEAVES":                        false,
-		"LEAVE":                         true,
-		"LEFT":                          true,
-		"LESS":                          false,
-		"LEVEL":                         false,
-		"LIKE":                          true,
-		"LIMIT":                         true,
-		"LINEAR":                        true,
-		"LINESTRING":                    false,
-		"LINES":                         true,
-		"LIST":                          false,
-		"LOAD":                          true,
-		"LOCAL":                         false,
-		"LOCALTIMESTAMP":                true,
-		"LOCALTIME":                     true,
-		"LOCKS":                         false,
-		"LOCK":                          true,
-		"LOGFILE":                       false,
-		"LOGS":                          false,
-		"LONGBLOB":                      true,
-		"LONGTEXT":                      true,
-		"LONG":                          true,
-		"LOOP":                          true,
-		"LOW_PRIORITY":                  true,
-		"MASTER_AUTO_POSITION":          false,
-		"MASTER_BIND":                   true,
-		"MASTER_CONNECT_RETRY":          false,
-		"MASTER_DELAY":                  false,
-		"MASTER":                        false,
-		"MASTER_HEARTBEAT_PERIOD":       false,
-		"MASTER_HOST":                   false,
-		"MASTER_LOG_FILE":               false,
-		"MASTER_LOG_POS":                false,
-		"MASTER_PASSWORD":               false,
-		"MASTER_PORT":                   false,
-		"MASTER_RETRY_COUNT":            false,
-		"MASTER_SERVER_ID":              false,
-		"MASTER_SSL_CA":                 false,
-		"MASTER_SSL_CAPATH":             false,
-		"MASTER_SSL_CERT":               false,
-		"MASTER_SSL_CIPHER":             false,
-		"MASTER_SSL_CRL":                false,
-		"MASTER_SSL_CRLPATH":            false,
-		"MASTER_SSL":                    false,
-		"MASTER_SSL_KEY":                false,
-		"MASTER_SSL_VERIFY_SERVER_CERT": true,
-		"MASTER_TLS_VERSION":            false,
-		"MASTER_USER":                   false,
-		"MATCH":                         true,
-		"MAX_CONNECTIONS_PER_HOUR":      false,
-		"MAX_QUERIES_PER_HOUR":          false,
-		"MAX_ROWS":                      false,
-		"MAX_SIZE":                      false,
-		"MAX_STATEMENT_TIME":            false,
-		"MAX_UPDATES_PER_HOUR":          false,
-		"MAX_USER_CONNECTIONS":          false,
-		"MAXVALUE":                      true,
-		"MEDIUMBLOB":                    true,
-		"MEDIUM":                        false,
-		"MEDIUMINT":                     true,
-		"MEDIUMTEXT":                    true,
-		"MEMORY":                        false,
-		"MERGE":                         false,
-		"MESSAGE_TEXT":                  false,
-		"MICROSECOND":                   false,
-		"MIDDLEINT":                     true,
-		"MIGRATE":                       false,
-		"MIN_ROWS":                      false,
-		"MINUTE":                        false,
-		"MINUTE_MICROSECOND":            true,
-		"MINUTE_SECOND":                 true,
-		"MODE":                          false,
-		"MODIFIES":                      true,
-		"MODIFY":                        false,
-		"MOD":                           true,
-		"MONTH":                         false,
-		"MULTILINESTRING":               false,
-		"MULTIPOINT":                    false,
-		"MULTIPOLYGON":                  false,
-		"MUTEX":                         false,
-		"MYSQL_ERRNO":                   false,
-		"NAME":                          false,
-		"NAMES":                         false,
-		"NATIONAL":                      false,
-		"NATURAL":                       true,
-		"NCHAR":                         false,
-		"NDBCLUSTER":                    false,
-		"NDB":                           false,
-		"NEVER":                         false,
-		"NEW":                           false,
-		"NEXT":                          false,
-		"NODEGROUP":                     false,
-		"NO":                            false,
-		"NONBLOCKING":                   false,
-		"NONE":                          false,
-		"NOT":                           true,
-		"NO_WAIT":                       false,
-		"NO_WRITE_TO_BINLOG":            true,
-		"NULL":                          true,
-		"NUMBER":                        false,
-		"NUMERIC":                       true,
-		"NVARCHAR":                      false,
-		"OFFSET":                        false,
-		"OLD_PASSWORD":                  false,
-		"ONE":                           false,
-		"ONLY":                          false,
-		"ON":                            true,
-		"OPEN":                          false,
-		"OPTIMIZER_COSTS":               true,
-		"OPTIMIZE":                      true,
-		"OPTIONALLY":                    true,
-		"OPTIONS":                       false,
-		"OPTION":                        true,
-		"ORDER":                         true,
-		"OR":                            true,
-		"OUTER":                         true,
-		"OUTFILE":                       true,
-		"OUT":                           true,
-		"OWNER":                         false,
-		"PACK_KEYS":                     false,
-		"PAGE":                          false,
-		"PARSE_GCOL_EXPR":               true,
-		"PARSER":                        false,
-		"PARTIAL":                       false,
-		"PARTITIONING":                  false,
-		"PARTITIONS":                    false,
-		"PARTITION":                     true,
-		"PASSWORD":                      false,
-		"PHASE":                         false,
-		"PLUGIN_DIR":                    false,
-		"PLUGIN":                        false,
-		"PLUGINS":                       false,
-		"POINT":                         false,
-		"POLYGON":                       false,
-		"PORT":                          false,
-		"PRECEDES":                      false,
-		"PRECISION":                     true,
-		"PREPARE":                       false,
-		"PRESERVE":                      false,
-		"PREV":                          false,
-		"PRIMARY":                       true,
-		"PRIVILEGES":                    false,
-		"PROCEDURE":                     true,
-		"PROCESSLIST":                   false,
-		"PROFILE":                       false,
-		"PROFILES":                      false,
-		"PROXY":                         false,
-		"PURGE":                         true,
-		"QUARTER":                       false,
-		"QUERY":                         false,
-		"QUICK":                         false,
-		"RANGE":                         true,
-		"READ_ONLY":                     false,
-		"READS":                         true,
-		"READ":                          true,
-		"READ_WRITE":                    true,
-		"REAL":                          true,
-		"REBUILD":                       false,
-		"RECOVER":                       false,
-		"REDO_BUFFER_SIZE":              false,
-		"REDOFILE":                      false,
-		"REDUNDANT":                     false,
-		"REFERENCES":                    true,
-		"REGEXP":                        true,
-		"RELAY":                         false,
-		"RELAYLOG":                      false,
-		"RELAY_LOG_FILE":                false,
-		"RELAY_LOG_POS":                 false,
-		"RELAY_THREAD":                  false,
-		"RELEASE":                       true,
-		"RELOAD":                        false,
-		"REMOVE":                        false,
-		"RENAME":                        true,
-		"REORGANIZE":                    false,
-		"REPAIR":                        false,
-		"REPEATABLE":                    false,
-		"REPEAT":                        true,
-		"REPLACE":                       true,
-		"REPLICATE_DO_DB":               false,
-		"REPLICATE_DO_TABLE":            false,
-		"REPLICATE_IGNORE_DB":           false,
-		"REPLICATE_IGNORE_TABLE":        false,
-		"REPLICATE_REWRITE_DB":          false,
-		"REPLICATE_WILD_DO_TABLE":       false,
-		"REPLICATE_WILD_IGNORE_TABLE":   false,
-		"REPLICATION":                   false,
-		"REQUIRE":                       true,
-		"RESET":                         false,
-		"RESIGNAL":                      true,
-		"RESTORE":                       false,
-		"RESTRICT":                      true,
-		"RESUME":                        false,
-		"RETURNED_SQLSTATE":             false,
-		"RETURNS":                       false,
-		"RETURN":                        true,
-		"REVERSE":                       false,
-		"REVOKE":                        true,
-		"RIGHT":                         true,
-		"RLIKE":                         true,
-		"ROLLBACK":                      false,
-		"ROLLUP":                        false,
-		"ROTATE":                        false,
-		"ROUTINE":                       false,
-		"ROW_COUNT":                     false,
-		"ROW":                           false,
-		"ROW_FORMAT":                    false,
-		"ROWS":                          false,
-		"RTREE":                         false,
-		"SAVEPOINT":                     false,
-		"SCHEDULE":                      false,
-		"SCHEMA_NAME":                   false,
-		"SCHEMAS":                       true,
-		"SCHEMA":                        true,
-		"SECOND":                        false,
-		"SECOND_MICROSECOND":            true,
-		"SECURITY":                      false,
-		"SELECT":                        true,
-		"SENSITIVE":                     true,
-		"SEPARATOR":                     true,
-		"SERIAL":                        false,
-		"SERIALIZABLE":                  false,
-		"SERVER":                        false,
-		"SESSION":                       false,
-		"SET":                           true,
-		"SHARE":                         false,
-		"SHOW":                          true,
-		"SHUTDOWN":                      false,
-		"SIGNAL":                        true,
-		"SIGNED":                        false,
-		"SIMPLE":                        false,
-		"SLAVE":                         false,
-		"SLOW":                          false,
-		"SMALLINT":                      true,
-		"SNAPSHOT":                      false,
-		"SOCKET":                        false,
-		"SOME":                          false,
-		"SONAME":                        false,
-		"SOUNDS":                        false,
-		"SOURCE":                        false,
-		"SPATIAL":                       true,
-		"SPECIFIC":                      true,
-		"SQL_AFTER_GTIDS":               false,
-		"SQL_AFTER_MTS_GAPS":            false,
-		"SQL_BEFORE_GTIDS":              false,
-		"SQL_BIG_RESULT":                true,
-		"SQL_BUFFER_RESULT":             false,
-		"SQL_CACHE":                     false,
-		"SQL_CALC_FOUND_ROWS":           true,
-		"SQLEXCEPTION":                  true,
-		"SQL_NO_CACHE":                  false,
-		"SQL_SMALL_RESULT":              true,
-		"SQLSTATE":                      true,
-		"SQL_THREAD":                    false,
-		"SQL":                           true,
-		"SQL_TSI_DAY":                   false,
-		"SQL_TSI_HOUR":                  false,
-		"SQL_TSI_MINUTE":                false,
-		"SQL_TSI_MONTH":                 false,
-		"SQL_TSI_QUARTER":               false,
-		"SQL_TSI_SECOND":                false,
-		"SQL_TSI_WEEK":                  false,
-		"SQL_TSI_YEAR":                  false,
-		"SQLWARNING":                    true,
-		"SSL":                           true,
-		"STACKED":                       false,
-		"START":                         false,
-		"STARTING":                      true,
-		"STARTS":                        false,
-		"STATS_AUTO_RECALC":             false,
-		"STATS_PERSISTENT":              false,
-		"STATS_SAMPLE_PAGES":            false,
-		"STATUS":                        false,
-		"STOP":                          false,
-		"STORAGE":                       false,
-		"STORED":                        true,
-		"STRAIGHT_JOIN":                 true,
-		"STRING":                        false,
-		"SUBCLASS_ORIGIN":               false,
-		"SUBJECT":                       false,
-		"SUBPARTITION":                  false,
-		"SUBPARTITIONS":                 false,
-		"SUPER":                         false,
-		"SUSPEND":                       false,
-		"SWAPS":                         false,
-		"SWITCHES":                      false,
-		"TABLE_CHECKSUM":                false,
-		"TABLE_NAME":                    false,
-		"TABLES":                        false,
-		"TABLESPACE":                    false,
-		"TABLE":                         true,
-		"TEMPORARY":                     false,
-		"TEMPTABLE":                     false,
-		"TERMINATED":                    true,
-		"TEXT":                          false,
-		"THAN":                          false,
-		"THEN":                          true,
-		"TIME":                          false,
-		"TIMESTAMPADD":                  false,
-		"TIMESTAMPDIFF":                 false,
-		"TIMESTAMP":                     false,
-		"TINYBLOB":                      true,
-		"TINYINT":                       true,
-		"TINYTEXT":                      true,
-		"TO":                            true,
-		"TRAILING":                      true,
-		"TRANSACTION":                   false,
-		"TRIGGERS":                      false,
-		"TRIGGER":                       true,
-		"TRUE":                          true,
-		"TRUNCATE":                      false,
-		"TYPE":                          false,
-		"TYPES":                         false,
-		"UNCOMMITTED":                   false,
-		"UNDEFINED":                     false,
-		"UNDO_BUFFER_SIZE":              false,
-		"UNDOFILE":                      false,
-		"UNDO":                          true,
-		"UNICODE":                       false,
-		"UNINSTALL":                     false,
-		"UNION":                         true,
-		"UNIQUE":                        true,
-		"UNKNOWN":                       false,
-		"UNLOCK":                        true,
-		"UNSIGNED":                      true,
-		"UNTIL":                         false,
-		"UPDATE":                        true,
-		"UPGRADE":                       false,
-		"USAGE":                         true,
-		"USE_FRM":                       false,
-		"USER":                          false,
-		"USER_RESOURCES":                false,
-		"USE":                           true,
-		"USING":                         true,
-		"UTC_DATE":                      true,
-		"UTC_TIMESTAMP":                 true,
-		"UTC_TIME":                      true,
-		"VALIDATION":                    false,
-		"VALUE":                         false,
-		"VALUES":                        true,
-		"VARBINARY":                     true,
-		"VARCHARACTER":                  true,
-		"VARCHAR":                       true,
-		"VARIABLES":                     false,
-		"VARYING":                       true,
-		"VIEW":                          false,
-		"VIRTUAL":                       true,
-		"WAIT":                          false,
-		"WARNINGS":                      false,
-		"WEEK":                          false,
-		"WEIGHT_STRING":                 false,
-		"WHEN":                          true,
-		"WHERE":                         true,
-		"WHILE":                         true,
-		"WITHOUT":                       false,
-		"WITH":                          true,
-		"WORK":                          false,
-		"WRAPPER":                       false,
-		"WRITE":                         true,
-		"X509":                          false,
-		"XA":                            false,
-		"XID":                           false,
-		"XML":                           false,
-		"XOR":                           true,
-		"YEAR":                          false,
-		"YEAR_MONTH":                    true,
-		"ZEROFILL":                      true,
+// ParseDatatype returns the parsed form of the supplied datatype
+// tokens, or an error if they are not a valid MySQL datatype
+func (d MySQLDialect) ParseDatatype(tokens ...string) (Datatype, error) {
+	return parseDatatype(d, d.parseRules(), tokens)
+}
+
+// parseRules returns the rules for parsing MySQL datatypes
+func (d MySQLDialect) parseRules() datatypeRules {
+	return datatypeRules{
+		types:     mysqlDatatypes,
+		modifiers: true,
+		lengths:   mysqlLengthDatatypes,
+		numerics:  mysqlNumericDatatypes,
+		limits:    mysqlDatatypeLimits,
 	}
+}
+
+// IsDatatypePart returns a boolean indicating if the supplied string
+// is considered to be part of a datatype definition in MySQL
+func (d MySQLDialect) IsDatatypePart(s string) bool {
+	return mysqlDatatypeParts[strings.ToLower(s)]
+}
+
+// DatatypeInfo returns the metadata for the named MySQL datatype
+func (d MySQLDialect) DatatypeInfo(name string) (DatatypeInfo, bool) {
+	return datatypeInfo(d, mysqlDatatypeInfo, name)
+}
+
+// Datatypes returns the metadata for all of the MySQL datatypes
+func (d MySQLDialect) Datatypes() []DatatypeInfo {
+	return datatypeInfos(mysqlDatatypeInfo)
+}
+
+// CanonicalDatatype returns the supplied datatype with any synonym
+// replaced by the canonical MySQL form (e.g. integer becomes int)
+func (d MySQLDialect) CanonicalDatatype(t Datatype) Datatype {
+	return canonicalDatatype(d, mysqlDatatypeAliases, t)
+}
+
+/*
+   MySQL keywords
+
+   https://dev.mysql.com/doc/refman/5.7/en/keywords.html
+
+   Those keywords that had no indicator if they were reserved or not were set to false.
+
+*/
+
+// map[keyword]isReserved
+var mysqlKeywords = map[string]bool{
+	"ACCESSIBLE":                    true,
+	"ACCOUNT":                       false,
+	"ACTION":                        false,
+	"ADD":                           true,
+	"AFTER":                         false,
+	"AGAINST":                       false,
+	"AGGREGATE":                     false,
+	"ALGORITHM":                     false,
+	"ALL":                           true,
+	"ALTER":                         true,
+	"ALWAYS":                        false,
+	"ANALYZE":                       true,
+	"AND":                           true,
+	"ANY":                           false,
+	"ASCII":                         false,
+	"ASC":                           true,
+	"ASENSITIVE":                    true,
+	"AS":                            true,
+	"AT":                            false,
+	"AUTOEXTEND_SIZE":               false,
+	"AUTO_INCREMENT":                false,
+	"AVG":                           false,
+	"AVG_ROW_LENGTH":                false,
+	"BACKUP":                        false,
+	"BEFORE":                        true,
+	"BEGIN":                         false,
+	"BETWEEN":                       true,
+	"BIGINT":                        true,
+	"BINARY":                        true,
+	"BINLOG":                        false,
+	"BIT":                           false,
+	"BLOB":                          true,
+	"BLOCK":                         false,
+	"BOOLEAN":                       false,
+	"BOOL":                          false,
+	"BOTH":                          true,
+	"BTREE":                         false,
+	"BYTE":                          false,
+	"BY":                            true,
+	"CACHE":                         false,
+	"CALL":                          true,
+	"CASCADED":                      false,
+	"CASCADE":                       true,
+	"CASE":                          true,
+	"CATALOG_NAME":                  false,
+	"CHAIN":                         false,
+	"CHANGED":                       false,
+	"CHANGE":                        true,
+	"CHANNEL":                       false,
+	"CHARACTER":                     true,
+	"CHARSET":                       false,
+	"CHAR":                          true,
+	"CHECKSUM":                      false,
+	"CHECK":                         true,
+	"CIPHER":                        false,
+	"CLASS_ORIGIN":                  false,
+	"CLIENT":                        false,
+	"CLOSE":                         false,
+	"COALESCE":                      false,
+	"CODE":                          false,
+	"COLLATE":                       true,
+	"COLLATION":                     false,
+	"COLUMN_FORMAT":                 false,
+	"COLUMN_NAME":                   false,
+	"COLUMNS":                       false,
+	"COLUMN":                        true,
+	"COMMENT":                       false,
+	"COMMIT":                        false,
+	"COMMITTED":                     false,
+	"COMPACT":                       false,
+	"COMPLETION":                    false,
+	"COMPRESSED":                    false,
+	"COMPRESSION":                   false,
+	"CONCURRENT":                    false,
+	"CONDITION":                     true,
+	"CONNECTION":                    false,
+	"CONSISTENT":                    false,
+	"CONSTRAINT_CATALOG":            false,
+	"CONSTRAINT_NAME":               false,
+	"CONSTRAINT_SCHEMA":             false,
+	"CONSTRAINT":                    true,
+	"CONTAINS":                      false,
+	"CONTEXT":                       false,
+	"CONTINUE":                      true,
+	"CONVERT":                       true,
+	"CPU":                           false,
+	"CREATE":                        true,
+	"CROSS":                         true,
+	"CUBE":                          false,
+	"CURRENT_DATE":                  true,
+	"CURRENT":                       false,
+	"CURRENT_TIMESTAMP":             true,
+	"CURRENT_TIME":                  true,
+	"CURRENT_USER":                  true,
+	"CURSOR_NAME":                   false,
+	"CURSOR":                        true,
+	"DATABASES":                     true,
+	"DATABASE":                      true,
+	"DATA":                          false,
+	"DATAFILE":                      false,
+	"DATE":                          false,
+	"DATETIME":                      false,
+	"DAY":                           false,
+	"DAY_HOUR":                      true,
+	"DAY_MICROSECOND":               true,
+	"DAY_MINUTE":                    true,
+	"DAY_SECOND":                    true,
+	"DEALLOCATE":                    false,
+	"DECIMAL":                       true,
+	"DECLARE":                       true,
+	"DEC":                           true,
+	"DEFAULT_AUTH":                  false,
+	"DEFAULT":                       true,
+	"DEFINER":                       false,
+	"DELAYED":                       true,
+	"DELAY_KEY_WRITE":               false,
+	"DELETE":                        true,
+	"DESCRIBE":                      true,
+	"DESC":                          true,
+	"DES_KEY_FILE":                  false,
+	"DETERMINISTIC":                 true,
+	"DIAGNOSTICS":                   false,
+	"DIRECTORY":                     false,
+	"DISABLE":                       false,
+	"DISCARD":                       false,
+	"DISK":                          false,
+	"DISTINCTROW":                   true,
+	"DISTINCT":                      true,
+	"DIV":                           true,
+	"DO":                            false,
+	"DOUBLE":                        true,
+	"DROP":                          true,
+	"DUAL":                          true,
+	"DUMPFILE":                      false,
+	"DUPLICATE":                     false,
+	"DYNAMIC":                       false,
+	"EACH":                          true,
+	"ELSEIF":                        true,
+	"ELSE":                          true,
+	"ENABLE":                        false,
+	"ENCLOSED":                      true,
+	"ENCRYPTION":                    false,
+	"END":                           false,
+	"ENDS":                          false,
+	"ENGINE":                        false,
+	"ENGINES":                       false,
+	"ENUM":                          false,
+	"ERROR":                         false,
+	"ERRORS":                        false,
+	"ESCAPED":                       true,
+	"ESCAPE":                        false,
+	"EVENT":                         false,
+	"EVENTS":                        false,
+	"EVERY":                         false,
+	"EXCHANGE":                      false,
+	"EXECUTE":                       false,
+	"EXISTS":                        true,
+	"EXIT":                          true,
+	"EXPANSION":                     false,
+	"EXPIRE":                        false,
+	"EXPLAIN":                       true,
+	"EXPORT":                        false,
+	"EXTENDED":                      false,
+	"EXTENT_SIZE":                   false,
+	"FALSE":                         true,
+	"FAST":                          false,
+	"FAULTS":                        false,
+	"FETCH":                         true,
+	"FIELDS":                        false,
+	"FILE_BLOCK_SIZE":               false,
+	"FILE":                          false,
+	"FILTER":                        false,
+	"FIRST":                         false,
+	"FIXED":                         false,
+	"FLOAT4":                        true,
+	"FLOAT8":                        true,
+	"FLOAT":                         true,
+	"FLUSH":                         false,
+	"FOLLOWS":                       false,
+	"FORCE":                         true,
+	"FOREIGN":                       true,
+	"FORMAT":                        false,
+	"FOR":                           true,
+	"FOUND":                         false,
+	"FROM":                          true,
+	"FULL":                          false,
+	"FULLTEXT":                      true,
+	"FUNCTION":                      false,
+	"GENERAL":                       false,
+	"GENERATED":                     true,
+	"GEOMETRYCOLLECTION":            false,
+	"GEOMETRY":                      false,
+	"GET_FORMAT":                    false,
+	"GET":                           true,
+	"GLOBAL":                        false,
+	"GRANTS":                        false,
+	"GRANT":                         true,
+	"GROUP_REPLICATION":             false,
+	"GROUP":                         true,
+	"HANDLER":                       false,
+	"HASH":                          false,
+	"HAVING":                        true,
+	"HELP":                          false,
+	"HIGH_PRIORITY":                 true,
+	"HOST":                          false,
+	"HOSTS":                         false,
+	"HOUR":                          false,
+	"HOUR_MICROSECOND":              true,
+	"HOUR_MINUTE":                   true,
+	"HOUR_SECOND":                   true,
+	"IDENTIFIED":                    false,
+	"IF":                            true,
+	"IGNORE_SERVER_IDS":             false,
+	"IGNORE":                        true,
+	"IMPORT":                        false,
+	"INDEXES":                       false,
+	"INDEX":                         true,
+	"INFILE":                        true,
+	"INITIAL_SIZE":                  false,
+	"INNER":                         true,
+	"INOUT":                         true,
+	"INSENSITIVE":                   true,
+	"INSERT_METHOD":                 false,
+	"INSERT":                        true,
+	"INSTALL":                       false,
+	"INSTANCE":                      false,
+	"INT1":                          true,
+	"INT2":                          true,
+	"INT3":                          true,
+	"INT4":                          true,
+	"INT8":                          true,
+	"INTEGER":                       true,
+	"INTERVAL":                      true,
+	"INTO":                          true,
+	"IN":                            true,
+	"INT":                           true,
+	"INVOKER":                       false,
+	"IO_AFTER_GTIDS":                true,
+	"IO_BEFORE_GTIDS":               true,
+	"IO":                            false,
+	"IO_THREAD":                     false,
+	"IPC":                           false,
+	"ISOLATION":                     false,
+	"ISSUER":                        false,
+	"IS":                            true,
+	"ITERATE":                       true,
+	"JOIN":                          true,
+	"JSON":                          false,
+	"KEY_BLOCK_SIZE":                false,
+	"KEYS":                          true,
+	"KEY":                           true,
+	"KILL":                          true,
+	"LANGUAGE":                      false,
+	"LAST":                          false,
+	"LEADING":                       true,
+	"LEAVES":                        false,
+	"LEAVE":                         true,
+	"LEFT":                          true,
+	"LESS":                          false,
+	"LEVEL":                         false,
+	"LIKE":                          true,
+	"LIMIT":                         true,
+	"LINEAR":                        true,
+	"LINESTRING":                    false,
+	"LINES":                         true,
+	"LIST":                          false,
+	"LOAD":                          true,
+	"LOCAL":                         false,
+	"LOCALTIMESTAMP":                true,
+	"LOCALTIME":                     true,
+	"LOCKS":                         false,
+	"LOCK":                          true,
+	"LOGFILE":                       false,
+	"LOGS":                          false,
+	"LONGBLOB":                      true,
+	"LONGTEXT":                      true,
+	"LONG":                          true,
+	"LOOP":                          true,
+	"LOW_PRIORITY":                  true,
+	"MASTER_AUTO_POSITION":          false,
+	"MASTER_BIND":                   true,
+	"MASTER_CONNECT_RETRY":          false,
+	"MASTER_DELAY":                  false,
+	"MASTER":                        false,
+	"MASTER_HEARTBEAT_PERIOD":       false,
+	"MASTER_HOST":                   false,
+	"MASTER_LOG_FILE":               false,
+	"MASTER_LOG_POS":                false,
+	"MASTER_PASSWORD":               false,
+	"MASTER_PORT":                   false,
+	"MASTER_RETRY_COUNT":            false,
+	"MASTER_SERVER_ID":              false,
+	"MASTER_SSL_CA":                 false,
+	"MASTER_SSL_CAPATH":             false,
+	"MASTER_SSL_CERT":               false,
+	"MASTER_SSL_CIPHER":             false,
+	"MASTER_SSL_CRL":                false,
+	"MASTER_SSL_CRLPATH":            false,
+	"MASTER_SSL":                    false,
+	"MASTER_SSL_KEY":                false,
+	"MASTER_SSL_VERIFY_SERVER_CERT": true,
+	"MASTER_TLS_VERSION":            false,
+	"MASTER_USER":                   false,
+	"MATCH":                         true,
+	"MAX_CONNECTIONS_PER_HOUR":      false,
+	"MAX_QUERIES_PER_HOUR":          false,
+	"MAX_ROWS":                      false,
+	"MAX_SIZE":                      false,
+	"MAX_STATEMENT_TIME":            false,
+	"MAX_UPDATES_PER_HOUR":          false,
+	"MAX_USER_CONNECTIONS":          false,
+	"MAXVALUE":                      true,
+	"MEDIUMBLOB":                    true,
+	"MEDIUM":                        false,
+	"MEDIUMINT":                     true,
+	"MEDIUMTEXT":                    true,
+	"MEMORY":                        false,
+	"MERGE":                         false,
+	"MESSAGE_TEXT":                  false,
+	"MICROSECOND":                   false,
+	"MIDDLEINT":                     true,
+	"MIGRATE":                       false,
+	"MIN_ROWS":                      false,
+	"MINUTE":                        false,
+	"MINUTE_MICROSECOND":            true,
+	"MINUTE_SECOND":                 true,
+	"MODE":                          false,
+	"MODIFIES":                      true,
+	"MODIFY":                        false,
+	"MOD":                           true,
+	"MONTH":                         false,
+	"MULTILINESTRING":               false,
+	"MULTIPOINT":                    false,
+	"MULTIPOLYGON":                  false,
+	"MUTEX":                         false,
+	"MYSQL_ERRNO":                   false,
+	"NAME":                          false,
+	"NAMES":                         false,
+	"NATIONAL":                      false,
+	"NATURAL":                       true,
+	"NCHAR":                         false,
+	"NDBCLUSTER":                    false,
+	"NDB":                           false,
+	"NEVER":                         false,
+	"NEW":                           false,
+	"NEXT":                          false,
+	"NODEGROUP":                     false,
+	"NO":                            false,
+	"NONBLOCKING":                   false,
+	"NONE":                          false,
+	"NOT":                           true,
+	"NO_WAIT":                       false,
+	"NO_WRITE_TO_BINLOG":            true,
+	"NULL":                          true,
+	"NUMBER":                        false,
+	"NUMERIC":                       true,
+	"NVARCHAR":                      false,
+	"OFFSET":                        false,
+	"OLD_PASSWORD":                  false,
+	"ONE":                           false,
+	"ONLY":                          false,
+	"ON":                            true,
+	"OPEN":                          false,
+	"OPTIMIZER_COSTS":               true,
+	"OPTIMIZE":                      true,
+	"OPTIONALLY":                    true,
+	"OPTIONS":                       false,
+	"OPTION":                        true,
+	"ORDER":                         true,
+	"OR":                            true,
+	"OUTER":                         true,
+	"OUTFILE":                       true,
+	"OUT":                           true,
+	"OWNER":                         false,
+	"PACK_KEYS":                     false,
+	"PAGE":                          false,
+	"PARSE_GCOL_EXPR":               true,
+	"PARSER":                        false,
+	"PARTIAL":                       false,
+	"PARTITIONING":                  false,
+	"PARTITIONS":                    false,
+	"PARTITION":                     true,
+	"PASSWORD":                      false,
+	"PHASE":                         false,
+	"PLUGIN_DIR":                    false,
+	"PLUGIN":                        false,
+	"PLUGINS":                       false,
+	"POINT":                         false,
+	"POLYGON":                       false,
+	"PORT":                          false,
+	"PRECEDES":                      false,
+	"PRECISION":                     true,
+	"PREPARE":                       false,
+	"PRESERVE":                      false,
+	"PREV":                          false,
+	"PRIMARY":                       true,
+	"PRIVILEGES":                    false,
+	"PROCEDURE":                     true,
+	"PROCESSLIST":                   false,
+	"PROFILE":                       false,
+	"PROFILES":                      false,
+	"PROXY":                         false,
+	"PURGE":                         true,
+	"QUARTER":                       false,
+	"QUERY":                         false,
+	"QUICK":                         false,
+	"RANGE":                         true,
+	"READ_ONLY":                     false,
+	"READS":                         true,
+	"READ":                          true,
+	"READ_WRITE":                    true,
+	"REAL":                          true,
+	"REBUILD":                       false,
+	"RECOVER":                       false,
+	"REDO_BUFFER_SIZE":              false,
+	"REDOFILE":                      false,
+	"REDUNDANT":                     false,
+	"REFERENCES":                    true,
+	"REGEXP":                        true,
+	"RELAY":                         false,
+	"RELAYLOG":                      false,
+	"RELAY_LOG_FILE":                false,
+	"RELAY_LOG_POS":                 false,
+	"RELAY_THREAD":                  false,
+	"RELEASE":                       true,
+	"RELOAD":                        false,
+	"REMOVE":                        false,
+	"RENAME":                        true,
+	"REORGANIZE":                    false,
+	"REPAIR":                        false,
+	"REPEATABLE":                    false,
+	"REPEAT":                        true,
+	"REPLACE":                       true,
+	"REPLICATE_DO_DB":               false,
+	"REPLICATE_DO_TABLE":            false,
+	"REPLICATE_IGNORE_DB":           false,
+	"REPLICATE_IGNORE_TABLE":        false,
+	"REPLICATE_REWRITE_DB":          false,
+	"REPLICATE_WILD_DO_TABLE":       false,
+	"REPLICATE_WILD_IGNORE_TABLE":   false,
+	"REPLICATION":                   false,
+	"REQUIRE":                       true,
+	"RESET":                         false,
+	"RESIGNAL":                      true,
+	"RESTORE":                       false,
+	"RESTRICT":                      true,
+	"RESUME":                        false,
+	"RETURNED_SQLSTATE":             false,
+	"RETURNS":                       false,
+	"RETURN":                        true,
+	"REVERSE":                       false,
+	"REVOKE":                        true,
+	"RIGHT":                         true,
+	"RLIKE":                         true,
+	"ROLLBACK":                      false,
+	"ROLLUP":                        false,
+	"ROTATE":                        false,
+	"ROUTINE":                       false,
+	"ROW_COUNT":                     false,
+	"ROW":                           false,
+	"ROW_FORMAT":                    false,
+	"ROWS":                          false,
+	"RTREE":                         false,
+	"SAVEPOINT":                     false,
+	"SCHEDULE":                      false,
+	"SCHEMA_NAME":                   false,
+	"SCHEMAS":                       true,
+	"SCHEMA":                        true,
+	"SECOND":                        false,
+	"SECOND_MICROSECOND":            true,
+	"SECURITY":                      false,
+	"SELECT":                        true,
+	"SENSITIVE":                     true,
+	"SEPARATOR":                     true,
+	"SERIAL":                        false,
+	"SERIALIZABLE":                  false,
+	"SERVER":                        false,
+	"SESSION":                       false,
+	"SET":                           true,
+	"SHARE":                         false,
+	"SHOW":                          true,
+	"SHUTDOWN":                      false,
+	"SIGNAL":                        true,
+	"SIGNED":                        false,
+	"SIMPLE":                        false,
+	"SLAVE":                         false,
+	"SLOW":                          false,
+	"SMALLINT":                      true,
+	"SNAPSHOT":                      false,
+	"SOCKET":                        false,
+	"SOME":                          false,
+	"SONAME":                        false,
+	"SOUNDS":                        false,
+	"SOURCE":                        false,
+	"SPATIAL":                       true,
+	"SPECIFIC":                      true,
+	"SQL_AFTER_GTIDS":               false,
+	"SQL_AFTER_MTS_GAPS":            false,
+	"SQL_BEFORE_GTIDS":              false,
+	"SQL_BIG_RESULT":                true,
+	"SQL_BUFFER_RESULT":             false,
+	"SQL_CACHE":                     false,
+	"SQL_CALC_FOUND_ROWS":           true,
+	"SQLEXCEPTION":                  true,
+	"SQL_NO_CACHE":                  false,
+	"SQL_SMALL_RESULT":              true,
+	"SQLSTATE":                      true,
+	"SQL_THREAD":                    false,
+	"SQL":                           true,
+	"SQL_TSI_DAY":                   false,
+	"SQL_TSI_HOUR":                  false,
+	"SQL_TSI_MINUTE":                false,
+	"SQL_TSI_MONTH":                 false,
+	"SQL_TSI_QUARTER":               false,
+	"SQL_TSI_SECOND":                false,
+	"SQL_TSI_WEEK":                  false,
+	"SQL_TSI_YEAR":                  false,
+	"SQLWARNING":                    true,
+	"SSL":                           true,
+	"STACKED":                       false,
+	"START":                         false,
+	"STARTING":                      true,
+	"STARTS":                        false,
+	"STATS_AUTO_RECALC":             false,
+	"STATS_PERSISTENT":              false,
+	"STATS_SAMPLE_PAGES":            false,
+	"STATUS":                        false,
+	"STOP":                          false,
+	"STORAGE":                       false,
+	"STORED":                        true,
+	"STRAIGHT_JOIN":                 true,
+	"STRING":                        false,
+	"SUBCLASS_ORIGIN":               false,
+	"SUBJECT":                       false,
+	"SUBPARTITION":                  false,
+	"SUBPARTITIONS":                 false,
+	"SUPER":                         false,
+	"SUSPEND":                       false,
+	"SWAPS":                         false,
+	"SWITCHES":                      false,
+	"TABLE_CHECKSUM":                false,
+	"TABLE_NAME":                    false,
+	"TABLES":                        false,
+	"TABLESPACE":                    false,
+	"TABLE":                         true,
+	"TEMPORARY":                     false,
+	"TEMPTABLE":                     false,
+	"TERMINATED":                    true,
+	"TEXT":                          false,
+	"THAN":                          false,
+	"THEN":                          true,
+	"TIME":                          false,
+	"TIMESTAMPADD":                  false,
+	"TIMESTAMPDIFF":                 false,
+	"TIMESTAMP":                     false,
+	"TINYBLOB":                      true,
+	"TINYINT":                       true,
+	"TINYTEXT":                      true,
+	"TO":                            true,
+	"TRAILING":                      true,
+	"TRANSACTION":                   false,
+	"TRIGGERS":                      false,
+	"TRIGGER":                       true,
+	"TRUE":                          true,
+	"TRUNCATE":                      false,
+	"TYPE":                          false,
+	"TYPES":                         false,
+	"UNCOMMITTED":                   false,
+	"UNDEFINED":                     false,
+	"UNDO_BUFFER_SIZE":              false,
+	"UNDOFILE":                      false,
+	"UNDO":                          true,
+	"UNICODE":                       false,
+	"UNINSTALL":                     false,
+	"UNION":                         true,
+	"UNIQUE":                        true,
+	"UNKNOWN":                       false,
+	"UNLOCK":                        true,
+	"UNSIGNED":                      true,
+	"UNTIL":                         false,
+	"UPDATE":                        true,
+	"UPGRADE":                       false,
+	"USAGE":                         true,
+	"USE_FRM":                       false,
+	"USER":                          false,
+	"USER_RESOURCES":                false,
+	"USE":                           true,
+	"USING":                         true,
+	"UTC_DATE":                      true,
+	"UTC_TIMESTAMP":                 true,
+	"UTC_TIME":                      true,
+	"VALIDATION":                    false,
+	"VALUE":                         false,
+	"VALUES":                        true,
+	"VARBINARY":                     true,
+	"VARCHARACTER":                  true,
+	"VARCHAR":                       true,
+	"VARIABLES":                     false,
+	"VARYING":                       true,
+	"VIEW":                          false,
+	"VIRTUAL":                       true,
+	"WAIT":                          false,
+	"WARNINGS":                      false,
+	"WEEK":                          false,
+	"WEIGHT_STRING":                 false,
+	"WHEN":                          true,
+	"WHERE":                         true,
+	"WHILE":                         true,
+	"WITHOUT":                       false,
+	"WITH":                          true,
+	"WORK":                          false,
+	"WRAPPER":                       false,
+	"WRITE":                         true,
+	"X509":                          false,
+	"XA":                            false,
+	"XID":                           false,
+	"XML":                           false,
+	"XOR":                           true,
+	"YEAR":                          false,
+	"YEAR_MONTH":                    true,
+	"ZEROFILL":                      true,
+}
+
+func (d MySQLDialect) keyword(s string) (bool, bool) {
 
 	v, ok := mysqlKeywords[strings.ToUpper(s)]
 
@@ -809,38 +1105,38 @@ func (d MySQLDialect) IsReservedKeyword(s string) bool {
 	return false
 }
 
+var mysqlOperators = map[string]bool{
+	"^":   true,
+	"~":   true,
+	"<":   true,
+	"<<":  true,
+	"<=":  true,
+	"<=>": true,
+	"<>":  true,
+	"=":   true,
+	">":   true,
+	">=":  true,
+	">>":  true,
+	"|":   true,
+	"||":  true,
+	"-":   true,
+	"->":  true,
+	"->>": true,
+	":=":  true,
+	"!":   true,
+	"!=":  true,
+	"/":   true,
+	"*":   true,
+	"&":   true,
+	"&&":  true,
+	"%":   true,
+	"+":   true,
+}
+
 // IsOperator returns a boolean indicating if the supplied string
 // is considered to be an operator in MySQL
 func (d MySQLDialect) IsOperator(s string) bool {
 
-	var mysqlOperators = map[string]bool{
-		"^":   true,
-		"~":   true,
-		"<":   true,
-		"<<":  true,
-		"<=":  true,
-		"<=>": true,
-		"<>":  true,
-		"=":   true,
-		">":   true,
-		">=":  true,
-		">>":  true,
-		"|":   true,
-		"||":  true,
-		"-":   true,
-		"->":  true,
-		"->>": true,
-		":=":  true,
-		"!":   true,
-		"!=":  true,
-		"/":   true,
-		"*":   true,
-		"&":   true,
-		"&&":  true,
-		"%":   true,
-		"+":   true,
-	}
-
 	_, ok := mysqlOperators[s]
 	return ok
 }
@@ -901,3 +1197,15 @@ func (d MySQLDialect) IsIdentifier(s string) bool {
 
 	return true
 }
+
+// PlaceholderStyles returns the bind parameter placeholder styles
+// supported by MySQL, in order of preference
+func (d MySQLDialect) PlaceholderStyles() []int {
+	return []int{QuestionPlaceholder}
+}
+
+// IsPlaceholder returns a boolean indicating if the supplied string
+// is considered to be a bind parameter placeholder in MySQL
+func (d MySQLDialect) IsPlaceholder(s string) bool {
+	return isPlaceholder(d, s)
+}
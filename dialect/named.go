@@ -0,0 +1,245 @@
+package dialect
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ExpandNamed takes SQL that uses :name parameters plus a map (with
+// string keys) or struct holding the parameter values and returns the
+// SQL rewritten to use the positional placeholder style of the
+// supplied dialect along with the matching argument list.
+//
+// Parameters are located using the lexing rules of the dialect so
+// that :name inside strings and comments, assignments (:=),
+// PostgreSQL style casts (::type) and the upper bounds of array slices
+// (arr[1:n]) are not treated as parameters. A colon that follows an
+// opening bracket or a comma starts a parameter (as in ARRAY[:a, :b]
+// or arr[:n]).
+// Parameters that are used more than once get an argument for each
+// use. Slices (other than []byte) are expanded into a comma separated
+// list of placeholders, one per element, for use with IN (...) lists.
+//
+// Struct fields are matched by their "db" tag when present, otherwise
+// by field name (case insensitively).
+func ExpandNamed(d DbDialect, sql string, arg any) (string, []any, error) {
+
+	lookup, err := namedLookup(arg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	styles := d.PlaceholderStyles()
+	if len(styles) == 0 {
+		return "", nil, fmt.Errorf("%s does not support bind parameters", d.DialectName())
+	}
+	style := styles[0]
+
+	tokens := Tokenize(d, sql)
+	var b strings.Builder
+	var args []any
+	brackets := 0
+
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+
+		if t.Type == PunctuationToken {
+			switch t.Value {
+			case "[":
+				brackets++
+			case "]":
+				if brackets > 0 {
+					brackets--
+				}
+			}
+		}
+
+		name, n := namedParameter(d, tokens, i, brackets > 0)
+		if n == 0 {
+			if t.Type == PlaceholderToken && !isCast(tokens, i) {
+				return "", nil, fmt.Errorf("cannot mix named parameters with placeholder %q at %s", t.Value, t.Start)
+			}
+			b.WriteString(t.Value)
+			continue
+		}
+
+		v, ok := lookup(name)
+		if !ok {
+			return "", nil, fmt.Errorf("no value for parameter %q at %s", name, t.Start)
+		}
+
+		values, err := expandValue(name, v)
+		if err != nil {
+			return "", nil, err
+		}
+
+		for j, v := range values {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			args = append(args, v)
+			b.WriteString(formatPlaceholder(style, len(args), ""))
+		}
+		i += n - 1
+	}
+
+	return b.String(), args, nil
+}
+
+// namedParameter checks if a :name parameter starts at the supplied
+// token and returns the name and the number of tokens that make up
+// the parameter (zero if there is no parameter). The inBrackets flag
+// indicates that the token is between square brackets
+func namedParameter(d DbDialect, tokens []Token, i int, inBrackets bool) (string, int) {
+
+	if isCast(tokens, i) {
+		return "", 0
+	}
+
+	t := tokens[i]
+	if t.Type == PlaceholderToken {
+		if placeholderStyle(d, t.Value) == ColonNamePlaceholder {
+			return t.Value[1:], 1
+		}
+		return "", 0
+	}
+
+	// Dialects without :name placeholders lex the colon separately
+	if t.Value != ":" || i+1 >= len(tokens) || (inBrackets && isSliceColon(tokens, i)) {
+		return "", 0
+	}
+
+	switch tokens[i+1].Type {
+	case IdentifierToken, KeywordToken, ReservedKeywordToken:
+		return tokens[i+1].Value, 2
+	}
+
+	return "", 0
+}
+
+// isSliceColon returns a boolean indicating if the colon at the
+// supplied token follows the lower bound of an array slice (as in
+// arr[1:n], arr[i][j:k] or arr[f(x):n]) rather than an opening bracket
+// or a comma (as in ARRAY[:a, :b])
+func isSliceColon(tokens []Token, i int) bool {
+
+	for i--; i >= 0; i-- {
+		t := tokens[i]
+		switch t.Type {
+		case WhitespaceToken, CommentToken:
+			continue
+		case NumericToken, IdentifierToken, QuotedIdentifierToken, PlaceholderToken:
+			return true
+		}
+		return t.Value == ")" || t.Value == "]"
+	}
+
+	return false
+}
+
+// isCast returns a boolean indicating if the supplied token follows
+// a colon, as the second colon of a "::" cast is never a parameter
+func isCast(tokens []Token, i int) bool {
+	return i > 0 && strings.HasSuffix(tokens[i-1].Value, ":")
+}
+
+// namedLookup returns a function for looking up named parameter values
+// in the supplied map or struct
+func namedLookup(arg any) (func(string) (any, bool), error) {
+
+	v := reflect.ValueOf(arg)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, errors.New("nil parameter source")
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported parameter source %T, map keys must be strings", arg)
+		}
+		return func(name string) (any, bool) {
+			e := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+			if !e.IsValid() {
+				return nil, false
+			}
+			return e.Interface(), true
+		}, nil
+
+	case reflect.Struct:
+		return func(name string) (any, bool) {
+			f, ok := structField(v, name)
+			if !ok {
+				return nil, false
+			}
+			return f.Interface(), true
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported parameter source %T", arg)
+}
+
+// structField returns the exported field of the supplied struct that
+// matches the supplied name, preferring "db" tags over field names
+func structField(v reflect.Value, name string) (reflect.Value, bool) {
+
+	t := v.Type()
+	match := -1
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(f.Tag.Get("db"), ",")
+		switch {
+		case tag == "-":
+			continue
+		case tag == name:
+			return v.Field(i), true
+		case tag == "" && match < 0 && strings.EqualFold(f.Name, name):
+			match = i
+		}
+	}
+
+	if match < 0 {
+		return reflect.Value{}, false
+	}
+	return v.Field(match), true
+}
+
+// expandValue returns the argument(s) for a named parameter, expanding
+// slices and arrays into their elements
+func expandValue(name string, value any) ([]any, error) {
+
+	if _, ok := value.(driver.Valuer); ok {
+		return []any{value}, nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return []any{value}, nil
+		}
+	default:
+		return []any{value}, nil
+	}
+
+	if v.Len() == 0 {
+		return nil, fmt.Errorf("empty list for parameter %q", name)
+	}
+
+	values := make([]any, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).Interface()
+	}
+
+	return values, nil
+}
@@ -0,0 +1,54 @@
+package dialect
+
+import "testing"
+
+func TestExpandNamed(t *testing.T) {
+
+	type params struct {
+		ID   int `db:"id"`
+		Name string
+		Skip int `db:"-"`
+	}
+	m := map[string]any{"ids": []int{1, 2, 3}, "n": "x", "b": []byte("raw"), "a": 1}
+
+	tests := []struct {
+		d       DbDialect
+		sql     string
+		arg     any
+		want    string
+		args    []any
+		wantErr bool
+	}{
+		{NewPostgreSQLDialect(), "select * from t where id in (:ids) and n = :n and x = ':n'", m,
+			"select * from t where id in ($1, $2, $3) and n = $4 and x = ':n'", []any{1, 2, 3, "x"}, false},
+		{NewMySQLDialect(), "select :n, :b", m, "select ?, ?", []any{"x", []byte("raw")}, false},
+		{NewMSSQLDialect(), "select :n -- :n", m, "select @p1 -- :n", []any{"x"}, false},
+		{NewOracleDialect(), "begin x := :id; end;", params{ID: 1}, "begin x := :1; end;", []any{1}, false},
+		{NewPostgreSQLDialect(), "select :id, :name, :id, a::text", &params{ID: 1, Name: "n"},
+			"select $1, $2, $3, a::text", []any{1, "n", 1}, false},
+		{NewPostgreSQLDialect(), "select arr[1:2], arr[1:n], arr[1:2][i : n], arr[1 :n], arr[f(i):n] from t", m,
+			"select arr[1:2], arr[1:n], arr[1:2][i : n], arr[1 :n], arr[f(i):n] from t", nil, false},
+		{NewPostgreSQLDialect(), "select * from t where id = any(ARRAY[:a, :n]) and x = arr[:n]", m,
+			"select * from t where id = any(ARRAY[$1, $2]) and x = arr[$3]", []any{1, "x", "x"}, false},
+		{NewPostgreSQLDialect(), "select ARRAY[:ids]", m, "select ARRAY[$1, $2, $3]", []any{1, 2, 3}, false},
+		{NewPostgreSQLDialect(), "select x[1]:n", m, "select x[1]$1", []any{"x"}, false},
+		{NewMySQLDialect(), "select a :n, (:n)", m, "select a ?, (?)", []any{"x", "x"}, false},
+		{NewSQLiteDialect(), "select :1, :n", map[string]any{"1": 1, "n": "x"}, "select ?, ?", []any{1, "x"}, false},
+		{NewPostgreSQLDialect(), "select :skip", params{}, "", nil, true},
+		{NewPostgreSQLDialect(), "select :missing", m, "", nil, true},
+		{NewPostgreSQLDialect(), "select :ids", map[string]any{"ids": []int{}}, "", nil, true},
+		{NewPostgreSQLDialect(), "select :n, $1", m, "", nil, true},
+		{NewPostgreSQLDialect(), "select :n", 42, "", nil, true},
+	}
+
+	for _, tt := range tests {
+		got, args, err := ExpandNamed(tt.d, tt.sql, tt.arg)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ExpandNamed(%q) error %v", tt.d.DialectName(), tt.sql, err)
+			continue
+		}
+		if got != tt.want || !equalArgs(args, tt.args) {
+			t.Errorf("%s: ExpandNamed(%q) = %q %v, expected %q %v", tt.d.DialectName(), tt.sql, got, args, tt.want, tt.args)
+		}
+	}
+}
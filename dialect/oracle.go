@@ -1,13 +1,16 @@
 package dialect
 
 import (
-	"regexp"
 	"strings"
 )
 
 type OracleDialect struct {
 	dialect int
 	name    string
+
+	// extendedStringSize indicates that MAX_STRING_SIZE = EXTENDED so
+	// that varchar2, nvarchar2 and raw may be up to 32767 bytes
+	extendedStringSize bool
 }
 
 func NewOracleDialect() *OracleDialect {
@@ -19,6 +22,12 @@ func NewOracleDialect() *OracleDialect {
 	return &d
 }
 
+// SetExtendedStringSize sets whether the database uses extended
+// (MAX_STRING_SIZE = EXTENDED) or standard maximum string sizes
+func (d *OracleDialect) SetExtendedStringSize(b bool) {
+	d.extendedStringSize = b
+}
+
 func (d OracleDialect) Dialect() int {
 	return d.dialect
 }
@@ -41,2850 +50,3074 @@ func (d OracleDialect) MaxOperatorLength() int {
 	return 3
 }
 
-// IsDatatype returns a boolean indicating if the supplied string
-// (or string slice) is considered to be a datatype in Oracle
-func (d OracleDialect) IsDatatype(s ...string) bool {
+// LineCommentChars returns the character sequences that start a
+// comment that runs to the end of the line
+func (d OracleDialect) LineCommentChars() []string {
+	return []string{"--"}
+}
+
+// BlockCommentChars returns the character sequences that start and
+// end a block comment
+func (d OracleDialect) BlockCommentChars() (string, string) {
+	return "/*", "*/"
+}
+
+// NestedComments returns a boolean indicating if block comments
+// may be nested in Oracle
+func (d OracleDialect) NestedComments() bool {
+	return false
+}
 
-	var oracleDatatypes = map[string]bool{
-		"bfile":                              true,
-		"binary_double":                      true,
-		"binary_float":                       true,
-		"binary_integer":                     true,
-		"blob":                               true,
-		"boolean":                            true,
-		"char":                               true, // (n [ byte | char ])
-		"char (n)":                           true, // (n [ byte | char ])
-		"char (n byte)":                      true, // (n [ byte | char ])
-		"char (n char)":                      true, // (n [ byte | char ])
-		"clob":                               true,
-		"date":                               true,
-		"float":                              true, // (n)
-		"float (n)":                          true, // (n)
-		"integer":                            true, // (n)
-		"integer (n)":                        true, // (n)
-		"interval day to second":             true, // interval day (n) to second (x)
-		"interval day (n) to second (n)":     true, // interval day (n) to second (x)
-		"interval year to month":             true, // interval year (n) to month
-		"interval year (n) to month":         true, // interval year (n) to month
-		"long":                               true,
-		"long raw":                           true,
-		"nchar":                              true, // (n)
-		"nchar (n)":                          true, // (n)
-		"nclob":                              true,
-		"number":                             true, // [(p,s)]
-		"number (n)":                         true, // [(p,s)]
-		"number (n,n)":                       true, // [(p,s)]
-		"nvarchar2":                          true, // (n)
-		"nvarchar2 (n)":                      true, // (n)
-		"pls_integer":                        true,
-		"raw":                                true, // (n)
-		"raw (n)":                            true, // (n)
-		"ref cursor":                         true,
-		"rowid":                              true,
-		"smallint":                           true,
-		"timestamp":                          true, // (n)
-		"timestamp (n)":                      true, // (n)
-		"timestamp with local time zone":     true, // timestamp (n) with local time zone
-		"timestamp (n) with local time zone": true, // timestamp (n) with local time zone
-		"timestamp with time zone":           true, // timestamp (n) with time zone
-		"timestamp (n) with time zone":       true, // timestamp (n) with time zone
-		"urowid":                             true, // [(n)]
-		"urowid (n)":                         true, // [(n)]
-		"varchar":                            true, // (n)
-		"varchar (n)":                        true, // (n)
-		"varchar2":                           true, // (n [ byte | char ])
-		"varchar2 (n)":                       true, // (n [ byte | char ])
-		"varchar2 (n byte)":                  true, // (n [ byte | char ])
-		"varchar2 (n char)":                  true, // (n [ byte | char ])
-		"identity":                           true,
+// IsComment returns a boolean indicating if the supplied string
+// is considered to be a complete comment in Oracle
+func (d OracleDialect) IsComment(s string) bool {
+	return isComment(d, s)
+}
+
+// IsHint returns a boolean indicating if the supplied string
+// is considered to be an optimizer hint in Oracle
+func (d OracleDialect) IsHint(s string) bool {
+
+	// Hints are comments where the comment chars are immediately
+	// followed by a plus sign
+	if !d.IsComment(s) {
+		return false
 	}
+	return strings.HasPrefix(s, "/*+") || strings.HasPrefix(s, "--+")
+}
 
-	var z []string
-	rn := regexp.MustCompile(`^[0-9]+$`)
-	pv := ""
-
-	for i, v := range s {
-		switch v {
-		case "(":
-			z = append(z, " "+v)
-		case ")", ",":
-			z = append(z, v)
-		default:
-			switch {
-			case rn.MatchString(v):
-				z = append(z, "n")
-			case i == 0:
-				z = append(z, v)
-			case pv == "(":
-				z = append(z, v)
-			default:
-				z = append(z, " "+v)
-			}
-		}
-		pv = v
+// IsExecutableComment returns a boolean indicating if the supplied
+// string is considered to be an executable comment in Oracle
+func (d OracleDialect) IsExecutableComment(s string) bool {
+	return false
+}
+
+// stringForms returns the forms of string literal supported by
+// Oracle
+func (d OracleDialect) stringForms() []stringForm {
+
+	// Q'...' and NQ'...' use the alternative quoting mechanism, e.g.
+	// q'[It's]' or q'!It's!'
+	return []stringForm{
+		{"", '\'', stringPlain},
+		{"N", '\'', stringPlain},
+		{"Q", '\'', stringAlternative},
+		{"NQ", '\'', stringAlternative},
 	}
+}
 
-	k := strings.ToLower(strings.Join(z, ""))
-	if _, ok := oracleDatatypes[k]; ok {
-		return true
+// IsStringLiteral returns a boolean indicating if the supplied string
+// is considered to be a complete string literal in Oracle
+func (d OracleDialect) IsStringLiteral(s string) bool {
+	return isStringLiteral(d.stringForms(), s)
+}
+
+// UnquoteString returns the value of the supplied Oracle string literal
+func (d OracleDialect) UnquoteString(s string) (string, error) {
+	return unquoteString(d.stringForms(), s)
+}
+
+// QuoteString returns the supplied string quoted as a string literal
+// for Oracle with any special characters escaped
+func (d OracleDialect) QuoteString(s string) string {
+	return quoteNational(s)
+}
+
+// identQuotes returns the forms of quoted identifier supported by
+// Oracle, with the preferred form first
+func (d OracleDialect) identQuotes() []identQuote {
+	return []identQuote{
+		{'"', '"', true},
 	}
+}
 
-	return false
+// QuoteIdentifier returns the supplied identifier quoted for Oracle
+// with any embedded quote characters escaped
+func (d OracleDialect) QuoteIdentifier(s string) (string, error) {
+	return quoteIdentifier(d.identQuotes()[0], s)
 }
 
-func (d OracleDialect) keyword(s string) (bool, bool) {
+// UnquoteIdentifier returns the name of the supplied quoted Oracle
+// identifier
+func (d OracleDialect) UnquoteIdentifier(s string) (string, error) {
+	return unquoteIdentifier(d.identQuotes(), s)
+}
+
+// FormatIdentifier returns the supplied identifier as it should be
+// written in Oracle, quoting it only when needed
+func (d OracleDialect) FormatIdentifier(s string) (string, error) {
+	return formatIdentifier(d, s)
+}
+
+// numberForm returns the forms of numeric literal supported by
+// Oracle
+func (d OracleDialect) numberForm() numberForm {
+
+	// The f (or F) and d (or D) suffixes mark BINARY_FLOAT and
+	// BINARY_DOUBLE literals
+	return numberForm{floatSuffix: true}
+}
+
+// IsNumericLiteral returns a boolean indicating if the supplied string
+// is considered to be a numeric literal in Oracle
+func (d OracleDialect) IsNumericLiteral(s string) bool {
+	return isNumericLiteral(d.numberForm(), s)
+}
+
+// ParseNumericLiteral returns the parsed form of the supplied Oracle
+// numeric literal
+func (d OracleDialect) ParseNumericLiteral(s string) (NumericLiteral, error) {
+	return parseNumericLiteral(d.numberForm(), s)
+}
+
+var oracleDatatypes = map[string]bool{
+	"bfile":                              true,
+	"binary_double":                      true,
+	"binary_float":                       true,
+	"binary_integer":                     true,
+	"blob":                               true,
+	"boolean":                            true,
+	"char":                               true, // (n [ byte | char ])
+	"char (n)":                           true, // (n [ byte | char ])
+	"char (n byte)":                      true, // (n [ byte | char ])
+	"char (n char)":                      true, // (n [ byte | char ])
+	"clob":                               true,
+	"date":                               true,
+	"float":                              true, // (n)
+	"float (n)":                          true, // (n)
+	"integer":                            true, // (n)
+	"integer (n)":                        true, // (n)
+	"interval day to second":             true, // interval day (n) to second (x)
+	"interval day (n) to second (n)":     true, // interval day (n) to second (x)
+	"interval year to month":             true, // interval year (n) to month
+	"interval year (n) to month":         true, // interval year (n) to month
+	"long":                               true,
+	"long raw":                           true,
+	"nchar":                              true, // (n)
+	"nchar (n)":                          true, // (n)
+	"nclob":                              true,
+	"number":                             true, // [(p,s)]
+	"number (n)":                         true, // [(p,s)]
+	"number (n,n)":                       true, // [(p,s)]
+	"nvarchar2":                          true, // (n)
+	"nvarchar2 (n)":                      true, // (n)
+	"pls_integer":                        true,
+	"raw":                                true, // (n)
+	"raw (n)":                            true, // (n)
+	"ref cursor":                         true,
+	"rowid":                              true,
+	"smallint":                           true,
+	"timestamp":                          true, // (n)
+	"timestamp (n)":                      true, // (n)
+	"timestamp with local time zone":     true, // timestamp (n) with local time zone
+	"timestamp (n) with local time zone": true, // timestamp (n) with local time zone
+	"timestamp with time zone":           true, // timestamp (n) with time zone
+	"timestamp (n) with time zone":       true, // timestamp (n) with time zone
+	"urowid":                             true, // [(n)]
+	"urowid (n)":                         true, // [(n)]
+	"varchar":                            true, // (n)
+	"varchar (n)":                        true, // (n)
+	"varchar2":                           true, // (n [ byte | char ])
+	"varchar2 (n)":                       true, // (n [ byte | char ])
+	"varchar2 (n byte)":                  true, // (n [ byte | char ])
+	"varchar2 (n char)":                  true, // (n [ byte | char ])
+	"identity":                           true,
+}
+
+// oracleLengthDatatypes is the set of Oracle datatypes whose
+// parameter is a length (as opposed to a precision)
+var oracleLengthDatatypes = map[string]bool{
+	"char":      true,
+	"nchar":     true,
+	"nvarchar2": true,
+	"raw":       true,
+	"urowid":    true,
+	"varchar":   true,
+	"varchar2":  true,
+}
+
+// oracleDatatypeParts is the set of words used in Oracle datatypes
+var oracleDatatypeParts = datatypeParts(oracleDatatypes)
 
-	/*
-	   Oracle keywords
-
-	   SELECT  CASE
-	               WHEN length ( keyword ) = 1 AND reserved = 'Y' THEN '// "' || keyword || '": true,'
-	               WHEN length ( keyword ) = 1 THEN '// "' || keyword || '": false,'
-	               WHEN reserved = 'Y' THEN '"' || keyword || '": true,'
-	               ELSE '"' || keyword || '": false,'
-	               END
-	       FROM v$reserved_words
-	       WHERE keyword IS NOT NULL
-	           AND regexp_like ( keyword, '^[A-Z]' )
-	       ORDER BY CASE
-	               WHEN length ( keyword ) = 1 THEN 1
-	               ELSE 2
-	               END,
-	               keyword ;
-
-
-	   PL/SQL Reserved Words and Keywords
-
-	   https://docs.oracle.com/en/database/oracle/oracle-database/19/lnpls/plsql-reserved-words-keywords.html
-
-	   bdr549
-
-	*/
-
-	var oracleSQLKeywords = map[string]bool{
-		// "A": false,
-		// "D": false,
-		// "E": false,
-		// "G": false,
-		// "H": false,
-		// "K": false,
-		// "M": false,
-		// "P": false,
-		// "T": false,
-		// "U": false,
-		"ABORT":                          false,
-		"ABS":                            false,
-		"ABSENT":                         false,
-		"ACCESS":                         false,
-		"ACCESSED":                       false,
-		"ACCOUNT":                        false,
-		"ACL":                            false,
-		"ACOS":                           false,
-		"ACROSS":                         false,
-		"ACTION":                         false,
-		"ACTIONS":                        false,
-		"ACTIVATE":                       false,
-		"ACTIVE_COMPONENT":               false,
-		"ACTIVE_DATA":                    false,
-		"ACTIVE_FUNCTION":                false,
-		"ACTIVE_TAG":                     false,
-		"ACTIVE":                         false,
-		"ACTIVITY":                       false,
-		"ADAPTIVE_PLAN":                  false,
-		"ADD_COLUMN":                     false,
-		"ADD_GROUP":                      false,
-		"ADD_MONTHS":                     false,
-		"ADD":                            false,
-		"ADG_REDIRECT_DML":               false,
-		"ADG_REDIRECT_PLSQL":             false,
-		"ADJ_DATE":                       false,
-		"ADMIN":                          false,
-		"ADMINISTER":                     false,
-		"ADMINISTRATOR":                  false,
-		"ADVANCED":                       false,
-		"ADVISE":                         false,
-		"ADVISOR":                        false,
-		"AFD_DISKSTRING":                 false,
-		"AFFINITY":                       false,
-		"AFTER":                          true,
-		"AGGREGATE":                      false,
-		"AGGREGATES":                     false,
-		"ALGORITHM":                      false,
-		"ALIAS":                          false,
-		"ALL_ROWS":                       false,
-		"ALLOCATE":                       false,
-		"ALLOW":                          false,
-		"ALTERNATE":                      false,
-		"ALWAYS":                         false,
-		"ANALYTIC":                       false,
-		"ANALYZE":                        false,
-		"ANCESTOR":                       false,
-		"ANCILLARY":                      false,
-		"AND_EQUAL":                      false,
-		"ANOMALY":                        false,
-		"ANSI_REARCH":                    false,
-		"ANSWER_QUERY_USING_STATS":       false,
-		"ANTIJOIN":                       false,
-		"ANYSCHEMA":                      false,
-		"APPEND_VALUES":                  false,
-		"APPEND":                         false,
-		"APPENDCHILDXML":                 false,
-		"APPLICATION":                    false,
-		"APPLY":                          false,
-		"APPROX_COUNT_DISTINCT_AGG":      false,
-		"APPROX_COUNT_DISTINCT_DETAIL":   false,
-		"APPROX_COUNT_DISTINCT":          false,
-		"APPROX_COUNT":                   false,
-		"APPROX_MEDIAN":                  false,
-		"APPROX_PERCENTILE_AGG":          false,
-		"APPROX_PERCENTILE_DETAIL":       false,
-		"APPROX_PERCENTILE":              false,
-		"APPROX_RANK":                    false,
-		"APPROX_SUM":                     false,
-		"ARCHIVAL":                       false,
-		"ARCHIVE":                        false,
-		"ARCHIVED":                       false,
-		"ARCHIVELOG":                     false,
-		"ARRAY":                          false,
-		"AS_JSON":                        false,
-		"ASCII":                          false,
-		"ASCIISTR":                       false,
-		"ASIN":                           false,
-		"ASIS":                           false,
-		"ASSEMBLY":                       false,
-		"ASSIGN":                         false,
-		"ASSOCIATE":                      false,
-		"ASYNC":                          false,
-		"ASYNCHRONOUS":                   false,
-		"AT":                             false,
-		"ATAN":                           false,
-		"ATAN2":                          false,
-		"ATTRIBUTE":                      false,
-		"ATTRIBUTES":                     false,
-		"AUDIT":                          false,
-		"AUTHENTICATED":                  false,
-		"AUTHENTICATION":                 false,
-		"AUTHID":                         false,
-		"AUTHORIZATION":                  false,
-		"AUTO_LOGIN":                     false,
-		"AUTO_REOPTIMIZE":                false,
-		"AUTO":                           false,
-		"AUTOALLOCATE":                   false,
-		"AUTOEXTEND":                     false,
-		"AUTOMATIC":                      false,
-		"AV_CACHE":                       false,
-		"AVAILABILITY":                   false,
-		"AVCACHE_OP":                     false,
-		"AVERAGE_RANK":                   false,
-		"AVG":                            false,
-		"AVMDX_OP":                       false,
-		"AVRO":                           false,
-		"AW":                             false,
-		"BACKGROUND":                     false,
-		"BACKUP":                         false,
-		"BAND_JOIN":                      false,
-		"BASIC":                          false,
-		"BASICFILE":                      false,
-		"BATCH_TABLE_ACCESS_BY_ROWID":    false,
-		"BATCH":                          false,
-		"BATCHSIZE":                      false,
-		"BECOME":                         false,
-		"BEFORE":                         true,
-		"BEGIN_OUTLINE_DATA":             false,
-		"BEGIN":                          true,
-		"BEGINNING":                      false,
-		"BEHALF":                         false,
-		"BEQUEATH":                       false,
-		"BFILE":                          false,
-		"BFILENAME":                      false,
-		"BIGFILE":                        false,
-		"BIN_TO_NUM":                     false,
-		"BINARY_DOUBLE_INFINITY":         false,
-		"BINARY_DOUBLE_NAN":              false,
-		"BINARY_DOUBLE":                  false,
-		"BINARY_FLOAT_INFINITY":          false,
-		"BINARY_FLOAT_NAN":               false,
-		"BINARY_FLOAT":                   false,
-		"BINARY":                         false,
-		"BIND_AWARE":                     false,
-		"BINDING":                        false,
-		"BITAND":                         false,
-		"BITMAP_AND":                     false,
-		"BITMAP_TREE":                    false,
-		"BITMAP":                         false,
-		"BITMAPS":                        false,
-		"BITS":                           false,
-		"BLOB":                           false,
-		"BLOCK_RANGE":                    false,
-		"BLOCK":                          false,
-		"BLOCKS":                         false,
-		"BLOCKSIZE":                      false,
-		"BODY":                           true,
-		"BOTH":                           false,
-		"BOUND":                          false,
-		"BRANCH":                         false,
-		"BREADTH":                        false,
-		"BROADCAST":                      false,
-		"BSON":                           false,
-		"BUFFER_CACHE":                   false,
-		"BUFFER_POOL":                    false,
-		"BUFFER":                         false,
-		"BUILD":                          false,
-		"BULK":                           false,
-		"BUSHY_JOIN":                     false,
-		"BYPASS_RECURSIVE_CHECK":         false,
-		"BYPASS_UJVC":                    false,
-		"BYTE":                           false,
-		"CACHE_CB":                       false,
-		"CACHE_INSTANCES":                false,
-		"CACHE_TEMP_TABLE":               false,
-		"CACHE":                          false,
-		"CACHING":                        false,
-		"CALCULATED":                     false,
-		"CALL":                           false,
-		"CALLBACK":                       false,
-		"CANCEL":                         false,
-		"CAPACITY":                       false,
-		"CAPTION":                        false,
-		"CARDINALITY":                    false,
-		"CASCADE":                        true,
-		"CASE":                           true,
-		"CAST":                           false,
-		"CATEGORY":                       false,
-		"CDB_HTTP_HANDLER":               false,
-		"CDB$DEFAULT":                    false,
-		"CEIL":                           false,
-		"CELL_FLASH_CACHE":               false,
-		"CELLMEMORY":                     false,
-		"CERTIFICATE":                    false,
-		"CFILE":                          false,
-		"CHAINED":                        false,
-		"CHANGE_DUPKEY_ERROR_INDEX":      false,
-		"CHANGE":                         false,
-		"CHAR_CS":                        false,
-		"CHARACTER":                      false,
-		"CHARTOROWID":                    false,
-		"CHECK_ACL_REWRITE":              false,
-		"CHECKPOINT":                     false,
-		"CHILD":                          false,
-		"CHOOSE":                         false,
-		"CHR":                            false,
-		"CHUNK":                          false,
-		"CLASS":                          false,
-		"CLASSIFICATION":                 false,
-		"CLASSIFIER":                     false,
-		"CLAUSE":                         false,
-		"CLEAN":                          false,
-		"CLEANUP":                        false,
-		"CLEAR":                          false,
-		"CLIENT":                         false,
-		"CLOB":                           false,
-		"CLONE":                          false,
-		"CLOSE_CACHED_OPEN_CURSORS":      false,
-		"CLOSE":                          false,
-		"CLOSEST":                        false,
-		"CLUSTER_BY_ROWID":               false,
-		"CLUSTER_DETAILS":                false,
-		"CLUSTER_DISTANCE":               false,
-		"CLUSTER_ID":                     false,
-		"CLUSTER_PROBABILITY":            false,
-		"CLUSTER_SET":                    false,
-		"CLUSTERING_FACTOR":              false,
-		"CLUSTERING":                     false,
-		"CO_AUTH_IND":                    false,
-		"COALESCE_SQ":                    false,
-		"COALESCE":                       false,
-		"COARSE":                         false,
-		"COLD":                           false,
-		"COLLATE":                        false,
-		"COLLATION":                      false,
-		"COLLECT":                        false,
-		"COLUMN_AUTH_INDICATOR":          false,
-		"COLUMN_AUTHORIZATION_INDICATOR": false,
-		"COLUMN_STATS":                   false,
-		"COLUMN_VALUE":                   false,
-		"COLUMN":                         true,
-		"COLUMNAR":                       false,
-		"COLUMNS":                        false,
-		"COMMENT":                        true,
-		"COMMIT":                         true,
-		"COMMITTED":                      false,
-		"COMMON_DATA_MAP":                false,
-		"COMMON":                         false,
-		"COMPACT":                        false,
-		"COMPATIBILITY":                  false,
-		"COMPILE":                        false,
-		"COMPLETE":                       false,
-		"COMPLIANCE":                     false,
-		"COMPONENT":                      false,
-		"COMPONENTS":                     false,
-		"COMPOSE":                        false,
-		"COMPOSITE_LIMIT":                false,
-		"COMPOSITE":                      false,
-		"COMPOUND":                       false,
-		"COMPUTATION":                    false,
-		"COMPUTE":                        false,
-		"CON_DBID_TO_ID":                 false,
-		"CON_GUID_TO_ID":                 false,
-		"CON_ID_FILTER":                  false,
-		"CON_ID_TO_CON_NAME":             false,
-		"CON_ID_TO_DBID":                 false,
-		"CON_ID":                         false,
-		"CON_NAME_TO_ID":                 false,
-		"CON_UID_TO_ID":                  false,
-		"CONCAT":                         false,
-		"CONDITION":                      false,
-		"CONDITIONAL":                    false,
-		"CONFIRM":                        false,
-		"CONFORMING":                     false,
-		"CONNECT_BY_CB_WHR_ONLY":         false,
-		"CONNECT_BY_COMBINE_SW":          false,
-		"CONNECT_BY_COST_BASED":          false,
-		"CONNECT_BY_ELIM_DUPS":           false,
-		"CONNECT_BY_FILTERING":           false,
-		"CONNECT_BY_ISCYCLE":             false,
-		"CONNECT_BY_ISLEAF":              false,
-		"CONNECT_BY_ROOT":                false,
-		"CONNECT_TIME":                   false,
-		"CONSIDER":                       false,
-		"CONSISTENT":                     false,
-		"CONST":                          false,
-		"CONSTANT":                       true,
-		"CONSTRAINT":                     false,
-		"CONSTRAINTS":                    false,
-		"CONTAINER_DATA_ADMIT_NULL":      false,
-		"CONTAINER_DATA":                 false,
-		"CONTAINER_MAP_OBJECT":           false,
-		"CONTAINER_MAP":                  false,
-		"CONTAINER":                      false,
-		"CONTAINERS_DEFAULT":             false,
-		"CONTAINERS":                     false,
-		"CONTENT":                        false,
-		"CONTENTS":                       false,
-		"CONTEXT":                        false,
-		"CONTINUE":                       true,
-		"CONTROLFILE":                    false,
-		"CONVERSION":                     false,
-		"CONVERT":                        false,
-		"COOKIE":                         false,
-		"COPY":                           false,
-		"CORR_K":                         false,
-		"CORR_S":                         false,
-		"CORR":                           false,
-		"CORRUPT_XID_ALL":                false,
-		"CORRUPT_XID":                    false,
-		"CORRUPTION":                     false,
-		"COS":                            false,
-		"COSH":                           false,
-		"COST_XML_QUERY_REWRITE":         false,
-		"COST":                           false,
-		"COUNT":                          false,
-		"COVAR_POP":                      false,
-		"COVAR_SAMP":                     false,
-		"CPU_COSTING":                    false,
-		"CPU_COUNT":                      false,
-		"CPU_PER_CALL":                   false,
-		"CPU_PER_SESSION":                false,
-		"CRASH":                          false,
-		"CREATE_FILE_DEST":               false,
-		"CREATE_STORED_OUTLINES":         false,
-		"CREATION":                       false,
-		"CREDENTIAL":                     false,
-		"CREDENTIALS":                    false,
-		"CRITICAL":                       false,
-		"CROSS":                          true,
-		"CROSSEDITION":                   false,
-		"CSCONVERT":                      false,
-		"CUBE_AJ":                        false,
-		"CUBE_GB":                        false,
-		"CUBE_SJ":                        false,
-		"CUBE":                           false,
-		"CUME_DIST":                      false,
-		"CUME_DISTM":                     false,
-		"CURRENT_DATE":                   false,
-		"CURRENT_INSTANCE":               false,
-		"CURRENT_SCHEMA":                 false,
-		"CURRENT_TIME":                   false,
-		"CURRENT_TIMESTAMP":              false,
-		"CURRENT_USER":                   false,
-		"CURRENT":                        false,
-		"CURRENTV":                       false,
-		"CURSOR_SHARING_EXACT":           false,
-		"CURSOR_SPECIFIC_SEGMENT":        false,
-		"CURSOR":                         false,
-		"CV":                             false,
-		"CYCLE":                          false,
-		"DANGLING":                       false,
-		"DATA_LINK_DML":                  false,
-		"DATA_SECURITY_REWRITE_LIMIT":    false,
-		"DATA_VALIDATE":                  false,
-		"DATA":                           false,
-		"DATABASE":                       false,
-		"DATAFILE":                       false,
-		"DATAFILES":                      false,
-		"DATAMOVEMENT":                   false,
-		"DATAOBJ_TO_MAT_PARTITION":       false,
-		"DATAOBJ_TO_PARTITION":           false,
-		"DATAOBJNO":                      false,
-		"DATAPUMP":                       false,
-		"DATASTORE":                      false,
-		"DATE_MODE":                      false,
-		"DAY":                            false,
-		"DAYS":                           false,
-		"DB_ROLE_CHANGE":                 false,
-		"DB_UNIQUE_NAME":                 false,
-		"DB_VERSION":                     false,
-		"DBA_RECYCLEBIN":                 false,
-		"DBA":                            false,
-		"DBMS_STATS":                     false,
-		"DBTIMEZONE":                     false,
-		"DDL":                            false,
-		"DEALLOCATE":                     false,
-		"DEBUG":                          false,
-		"DEBUGGER":                       false,
-		"DEC":                            false,
-		"DECLARE":                        false,
-		"DECODE":                         false,
-		"DECOMPOSE":                      false,
-		"DECOMPRESS":                     false,
-		"DECORRELATE":                    false,
-		"DECR":                           false,
-		"DECREMENT":                      false,
-		"DECRYPT":                        false,
-		"DEDUPLICATE":                    false,
-		"DEFAULT_COLLATION":              false,
-		"DEFAULT_PDB_HINT":               false,
-		"DEFAULTS":                       false,
-		"DEFERRABLE":                     false,
-		"DEFERRED":                       false,
-		"DEFINE":                         false,
-		"DEFINED":                        false,
-		"DEFINER":                        false,
-		"DEFINITION":                     false,
-		"DEGREE":                         false,
-		"DELAY":                          false,
-		"DELEGATE":                       false,
-		"DELETE_ALL":                     false,
-		"DELETEXML":                      false,
-		"DEMAND":                         false,
-		"DENSE_RANK":                     false,
-		"DENSE_RANKM":                    false,
-		"DEPENDENT":                      false,
-		"DEPTH":                          false,
-		"DEQUEUE":                        false,
-		"DEREF_NO_REWRITE":               false,
-		"DEREF":                          false,
-		"DESCENDANT":                     false,
-		"DESCRIPTION":                    false,
-		"DESTROY":                        false,
-		"DETACHED":                       false,
-		"DETERMINED":                     false,
-		"DETERMINES":                     false,
-		"DETERMINISTIC":                  false,
-		"DG_GATHER_STATS":                false,
-		"DICTIONARY":                     false,
-		"DIGEST":                         false,
-		"DIMENSION":                      false,
-		"DIMENSIONS":                     false,
-		"DIRECT_LOAD":                    false,
-		"DIRECT_PATH":                    false,
-		"DIRECTORY":                      false,
-		"DISABLE_ALL":                    false,
-		"DISABLE_CONTAINER_MAP":          false,
-		"DISABLE_CONTAINERS_DEFAULT":     false,
-		"DISABLE_PARALLEL_DML":           false,
-		"DISABLE_PRESET":                 false,
-		"DISABLE_RPKE":                   false,
-		"DISABLE":                        true,
-		"DISALLOW":                       false,
-		"DISASSOCIATE":                   false,
-		"DISCARD":                        false,
-		"DISCONNECT":                     false,
-		"DISK":                           false,
-		"DISKGROUP":                      false,
-		"DISKS":                          false,
-		"DISMOUNT":                       false,
-		"DIST_AGG_PROLLUP_PUSHDOWN":      false,
-		"DISTINGUISHED":                  false,
-		"DISTRIBUTE":                     false,
-		"DISTRIBUTED":                    false,
-		"DML_UPDATE":                     false,
-		"DML":                            false,
-		"DOCFIDELITY":                    false,
-		"DOCUMENT":                       false,
-		"DOMAIN_INDEX_FILTER":            false,
-		"DOMAIN_INDEX_NO_SORT":           false,
-		"DOMAIN_INDEX_SORT":              false,
-		"DOUBLE":                         false,
-		"DOWNGRADE":                      false,
-		"DRIVING_SITE":                   false,
-		"DROP_COLUMN":                    false,
-		"DROP_GROUP":                     false,
-		"DST_UPGRADE_INSERT_CONV":        false,
-		"DUMP":                           false,
-		"DUPLICATE":                      false,
-		"DUPLICATED":                     false,
-		"DV":                             false,
-		"DYNAMIC_SAMPLING_EST_CDN":       false,
-		"DYNAMIC_SAMPLING":               false,
-		"DYNAMIC":                        false,
-		"EACH":                           false,
-		"EDITION":                        false,
-		"EDITIONABLE":                    false,
-		"EDITIONING":                     false,
-		"EDITIONS":                       false,
-		"ELEMENT":                        false,
-		"ELIM_GROUPBY":                   false,
-		"ELIMINATE_JOIN":                 false,
-		"ELIMINATE_OBY":                  false,
-		"ELIMINATE_OUTER_JOIN":           false,
-		"ELIMINATE_SQ":                   false,
-		"EM":                             false,
-		"EMPTY_BLOB":                     false,
-		"EMPTY_CLOB":                     false,
-		"EMPTY":                          false,
-		"ENABLE_ALL":                     false,
-		"ENABLE_PARALLEL_DML":            false,
-		"ENABLE_PRESET":                  false,
-		"ENABLE":                         true,
-		"ENCODE":                         false,
-		"ENCODING":                       false,
-		"ENCRYPT":                        false,
-		"ENCRYPTION":                     false,
-		"END_OUTLINE_DATA":               false,
-		"END":                            true,
-		"ENFORCE":                        false,
-		"ENFORCED":                       false,
-		"ENQUEUE":                        false,
-		"ENTERPRISE":                     false,
-		"ENTITYESCAPING":                 false,
-		"ENTRY":                          false,
-		"EQUIPART":                       false,
-		"ERROR_ARGUMENT":                 false,
-		"ERROR_ON_OVERLAP_TIME":          false,
-		"ERROR":                          false,
-		"ERRORS":                         false,
-		"ESCAPE":                         false,
-		"ESTIMATE":                       false,
-		"EVAL":                           false,
-		"EVALNAME":                       false,
-		"EVALUATE":                       false,
-		"EVALUATION":                     false,
-		"EVENTS":                         false,
-		"EVERY":                          false,
-		"EXCEPT":                         false,
-		"EXCEPTIONS":                     false,
-		"EXCHANGE":                       false,
-		"EXCLUDE":                        false,
-		"EXCLUDING":                      false,
-		"EXECUTE":                        false,
-		"EXEMPT":                         false,
-		"EXISTING":                       false,
-		"EXISTSNODE":                     false,
-		"EXP":                            false,
-		"EXPAND_GSET_TO_UNION":           false,
-		"EXPAND_TABLE":                   false,
-		"EXPIRE":                         false,
-		"EXPLAIN":                        false,
-		"EXPLOSION":                      false,
-		"EXPORT":                         false,
-		"EXPR_CORR_CHECK":                false,
-		"EXPRESS":                        false,
-		"EXTEND":                         false,
-		"EXTENDED":                       false,
-		"EXTENDS":                        false,
-		"EXTENT":                         false,
-		"EXTENTS":                        false,
-		"EXTERNAL":                       false,
-		"EXTERNALLY":                     false,
-		"EXTRA":                          false,
-		"EXTRACT":                        false,
-		"EXTRACTCLOBXML":                 false,
-		"EXTRACTVALUE":                   false,
-		"FACILITY":                       false,
-		"FACT":                           false,
-		"FACTOR":                         false,
-		"FACTORIZE_JOIN":                 false,
-		"FAILED_LOGIN_ATTEMPTS":          false,
-		"FAILED":                         false,
-		"FAILGROUP":                      false,
-		"FAILOVER":                       false,
-		"FAILURE":                        false,
-		"FALSE":                          false,
-		"FAMILY":                         false,
-		"FAR":                            false,
-		"FAST":                           false,
-		"FBTSCAN":                        false,
-		"FEATURE_COMPARE":                false,
-		"FEATURE_DETAILS":                false,
-		"FEATURE_ID":                     false,
-		"FEATURE_SET":                    false,
-		"FEATURE_VALUE":                  false,
-		"FEATURE":                        false,
-		"FEDERATION":                     false,
-		"FETCH":                          false,
-		"FILE_NAME_CONVERT":              false,
-		"FILE":                           false,
-		"FILEGROUP":                      false,
-		"FILESYSTEM_LIKE_LOGGING":        false,
-		"FILTER":                         false,
-		"FINAL":                          false,
-		"FINE":                           false,
-		"FINISH":                         false,
-		"FIRST_ROWS":                     false,
-		"FIRST_VALUE":                    false,
-		"FIRST":                          false,
-		"FIRSTM":                         false,
-		"FIXED_VIEW_DATA":                false,
-		"FLAGGER":                        false,
-		"FLASH_CACHE":                    false,
-		"FLASHBACK":                      false,
-		"FLEX":                           false,
-		"FLOB":                           false,
-		"FLOOR":                          false,
-		"FLUSH":                          false,
-		"FOLDER":                         false,
-		"FOLLOWING":                      false,
-		"FOLLOWS":                        false,
-		"FORCE_SPATIAL":                  false,
-		"FORCE_XML_QUERY_REWRITE":        false,
-		"FORCE":                          true,
-		"FOREIGN":                        false,
-		"FOREVER":                        false,
-		"FORMAT":                         false,
-		"FORWARD":                        false,
-		"FRAGMENT_NUMBER":                false,
-		"FREE":                           false,
-		"FREELIST":                       false,
-		"FREELISTS":                      false,
-		"FREEPOOLS":                      false,
-		"FRESH_MV":                       false,
-		"FRESH":                          false,
-		"FROM_TZ":                        false,
-		"FULL_OUTER_JOIN_TO_OUTER":       false,
-		"FULL":                           true,
-		"FUNCTION":                       false,
-		"FUNCTIONS":                      false,
-		"GATHER_OPTIMIZER_STATISTICS":    false,
-		"GATHER_PLAN_STATISTICS":         false,
-		"GBY_CONC_ROLLUP":                false,
-		"GBY_PUSHDOWN":                   false,
-		"GENERATED":                      false,
-		"GET":                            false,
-		"GLOBAL_NAME":                    false,
-		"GLOBAL_TOPIC_ENABLED":           false,
-		"GLOBAL":                         false,
-		"GLOBALLY":                       false,
-		"GRANTED":                        false,
-		"GRANULAR":                       false,
-		"GREATEST":                       false,
-		"GROUP_BY":                       false,
-		"GROUP_ID":                       false,
-		"GROUPING_ID":                    false,
-		"GROUPING":                       false,
-		"GROUPS":                         false,
-		"GUARANTEE":                      false,
-		"GUARANTEED":                     false,
-		"GUARD":                          false,
-		"HALF_YEARS":                     false,
-		"HASH_AJ":                        false,
-		"HASH_SJ":                        false,
-		"HASH":                           false,
-		"HASHKEYS":                       false,
-		"HEADER":                         false,
-		"HEAP":                           false,
-		"HELP":                           false,
-		"HEXTORAW":                       false,
-		"HEXTOREF":                       false,
-		"HIDDEN":                         false,
-		"HIDE":                           false,
-		"HIER_ANCESTOR":                  false,
-		"HIER_CAPTION":                   false,
-		"HIER_CHILD_COUNT":               false,
-		"HIER_COLUMN":                    false,
-		"HIER_DEPTH":                     false,
-		"HIER_DESCRIPTION":               false,
-		"HIER_HAS_CHILDREN":              false,
-		"HIER_LAG":                       false,
-		"HIER_LEAD":                      false,
-		"HIER_LEVEL":                     false,
-		"HIER_MEMBER_NAME":               false,
-		"HIER_MEMBER_UNIQUE_NAME":        false,
-		"HIER_ORDER":                     false,
-		"HIER_PARENT":                    false,
-		"HIER_WINDOW":                    false,
-		"HIERARCHICAL":                   false,
-		"HIERARCHIES":                    false,
-		"HIERARCHY":                      false,
-		"HIGH":                           false,
-		"HINTSET_BEGIN":                  false,
-		"HINTSET_END":                    false,
-		"HOST":                           false,
-		"HOT":                            false,
-		"HOUR":                           false,
-		"HOURS":                          false,
-		"HTTP":                           false,
-		"HWM_BROKERED":                   false,
-		"HYBRID":                         false,
-		"ID":                             false,
-		"IDENTIFIER":                     false,
-		"IDENTITY":                       false,
-		"IDGENERATORS":                   false,
-		"IDLE_TIME":                      false,
-		"IF":                             true,
-		"IGNORE_OPTIM_EMBEDDED_HINTS":    false,
-		"IGNORE_ROW_ON_DUPKEY_INDEX":     false,
-		"IGNORE_WHERE_CLAUSE":            false,
-		"IGNORE":                         false,
-		"ILM":                            false,
-		"IMMEDIATE":                      false,
-		"IMPACT":                         false,
-		"IMPORT":                         false,
-		"IN_MEMORY_METADATA":             false,
-		"IN_XQUERY":                      false,
-		"INACTIVE_ACCOUNT_TIME":          false,
-		"INACTIVE":                       false,
-		"INCLUDE_VERSION":                false,
-		"INCLUDE":                        false,
-		"INCLUDES":                       false,
-		"INCLUDING":                      false,
-		"INCR":                           false,
-		"INCREMENT":                      false,
-		"INCREMENTAL":                    false,
-		"INDENT":                         false,
-		"INDEX_ASC":                      false,
-		"INDEX_COMBINE":                  false,
-		"INDEX_DESC":                     false,
-		"INDEX_FFS":                      false,
-		"INDEX_FILTER":                   false,
-		"INDEX_JOIN":                     false,
-		"INDEX_ROWS":                     false,
-		"INDEX_RRS":                      false,
-		"INDEX_RS_ASC":                   false,
-		"INDEX_RS_DESC":                  false,
-		"INDEX_RS":                       false,
-		"INDEX_SCAN":                     false,
-		"INDEX_SKIP_SCAN":                false,
-		"INDEX_SS_ASC":                   false,
-		"INDEX_SS_DESC":                  false,
-		"INDEX_SS":                       false,
-		"INDEX_STATS":                    false,
-		"INDEXED":                        false,
-		"INDEXES":                        false,
-		"INDEXING":                       false,
-		"INDEXTYPE":                      false,
-		"INDEXTYPES":                     false,
-		"INDICATOR":                      false,
-		"INFINITE":                       false,
-		"INFORMATIONAL":                  false,
-		"INHERIT":                        false,
-		"INITCAP":                        false,
-		"INITIAL":                        false,
-		"INITIALIZED":                    false,
-		"INITIALLY":                      false,
-		"INITRANS":                       false,
-		"INLINE_XMLTYPE_NT":              false,
-		"INLINE_XT":                      false,
-		"INLINE":                         false,
-		"INMEMORY_PRUNING":               false,
-		"INMEMORY":                       false,
-		"INNER":                          true,
-		"INPLACE":                        false,
-		"INSERTCHILDXML":                 false,
-		"INSERTCHILDXMLAFTER":            false,
-		"INSERTCHILDXMLBEFORE":           false,
-		"INSERTXMLAFTER":                 false,
-		"INSERTXMLBEFORE":                false,
-		"INSTALL":                        false,
-		"INSTANCE":                       false,
-		"INSTANCES":                      false,
-		"INSTANTIABLE":                   false,
-		"INSTANTLY":                      false,
-		"INSTEAD":                        false,
-		"INSTR":                          false,
-		"INSTR2":                         false,
-		"INSTR4":                         false,
-		"INSTRB":                         false,
-		"INSTRC":                         false,
-		"INT":                            false,
-		"INTERLEAVED":                    false,
-		"INTERMEDIATE":                   false,
-		"INTERNAL_CONVERT":               false,
-		"INTERNAL_USE":                   false,
-		"INTERPRETED":                    false,
-		"INTERVAL":                       false,
-		"INTRA_CDB":                      false,
-		"INVALIDATE":                     false,
-		"INVALIDATION":                   false,
-		"INVISIBLE":                      false,
-		"IOSEEKTIM":                      false,
-		"IOTFRSPEED":                     false,
-		"ISOLATE":                        false,
-		"ISOLATION_LEVEL":                false,
-		"ISOLATION":                      false,
-		"ITERATE":                        false,
-		"ITERATION_NUMBER":               false,
-		"JAVA":                           false,
-		"JOB":                            false,
-		"JOIN":                           true,
-		"JSON_ARRAY":                     false,
-		"JSON_ARRAYAGG":                  false,
-		"JSON_EQUAL":                     false,
-		"JSON_EXISTS":                    false,
-		"JSON_EXISTS2":                   false,
-		"JSON_MERGEPATCH":                false,
-		"JSON_OBJECT":                    false,
-		"JSON_OBJECTAGG":                 false,
-		"JSON_PATCH":                     false,
-		"JSON_QUERY":                     false,
-		"JSON_TABLE":                     false,
-		"JSON_TEXTCONTAINS":              false,
-		"JSON_TEXTCONTAINS2":             false,
-		"JSON_TRANSFORM":                 false,
-		"JSON_VALUE":                     false,
-		"JSON":                           false,
-		"JSONGET":                        false,
-		"JSONPARSE":                      false,
-		"JSONTOXML":                      false,
-		"KEEP_DUPLICATES":                false,
-		"KEEP":                           false,
-		"KERBEROS":                       false,
-		"KEY_LENGTH":                     false,
-		"KEY":                            false,
-		"KEYS":                           false,
-		"KEYSIZE":                        false,
-		"KEYSTORE":                       false,
-		"KILL":                           false,
-		"LABEL":                          false,
-		"LAG_DIFF_PERCENT":               false,
-		"LAG_DIFF":                       false,
-		"LAG":                            false,
-		"LANGUAGE":                       false,
-		"LAST_DAY":                       false,
-		"LAST_VALUE":                     false,
-		"LAST":                           false,
-		"LATERAL":                        false,
-		"LAX":                            false,
-		"LAYER":                          false,
-		"LDAP_REG_SYNC_INTERVAL":         false,
-		"LDAP_REGISTRATION_ENABLED":      false,
-		"LDAP_REGISTRATION":              false,
-		"LEAD_CDB_URI":                   false,
-		"LEAD_CDB":                       false,
-		"LEAD_DIFF_PERCENT":              false,
-		"LEAD_DIFF":                      false,
-		"LEAD":                           false,
-		"LEADING":                        false,
-		"LEAF":                           false,
-		"LEAST":                          false,
-		"LEAVES":                         false,
-		"LEFT":                           false,
-		"LENGTH":                         false,
-		"LENGTH2":                        false,
-		"LENGTH4":                        false,
-		"LENGTHB":                        false,
-		"LENGTHC":                        false,
-		"LESS":                           false,
-		"LEVEL":                          false,
-		"LEVELS":                         false,
-		"LIBRARY":                        false,
-		"LIFE":                           false,
-		"LIFECYCLE":                      false,
-		"LIFETIME":                       false,
-		"LIKE2":                          false,
-		"LIKE4":                          false,
-		"LIKEC":                          false,
-		"LIMIT":                          false,
-		"LINEAR":                         false,
-		"LINK":                           false,
-		"LIST":                           false,
-		"LISTAGG":                        false,
-		"LN":                             false,
-		"LNNVL":                          false,
-		"LOAD":                           false,
-		"LOB":                            false,
-		"LOBNVL":                         false,
-		"LOBS":                           false,
-		"LOCAL_INDEXES":                  false,
-		"LOCAL":                          false,
-		"LOCALTIME":                      false,
-		"LOCALTIMESTAMP":                 false,
-		"LOCATION":                       false,
-		"LOCATOR":                        false,
-		"LOCKDOWN":                       false,
-		"LOCKED":                         false,
-		"LOCKING":                        false,
-		"LOG_READ_ONLY_VIOLATIONS":       false,
-		"LOG":                            false,
-		"LOGFILE":                        false,
-		"LOGFILES":                       false,
-		"LOGGING":                        false,
-		"LOGICAL_READS_PER_CALL":         false,
-		"LOGICAL_READS_PER_SESSION":      false,
-		"LOGICAL":                        false,
-		"LOGMINING":                      false,
-		"LOGOFF":                         false,
-		"LOGON":                          false,
-		"LOST":                           false,
-		"LOW":                            false,
-		"LOWER":                          false,
-		"LPAD":                           false,
-		"LTRIM":                          false,
-		"MAIN":                           false,
-		"MAKE_REF":                       false,
-		"MANAGE":                         false,
-		"MANAGED":                        false,
-		"MANAGEMENT":                     false,
-		"MANAGER":                        false,
-		"MANUAL":                         false,
-		"MAP":                            false,
-		"MAPPING":                        false,
-		"MASTER":                         false,
-		"MATCH_NUMBER":                   false,
-		"MATCH_RECOGNIZE":                false,
-		"MATCH":                          false,
-		"MATCHED":                        true,
-		"MATCHES":                        false,
-		"MATERIALIZE":                    false,
-		"MATERIALIZED":                   true,
-		"MATRIX":                         false,
-		"MAX_AUDIT_SIZE":                 false,
-		"MAX_DIAG_SIZE":                  false,
-		"MAX_PDB_SNAPSHOTS":              false,
-		"MAX_SHARED_TEMP_SIZE":           false,
-		"MAX":                            false,
-		"MAXARCHLOGS":                    false,
-		"MAXDATAFILES":                   false,
-		"MAXEXTENTS":                     false,
-		"MAXIMIZE":                       false,
-		"MAXINSTANCES":                   false,
-		"MAXLOGFILES":                    false,
-		"MAXLOGHISTORY":                  false,
-		"MAXLOGMEMBERS":                  false,
-		"MAXSIZE":                        false,
-		"MAXTRANS":                       false,
-		"MAXVALUE":                       false,
-		"MBRC":                           false,
-		"MEASURE":                        false,
-		"MEASURES":                       false,
-		"MEDIAN":                         false,
-		"MEDIUM":                         false,
-		"MEMBER":                         false,
-		"MEMCOMPRESS":                    false,
-		"MEMOPTIMIZE_WRITE":              false,
-		"MEMOPTIMIZE":                    false,
-		"MEMORY":                         false,
-		"MERGE_AJ":                       false,
-		"MERGE_CONST_ON":                 false,
-		"MERGE_SJ":                       false,
-		"MERGE":                          true,
-		"MERGE$ACTIONS":                  false,
-		"METADATA_SOURCE_PDB":            false,
-		"METADATA":                       false,
-		"METHOD":                         false,
-		"MIGRATE_CROSS_CON":              false,
-		"MIGRATE":                        false,
-		"MIGRATION":                      false,
-		"MIN":                            false,
-		"MINEXTENTS":                     false,
-		"MINIMIZE":                       false,
-		"MINIMUM":                        false,
-		"MINING":                         false,
-		"MINUS_NULL":                     false,
-		"MINUTE":                         false,
-		"MINUTES":                        false,
-		"MINVALUE":                       false,
-		"MIRROR":                         false,
-		"MIRRORCOLD":                     false,
-		"MIRRORHOT":                      false,
-		"MLSLABEL":                       false,
-		"MOD":                            false,
-		"MODEL_COMPILE_SUBQUERY":         false,
-		"MODEL_DONTVERIFY_UNIQUENESS":    false,
-		"MODEL_DYNAMIC_SUBQUERY":         false,
-		"MODEL_MIN_ANALYSIS":             false,
-		"MODEL_NB":                       false,
-		"MODEL_NO_ANALYSIS":              false,
-		"MODEL_PBY":                      false,
-		"MODEL_PUSH_REF":                 false,
-		"MODEL_SV":                       false,
-		"MODEL":                          false,
-		"MODIFICATION":                   false,
-		"MODIFY_COLUMN_TYPE":             false,
-		"MODIFY":                         true,
-		"MODULE":                         false,
-		"MONITOR":                        false,
-		"MONITORING":                     false,
-		"MONTH":                          false,
-		"MONTHS_BETWEEN":                 false,
-		"MONTHS":                         false,
-		"MOUNT":                          false,
-		"MOUNTPATH":                      false,
-		"MOVE":                           false,
-		"MOVEMENT":                       false,
-		"MULTIDIMENSIONAL":               false,
-		"MULTISET":                       false,
-		"MV_MERGE":                       false,
-		"NAME":                           false,
-		"NAMED":                          false,
-		"NAMESPACE":                      false,
-		"NAN":                            false,
-		"NANVL":                          false,
-		"NATIONAL":                       false,
-		"NATIVE_FULL_OUTER_JOIN":         false,
-		"NATIVE":                         false,
-		"NATURAL":                        true,
-		"NAV":                            false,
-		"NCHAR_CS":                       false,
-		"NCHAR":                          false,
-		"NCHR":                           false,
-		"NCLOB":                          false,
-		"NEEDED":                         false,
-		"NEG":                            false,
-		"NESTED_TABLE_FAST_INSERT":       false,
-		"NESTED_TABLE_GET_REFS":          false,
-		"NESTED_TABLE_ID":                false,
-		"NESTED_TABLE_SET_REFS":          false,
-		"NESTED_TABLE_SET_SETID":         false,
-		"NESTED":                         false,
-		"NETWORK":                        false,
-		"NEVER":                          false,
-		"NEW_TIME":                       false,
-		"NEW":                            false,
-		"NEXT_DAY":                       false,
-		"NEXT":                           true,
-		"NL_AJ":                          false,
-		"NL_SJ":                          false,
-		"NLJ_BATCHING":                   false,
-		"NLJ_INDEX_FILTER":               false,
-		"NLJ_INDEX_SCAN":                 false,
-		"NLJ_PREFETCH":                   false,
-		"NLS_CALENDAR":                   false,
-		"NLS_CHARACTERSET":               false,
-		"NLS_CHARSET_DECL_LEN":           false,
-		"NLS_CHARSET_ID":                 false,
-		"NLS_CHARSET_NAME":               false,
-		"NLS_COLLATION_ID":               false,
-		"NLS_COLLATION_NAME":             false,
-		"NLS_COMP":                       false,
-		"NLS_CURRENCY":                   false,
-		"NLS_DATE_FORMAT":                false,
-		"NLS_DATE_LANGUAGE":              false,
-		"NLS_INITCAP":                    false,
-		"NLS_ISO_CURRENCY":               false,
-		"NLS_LANG":                       false,
-		"NLS_LANGUAGE":                   false,
-		"NLS_LENGTH_SEMANTICS":           false,
-		"NLS_LOWER":                      false,
-		"NLS_NCHAR_CONV_EXCP":            false,
-		"NLS_NUMERIC_CHARACTERS":         false,
-		"NLS_SORT":                       false,
-		"NLS_SPECIAL_CHARS":              false,
-		"NLS_TERRITORY":                  false,
-		"NLS_UPPER":                      false,
-		"NLSSORT":                        false,
-		"NO_ACCESS":                      false,
-		"NO_ADAPTIVE_PLAN":               false,
-		"NO_ANSI_REARCH":                 false,
-		"NO_ANSWER_QUERY_USING_STATS":    false,
-		"NO_AUTO_REOPTIMIZE":             false,
-		"NO_BAND_JOIN":                   false,
-		"NO_BASETABLE_MULTIMV_REWRITE":   false,
-		"NO_BATCH_TABLE_ACCESS_BY_ROWID": false,
-		"NO_BIND_AWARE":                  false,
-		"NO_BUFFER":                      false,
-		"NO_BUSHY_JOIN":                  false,
-		"NO_CARTESIAN":                   false,
-		"NO_CHECK_ACL_REWRITE":           false,
-		"NO_CLUSTER_BY_ROWID":            false,
-		"NO_CLUSTERING":                  false,
-		"NO_COALESCE_SQ":                 false,
-		"NO_COMMON_DATA":                 false,
-		"NO_CONNECT_BY_CB_WHR_ONLY":      false,
-		"NO_CONNECT_BY_COMBINE_SW":       false,
-		"NO_CONNECT_BY_COST_BASED":       false,
-		"NO_CONNECT_BY_ELIM_DUPS":        false,
-		"NO_CONNECT_BY_FILTERING":        false,
-		"NO_CONTAINERS":                  false,
-		"NO_COST_XML_QUERY_REWRITE":      false,
-		"NO_CPU_COSTING":                 false,
-		"NO_CROSS_CONTAINER":             false,
-		"NO_DATA_SECURITY_REWRITE":       false,
-		"NO_DECORRELATE":                 false,
-		"NO_DIST_AGG_PROLLUP_PUSHDOWN":   false,
-		"NO_DOMAIN_INDEX_FILTER":         false,
-		"NO_DST_UPGRADE_INSERT_CONV":     false,
-		"NO_ELIM_GROUPBY":                false,
-		"NO_ELIMINATE_JOIN":              false,
-		"NO_ELIMINATE_OBY":               false,
-		"NO_ELIMINATE_OUTER_JOIN":        false,
-		"NO_ELIMINATE_SQ":                false,
-		"NO_EXPAND_GSET_TO_UNION":        false,
-		"NO_EXPAND_TABLE":                false,
-		"NO_EXPAND":                      false,
-		"NO_FACT":                        false,
-		"NO_FACTORIZE_JOIN":              false,
-		"NO_FILTERING":                   false,
-		"NO_FULL_OUTER_JOIN_TO_OUTER":    false,
-		"NO_GATHER_OPTIMIZER_STATISTICS": false,
-		"NO_GBY_PUSHDOWN":                false,
-		"NO_INDEX_FFS":                   false,
-		"NO_INDEX_SS":                    false,
-		"NO_INDEX":                       false,
-		"NO_INMEMORY_PRUNING":            false,
-		"NO_INMEMORY":                    false,
-		"NO_LOAD":                        false,
-		"NO_MERGE":                       false,
-		"NO_MODEL_PUSH_REF":              false,
-		"NO_MONITOR":                     false,
-		"NO_MONITORING":                  false,
-		"NO_MULTIMV_REWRITE":             false,
-		"NO_NATIVE_FULL_OUTER_JOIN":      false,
-		"NO_NLJ_BATCHING":                false,
-		"NO_NLJ_PREFETCH":                false,
-		"NO_OBJECT_LINK":                 false,
-		"NO_OR_EXPAND":                   false,
-		"NO_ORDER_ROLLUPS":               false,
-		"NO_OUTER_JOIN_TO_ANTI":          false,
-		"NO_OUTER_JOIN_TO_INNER":         false,
-		"NO_PARALLEL_INDEX":              false,
-		"NO_PARALLEL":                    false,
-		"NO_PARTIAL_COMMIT":              false,
-		"NO_PARTIAL_JOIN":                false,
-		"NO_PARTIAL_ROLLUP_PUSHDOWN":     false,
-		"NO_PLACE_DISTINCT":              false,
-		"NO_PLACE_GROUP_BY":              false,
-		"NO_PQ_CONCURRENT_UNION":         false,
-		"NO_PQ_MAP":                      false,
-		"NO_PQ_REPLICATE":                false,
-		"NO_PQ_SKEW":                     false,
-		"NO_PRUNE_GSETS":                 false,
-		"NO_PULL_PRED":                   false,
-		"NO_PUSH_HAVING_TO_GBY":          false,
-		"NO_PUSH_PRED":                   false,
-		"NO_PUSH_SUBQ":                   false,
-		"NO_PX_FAULT_TOLERANCE":          false,
-		"NO_PX_JOIN_FILTER":              false,
-		"NO_QKN_BUFF":                    false,
-		"NO_QUERY_TRANSFORMATION":        false,
-		"NO_REF_CASCADE":                 false,
-		"NO_REORDER_WIF":                 false,
-		"NO_RESULT_CACHE":                false,
-		"NO_REWRITE":                     false,
-		"NO_ROOT_SW_FOR_LOCAL":           false,
-		"NO_SEMI_TO_INNER":               false,
-		"NO_SEMIJOIN":                    false,
-		"NO_SET_TO_JOIN":                 false,
-		"NO_SQL_TRANSLATION":             false,
-		"NO_SQL_TUNE":                    false,
-		"NO_STAR_TRANSFORMATION":         false,
-		"NO_STATEMENT_QUEUING":           false,
-		"NO_STATS_GSETS":                 false,
-		"NO_SUBQUERY_PRUNING":            false,
-		"NO_SUBSTRB_PAD":                 false,
-		"NO_SWAP_JOIN_INPUTS":            false,
-		"NO_TABLE_LOOKUP_BY_NL":          false,
-		"NO_TEMP_TABLE":                  false,
-		"NO_TRANSFORM_DISTINCT_AGG":      false,
-		"NO_UNNEST":                      false,
-		"NO_USE_CUBE":                    false,
-		"NO_USE_DAGG_UNION_ALL_GSETS":    false,
-		"NO_USE_HASH_AGGREGATION":        false,
-		"NO_USE_HASH_GBY_FOR_DAGGPSHD":   false,
-		"NO_USE_HASH_GBY_FOR_PUSHDOWN":   false,
-		"NO_USE_HASH":                    false,
-		"NO_USE_INVISIBLE_INDEXES":       false,
-		"NO_USE_MERGE":                   false,
-		"NO_USE_NL":                      false,
-		"NO_USE_PARTITION_WISE_DISTINCT": false,
-		"NO_USE_PARTITION_WISE_GBY":      false,
-		"NO_USE_PARTITION_WISE_WIF":      false,
-		"NO_USE_VECTOR_AGGREGATION":      false,
-		"NO_VECTOR_TRANSFORM_DIMS":       false,
-		"NO_VECTOR_TRANSFORM_FACT":       false,
-		"NO_VECTOR_TRANSFORM":            false,
-		"NO_XDB_FASTPATH_INSERT":         false,
-		"NO_XML_DML_REWRITE":             false,
-		"NO_XML_QUERY_REWRITE":           false,
-		"NO_XMLINDEX_REWRITE_IN_SELECT":  false,
-		"NO_XMLINDEX_REWRITE":            false,
-		"NO_ZONEMAP":                     false,
-		"NO":                             false,
-		"NOAPPEND":                       false,
-		"NOARCHIVELOG":                   false,
-		"NOAUDIT":                        false,
-		"NOCACHE":                        false,
-		"NOCOPY":                         false,
-		"NOCPU_COSTING":                  false,
-		"NOCYCLE":                        false,
-		"NODELAY":                        false,
-		"NOENTITYESCAPING":               false,
-		"NOEXTEND":                       false,
-		"NOFORCE":                        false,
-		"NOGUARANTEE":                    false,
-		"NOKEEP":                         false,
-		"NOLOCAL":                        false,
-		"NOLOGGING":                      false,
-		"NOMAPPING":                      false,
-		"NOMAXVALUE":                     false,
-		"NOMINIMIZE":                     false,
-		"NOMINVALUE":                     false,
-		"NOMONITORING":                   false,
-		"NONBLOCKING":                    false,
-		"NONE":                           false,
-		"NONEDITIONABLE":                 false,
-		"NONPARTITIONED":                 false,
-		"NONSCHEMA":                      false,
-		"NOORDER":                        false,
-		"NOOVERRIDE":                     false,
-		"NOPARALLEL_INDEX":               false,
-		"NOPARALLEL":                     false,
-		"NORELOCATE":                     false,
-		"NORELY":                         false,
-		"NOREPAIR":                       false,
-		"NOREPLAY":                       false,
-		"NORESETLOGS":                    false,
-		"NOREVERSE":                      false,
-		"NOREWRITE":                      false,
-		"NORMAL":                         false,
-		"NOROWDEPENDENCIES":              false,
-		"NOSCALE":                        false,
-		"NOSCHEMACHECK":                  false,
-		"NOSEGMENT":                      false,
-		"NOSORT":                         false,
-		"NOSTRICT":                       false,
-		"NOSWITCH":                       false,
-		"NOTHING":                        false,
-		"NOTIFICATION":                   false,
-		"NOVALIDATE":                     false,
-		"NOW":                            false,
-		"NTH_VALUE":                      false,
-		"NTILE":                          false,
-		"NULLIF":                         false,
-		"NULLS":                          false,
-		"NUM_INDEX_KEYS":                 false,
-		"NUMERIC":                        false,
-		"NUMTODSINTERVAL":                false,
-		"NUMTOYMINTERVAL":                false,
-		"NVARCHAR2":                      false,
-		"NVL":                            false,
-		"NVL2":                           false,
-		"OBJ_ID":                         false,
-		"OBJECT":                         false,
-		"OBJECT2XML":                     false,
-		"OBJNO_REUSE":                    false,
-		"OBJNO":                          false,
-		"OCCURENCES":                     false,
-		"OCCURRENCES":                    false,
-		"OFF":                            false,
-		"OFFLINE":                        false,
-		"OFFSET":                         false,
-		"OID":                            false,
-		"OIDINDEX":                       false,
-		"OLAP":                           false,
-		"OLD_PUSH_PRED":                  false,
-		"OLD":                            false,
-		"OLS":                            false,
-		"OLTP":                           false,
-		"OMIT":                           false,
-		"ONE":                            false,
-		"ONLINE":                         false,
-		"ONLY":                           false,
-		"OPAQUE_TRANSFORM":               false,
-		"OPAQUE_XCANONICAL":              false,
-		"OPAQUE":                         false,
-		"OPCODE":                         false,
-		"OPEN":                           false,
-		"OPERATIONS":                     false,
-		"OPERATOR":                       false,
-		"OPT_ESTIMATE":                   false,
-		"OPT_PARAM":                      false,
-		"OPTIMAL":                        false,
-		"OPTIMIZE":                       false,
-		"OPTIMIZER_FEATURES_ENABLE":      false,
-		"OPTIMIZER_GOAL":                 false,
-		"OR_EXPAND":                      false,
-		"OR_PREDICATES":                  false,
-		"ORA_BRANCH":                     false,
-		"ORA_CHECK_ACL":                  false,
-		"ORA_CHECK_PRIVILEGE":            false,
-		"ORA_CHECK_SYS_PRIVILEGE":        false,
-		"ORA_CLUSTERING":                 false,
-		"ORA_CONCAT_RWKEY":               false,
-		"ORA_DM_PARTITION_NAME":          false,
-		"ORA_DST_AFFECTED":               false,
-		"ORA_DST_CONVERT":                false,
-		"ORA_DST_ERROR":                  false,
-		"ORA_GET_ACLIDS":                 false,
-		"ORA_GET_PRIVILEGES":             false,
-		"ORA_HASH":                       false,
-		"ORA_INVOKING_USER":              false,
-		"ORA_INVOKING_USERID":            false,
-		"ORA_INVOKING_XS_USER_GUID":      false,
-		"ORA_INVOKING_XS_USER":           false,
-		"ORA_PARTITION_VALIDATION":       false,
-		"ORA_RAWCOMPARE":                 false,
-		"ORA_RAWCONCAT":                  false,
-		"ORA_ROWSCN_RAW":                 false,
-		"ORA_ROWSCN":                     false,
-		"ORA_ROWVERSION":                 false,
-		"ORA_SEARCH_RWKEY":               false,
-		"ORA_SHARD_ID":                   false,
-		"ORA_TABVERSION":                 false,
-		"ORA_WRITE_TIME":                 false,
-		"ORADEBUG":                       false,
-		"ORDER_SUBQ":                     false,
-		"ORDERED_PREDICATES":             false,
-		"ORDERED":                        false,
-		"ORDINALITY":                     false,
-		"ORGANIZATION":                   false,
-		"OSON":                           false,
-		"OTHER":                          false,
-		"OUT_OF_LINE":                    false,
-		"OUTER_JOIN_TO_ANTI":             false,
-		"OUTER_JOIN_TO_INNER":            false,
-		"OUTER":                          false,
-		"OUTLINE_LEAF":                   false,
-		"OUTLINE":                        false,
-		"OVER":                           false,
-		"OVERFLOW_NOMOVE":                false,
-		"OVERFLOW":                       false,
-		"OVERLAPS":                       false,
-		"OWN":                            false,
-		"OWNER":                          false,
-		"OWNERSHIP":                      false,
-		"PACKAGE":                        false,
-		"PACKAGES":                       false,
-		"PARALLEL_INDEX":                 false,
-		"PARALLEL":                       false,
-		"PARAM":                          false,
-		"PARAMETERS":                     false,
-		"PARENT":                         false,
-		"PARITY":                         false,
-		"PART$NUM$INST":                  false,
-		"PARTIAL_JOIN":                   false,
-		"PARTIAL_ROLLUP_PUSHDOWN":        false,
-		"PARTIAL":                        false,
-		"PARTIALLY":                      false,
-		"PARTITION_CONTAINED":            false,
-		"PARTITION_HASH":                 false,
-		"PARTITION_LIST":                 false,
-		"PARTITION_RANGE":                false,
-		"PARTITION":                      false,
-		"PARTITIONING":                   false,
-		"PARTITIONS":                     false,
-		"PARTITIONSET":                   false,
-		"PASSING":                        false,
-		"PASSWORD_GRACE_TIME":            false,
-		"PASSWORD_LIFE_TIME":             false,
-		"PASSWORD_LOCK_TIME":             false,
-		"PASSWORD_REUSE_MAX":             false,
-		"PASSWORD_REUSE_TIME":            false,
-		"PASSWORD_VERIFY_FUNCTION":       false,
-		"PASSWORD":                       false,
-		"PAST":                           false,
-		"PATCH":                          false,
-		"PATH_PREFIX":                    false,
-		"PATH":                           false,
-		"PATHS":                          false,
-		"PATTERN":                        false,
-		"PBL_HS_BEGIN":                   false,
-		"PBL_HS_END":                     false,
-		"PCTINCREASE":                    false,
-		"PCTTHRESHOLD":                   false,
-		"PCTUSED":                        false,
-		"PCTVERSION":                     false,
-		"PDB_LOCAL_ONLY":                 false,
-		"PENDING":                        false,
-		"PER":                            false,
-		"PERCENT_RANK":                   false,
-		"PERCENT_RANKM":                  false,
-		"PERCENT":                        false,
-		"PERCENTILE_CONT":                false,
-		"PERCENTILE_DISC":                false,
-		"PERFORMANCE":                    false,
-		"PERIOD":                         false,
-		"PERMANENT":                      false,
-		"PERMISSION":                     false,
-		"PERMUTE":                        false,
-		"PERSISTABLE":                    false,
-		"PFILE":                          false,
-		"PHYSICAL":                       false,
-		"PIKEY":                          false,
-		"PIV_GB":                         false,
-		"PIV_SSF":                        false,
-		"PIVOT":                          true,
-		"PLACE_DISTINCT":                 false,
-		"PLACE_GROUP_BY":                 false,
-		"PLAN":                           false,
-		"PLSCOPE_SETTINGS":               false,
-		"PLSQL_CCFLAGS":                  false,
-		"PLSQL_CODE_TYPE":                false,
-		"PLSQL_DEBUG":                    false,
-		"PLSQL_OPTIMIZE_LEVEL":           false,
-		"PLSQL_WARNINGS":                 false,
-		"PLUGGABLE":                      false,
-		"POINT":                          false,
-		"POLICY":                         false,
-		"POOL_16K":                       false,
-		"POOL_2K":                        false,
-		"POOL_32K":                       false,
-		"POOL_4K":                        false,
-		"POOL_8K":                        false,
-		"PORT":                           false,
-		"POSITION":                       false,
-		"POST_TRANSACTION":               false,
-		"POWER":                          false,
-		"POWERMULTISET_BY_CARDINALITY":   false,
-		"POWERMULTISET":                  false,
-		"PQ_CONCURRENT_UNION":            false,
-		"PQ_DISTRIBUTE_WINDOW":           false,
-		"PQ_DISTRIBUTE":                  false,
-		"PQ_FILTER":                      false,
-		"PQ_MAP":                         false,
-		"PQ_NOMAP":                       false,
-		"PQ_REPLICATE":                   false,
-		"PQ_SKEW":                        false,
-		"PREBUILT":                       false,
-		"PRECEDES":                       false,
-		"PRECEDING":                      false,
-		"PRECISION":                      false,
-		"PRECOMPUTE_SUBQUERY":            false,
-		"PREDICATE_REORDERS":             false,
-		"PREDICTION_BOUNDS":              false,
-		"PREDICTION_COST":                false,
-		"PREDICTION_DETAILS":             false,
-		"PREDICTION_PROBABILITY":         false,
-		"PREDICTION_SET":                 false,
-		"PREDICTION":                     false,
-		"PRELOAD":                        false,
-		"PREPARE":                        false,
-		"PRESENT":                        false,
-		"PRESENTNNV":                     false,
-		"PRESENTV":                       false,
-		"PRESERVE_OID":                   false,
-		"PRESERVE":                       false,
-		"PRETTY":                         false,
-		"PREV":                           false,
-		"PREVIOUS":                       false,
-		"PRIMARY":                        false,
-		"PRINTBLOBTOCLOB":                false,
-		"PRIORITY":                       false,
-		"PRIVATE_SGA":                    false,
-		"PRIVATE":                        false,
-		"PRIVILEGE":                      false,
-		"PRIVILEGED":                     false,
-		"PRIVILEGES":                     false,
-		"PROCEDURAL":                     false,
-		"PROCEDURE":                      false,
-		"PROCESS":                        false,
-		"PROFILE":                        false,
-		"PROGRAM":                        false,
-		"PROJECT":                        false,
-		"PROPAGATE":                      false,
-		"PROPERTY":                       false,
-		"PROTECTED":                      false,
-		"PROTECTION":                     false,
-		"PROXY":                          false,
-		"PRUNING":                        false,
-		"PULL_PRED":                      false,
-		"PURGE":                          false,
-		"PUSH_HAVING_TO_GBY":             false,
-		"PUSH_PRED":                      false,
-		"PUSH_SUBQ":                      false,
-		"PX_FAULT_TOLERANCE":             false,
-		"PX_GRANULE":                     false,
-		"PX_JOIN_FILTER":                 false,
-		"QB_NAME":                        false,
-		"QUALIFY":                        false,
-		"QUARTERS":                       false,
-		"QUERY_BLOCK":                    false,
-		"QUERY":                          false,
-		"QUEUE_CURR":                     false,
-		"QUEUE_ROWP":                     false,
-		"QUEUE":                          false,
-		"QUIESCE":                        false,
-		"QUORUM":                         false,
-		"QUOTA":                          false,
-		"QUOTAGROUP":                     false,
-		"RANDOM_LOCAL":                   false,
-		"RANDOM":                         false,
-		"RANGE":                          false,
-		"RANK":                           false,
-		"RANKM":                          false,
-		"RAPIDLY":                        false,
-		"RATIO_TO_REPORT":                false,
-		"RAWTOHEX":                       false,
-		"RAWTONHEX":                      false,
-		"RAWTOREF":                       false,
-		"RBA":                            false,
-		"RBO_OUTLINE":                    false,
-		"RDBA":                           false,
-		"READ_OR_WRITE":                  false,
-		"READ":                           false,
-		"READS":                          false,
-		"REAL":                           false,
-		"REALM":                          false,
-		"REBALANCE":                      false,
-		"REBUILD":                        false,
-		"RECONNECT":                      false,
-		"RECORDS_PER_BLOCK":              false,
-		"RECOVER":                        false,
-		"RECOVERABLE":                    false,
-		"RECOVERY":                       false,
-		"RECYCLE":                        false,
-		"RECYCLEBIN":                     false,
-		"REDACTION":                      false,
-		"REDEFINE":                       false,
-		"REDO":                           false,
-		"REDUCED":                        false,
-		"REDUNDANCY":                     false,
-		"REF_CASCADE_CURSOR":             false,
-		"REF":                            false,
-		"REFERENCE":                      false,
-		"REFERENCED":                     false,
-		"REFERENCES":                     false,
-		"REFERENCING":                    false,
-		"REFRESH":                        false,
-		"REFTOHEX":                       false,
-		"REFTORAW":                       false,
-		"REGEXP_COUNT":                   false,
-		"REGEXP_INSTR":                   false,
-		"REGEXP_LIKE":                    false,
-		"REGEXP_REPLACE":                 false,
-		"REGEXP_SUBSTR":                  false,
-		"REGISTER":                       false,
-		"REGR_AVGX":                      false,
-		"REGR_AVGY":                      false,
-		"REGR_COUNT":                     false,
-		"REGR_INTERCEPT":                 false,
-		"REGR_R2":                        false,
-		"REGR_SLOPE":                     false,
-		"REGR_SXX":                       false,
-		"REGR_SXY":                       false,
-		"REGR_SYY":                       false,
-		"REGULAR":                        false,
-		"REJECT":                         false,
-		"REKEY":                          false,
-		"RELATIONAL":                     false,
-		"RELOCATE":                       false,
-		"RELY":                           false,
-		"REMAINDER":                      false,
-		"REMOTE_MAPPED":                  false,
-		"REMOTE":                         false,
-		"REMOVE":                         false,
-		"REORDER_WIF":                    false,
-		"REPAIR":                         false,
-		"REPEAT":                         false,
-		"REPLACE":                        false,
-		"REPLICATION":                    false,
-		"REQUIRED":                       false,
-		"RESERVOIR_SAMPLING":             false,
-		"RESET":                          false,
-		"RESETLOGS":                      false,
-		"RESIZE":                         false,
-		"RESOLVE":                        false,
-		"RESOLVER":                       false,
-		"RESPECT":                        false,
-		"RESTART":                        false,
-		"RESTORE_AS_INTERVALS":           false,
-		"RESTORE":                        false,
-		"RESTRICT_ALL_REF_CONS":          false,
-		"RESTRICT":                       false,
-		"RESTRICTED":                     false,
-		"RESULT_CACHE":                   false,
-		"RESUMABLE":                      false,
-		"RESUME":                         false,
-		"RETENTION":                      false,
-		"RETRY_ON_ROW_CHANGE":            false,
-		"RETURN":                         true,
-		"RETURNING":                      true,
-		"REUSE":                          false,
-		"REVERSE":                        false,
-		"REWRITE_OR_ERROR":               false,
-		"REWRITE":                        false,
-		"RIGHT":                          false,
-		"RIM":                            false,
-		"ROLE":                           true,
-		"ROLES":                          false,
-		"ROLESET":                        false,
-		"ROLLBACK":                       false,
-		"ROLLING":                        false,
-		"ROLLUP":                         false,
-		"ROOT":                           false,
-		"ROUND_TIES_TO_EVEN":             false,
-		"ROUND":                          false,
-		"ROW_LENGTH":                     false,
-		"ROW_NUMBER":                     false,
-		"ROW":                            false,
-		"ROWDEPENDENCIES":                false,
-		"ROWID_MAPPING_TABLE":            false,
-		"ROWID":                          false,
-		"ROWIDTOCHAR":                    false,
-		"ROWIDTONCHAR":                   false,
-		"ROWNUM":                         false,
-		"ROWS":                           false,
-		"RPAD":                           false,
-		"RTRIM":                          false,
-		"RULE":                           false,
-		"RULES":                          false,
-		"RUNNING":                        false,
-		"SALT":                           false,
-		"SAMPLE":                         false,
-		"SAVE_AS_INTERVALS":              false,
-		"SAVE":                           false,
-		"SAVEPOINT":                      false,
-		"SB4":                            false,
-		"SCALE_ROWS":                     false,
-		"SCALE":                          false,
-		"SCAN_INSTANCES":                 false,
-		"SCAN":                           false,
-		"SCHEDULER":                      false,
-		"SCHEMA":                         false,
-		"SCHEMACHECK":                    false,
-		"SCN_ASCENDING":                  false,
-		"SCN":                            false,
-		"SCOPE":                          false,
-		"SCRUB":                          false,
-		"SD_ALL":                         false,
-		"SD_INHIBIT":                     false,
-		"SD_SHOW":                        false,
-		"SDO_GEOM_KEY":                   false,
-		"SDO_GEOM_MBB":                   false,
-		"SDO_GEOM_MBR":                   false,
-		"SEARCH":                         false,
-		"SECOND":                         false,
-		"SECONDS":                        false,
-		"SECRET":                         false,
-		"SECUREFILE_DBA":                 false,
-		"SECUREFILE":                     false,
-		"SECURITY":                       false,
-		"SEED":                           false,
-		"SEG_BLOCK":                      false,
-		"SEG_FILE":                       false,
-		"SEGMENT":                        false,
-		"SELECTIVITY":                    false,
-		"SELF":                           false,
-		"SEMI_TO_INNER":                  false,
-		"SEMIJOIN_DRIVER":                false,
-		"SEMIJOIN":                       false,
-		"SEQUENCE":                       false,
-		"SEQUENCED":                      false,
-		"SEQUENTIAL":                     false,
-		"SERIAL":                         false,
-		"SERIALIZABLE":                   false,
-		"SERVERERROR":                    false,
-		"SERVICE_NAME_CONVERT":           false,
-		"SERVICE":                        false,
-		"SERVICES":                       false,
-		"SESSION_CACHED_CURSORS":         false,
-		"SESSION":                        false,
-		"SESSIONS_PER_USER":              false,
-		"SESSIONTIMEZONE":                false,
-		"SESSIONTZNAME":                  false,
-		"SET_TO_JOIN":                    false,
-		"SETS":                           false,
-		"SETTINGS":                       false,
-		"SEVERE":                         false,
-		"SHARD":                          false,
-		"SHARDED":                        false,
-		"SHARDS":                         false,
-		"SHARDSPACE":                     false,
-		"SHARE_OF":                       false,
-		"SHARED_POOL":                    false,
-		"SHARED":                         false,
-		"SHARING":                        false,
-		"SHELFLIFE":                      false,
-		"SHOW":                           false,
-		"SHRINK":                         false,
-		"SHUTDOWN":                       false,
-		"SIBLING":                        false,
-		"SIBLINGS":                       false,
-		"SID":                            false,
-		"SIGN":                           false,
-		"SIGNAL_COMPONENT":               false,
-		"SIGNAL_FUNCTION":                false,
-		"SIMPLE":                         false,
-		"SIN":                            false,
-		"SINGLE":                         false,
-		"SINGLETASK":                     false,
-		"SINH":                           false,
-		"SITE":                           false,
-		"SKIP_EXT_OPTIMIZER":             false,
-		"SKIP_PROXY":                     false,
-		"SKIP_UNQ_UNUSABLE_IDX":          false,
-		"SKIP_UNUSABLE_INDEXES":          false,
-		"SKIP":                           false,
-		"SMALLFILE":                      false,
-		"SNAPSHOT":                       false,
-		"SOME":                           false,
-		"SORT":                           false,
-		"SOUNDEX":                        false,
-		"SOURCE_FILE_DIRECTORY":          false,
-		"SOURCE_FILE_NAME_CONVERT":       false,
-		"SOURCE":                         false,
-		"SPACE":                          false,
-		"SPECIFICATION":                  false,
-		"SPFILE":                         false,
-		"SPLIT":                          false,
-		"SPREADSHEET":                    false,
-		"SQL_SCOPE":                      false,
-		"SQL_TRACE":                      false,
-		"SQL_TRANSLATION_PROFILE":        false,
-		"SQL":                            false,
-		"SQLLDR":                         false,
-		"SQRT":                           false,
-		"STALE":                          false,
-		"STANDALONE":                     false,
-		"STANDARD_HASH":                  false,
-		"STANDBY_MAX_DATA_DELAY":         false,
-		"STANDBY":                        false,
-		"STANDBYS":                       false,
-		"STAR_TRANSFORMATION":            false,
-		"STAR":                           false,
-		"STARTUP":                        false,
-		"STATE":                          false,
-		"STATEMENT_ID":                   false,
-		"STATEMENT_QUEUING":              false,
-		"STATEMENT":                      false,
-		"STATEMENTS":                     false,
-		"STATIC":                         false,
-		"STATISTICS":                     false,
-		"STATS_BINOMIAL_TEST":            false,
-		"STATS_CROSSTAB":                 false,
-		"STATS_F_TEST":                   false,
-		"STATS_KS_TEST":                  false,
-		"STATS_MODE":                     false,
-		"STATS_MW_TEST":                  false,
-		"STATS_ONE_WAY_ANOVA":            false,
-		"STATS_T_TEST_INDEP":             false,
-		"STATS_T_TEST_INDEPU":            false,
-		"STATS_T_TEST_ONE":               false,
-		"STATS_T_TEST_PAIRED":            false,
-		"STATS_WSR_TEST":                 false,
-		"STDDEV_POP":                     false,
-		"STDDEV_SAMP":                    false,
-		"STDDEV":                         false,
-		"STOP":                           false,
-		"STORAGE_INDEX":                  false,
-		"STORAGE":                        false,
-		"STORE":                          false,
-		"STREAM":                         false,
-		"STREAMS":                        false,
-		"STRICT":                         false,
-		"STRING":                         false,
-		"STRIP":                          false,
-		"STRIPE_COLUMNS":                 false,
-		"STRIPE_WIDTH":                   false,
-		"STRUCTURE":                      false,
-		"SUBMULTISET":                    false,
-		"SUBPARTITION_REL":               false,
-		"SUBPARTITION":                   false,
-		"SUBPARTITIONING":                false,
-		"SUBPARTITIONS":                  false,
-		"SUBQUERIES":                     false,
-		"SUBQUERY_PRUNING":               false,
-		"SUBSCRIBE":                      false,
-		"SUBSET":                         false,
-		"SUBSTITUTABLE":                  false,
-		"SUBSTR":                         false,
-		"SUBSTR2":                        false,
-		"SUBSTR4":                        false,
-		"SUBSTRB":                        false,
-		"SUBSTRC":                        false,
-		"SUCCESS":                        false,
-		"SUCCESSFUL":                     false,
-		"SUM":                            false,
-		"SUMMARY":                        false,
-		"SUPPLEMENTAL":                   false,
-		"SUPPRESS_LOAD":                  false,
-		"SUSPEND":                        false,
-		"SWAP_JOIN_INPUTS":               false,
-		"SWITCH":                         false,
-		"SWITCHOVER":                     false,
-		"SYNC":                           false,
-		"SYNCHRONOUS":                    false,
-		"SYS_AUDIT":                      false,
-		"SYS_CHECK_PRIVILEGE":            false,
-		"SYS_CHECKACL":                   false,
-		"SYS_CONNECT_BY_PATH":            false,
-		"SYS_CONTEXT":                    false,
-		"SYS_CTX_CONTAINS2":              false,
-		"SYS_CTX_MKIVIDX":                false,
-		"SYS_CTXINFOPK":                  false,
-		"SYS_DBURIGEN":                   false,
-		"SYS_DL_CURSOR":                  false,
-		"SYS_DM_RXFORM_CHR":              false,
-		"SYS_DM_RXFORM_LAB":              false,
-		"SYS_DM_RXFORM_NUM":              false,
-		"SYS_DOM_COMPARE":                false,
-		"SYS_DST_PRIM2SEC":               false,
-		"SYS_DST_SEC2PRIM":               false,
-		"SYS_ET_BFILE_TO_RAW":            false,
-		"SYS_ET_BLOB_TO_IMAGE":           false,
-		"SYS_ET_IMAGE_TO_BLOB":           false,
-		"SYS_ET_RAW_TO_BFILE":            false,
-		"SYS_EXTPDTXT":                   false,
-		"SYS_EXTRACT_UTC":                false,
-		"SYS_FBT_INSDEL":                 false,
-		"SYS_FILTER_ACLS":                false,
-		"SYS_FNMATCHES":                  false,
-		"SYS_FNREPLACE":                  false,
-		"SYS_GET_ACLIDS":                 false,
-		"SYS_GET_COL_ACLIDS":             false,
-		"SYS_GET_PRIVILEGES":             false,
-		"SYS_GETTOKENID":                 false,
-		"SYS_GETXTIVAL":                  false,
-		"SYS_GUID":                       false,
-		"SYS_MAKE_XMLNODEID":             false,
-		"SYS_MAKEXML":                    false,
-		"SYS_MKXMLATTR":                  false,
-		"SYS_MKXTI":                      false,
-		"SYS_OP_ADT2BIN":                 false,
-		"SYS_OP_ADTCONS":                 false,
-		"SYS_OP_ALSCRVAL":                false,
-		"SYS_OP_ATG":                     false,
-		"SYS_OP_BIN2ADT":                 false,
-		"SYS_OP_BITVEC":                  false,
-		"SYS_OP_BL2R":                    false,
-		"SYS_OP_BLOOM_FILTER_LIST":       false,
-		"SYS_OP_BLOOM_FILTER":            false,
-		"SYS_OP_C2C":                     false,
-		"SYS_OP_CAST":                    false,
-		"SYS_OP_CEG":                     false,
-		"SYS_OP_CL2C":                    false,
-		"SYS_OP_COMBINED_HASH":           false,
-		"SYS_OP_COMP":                    false,
-		"SYS_OP_CONVERT":                 false,
-		"SYS_OP_COUNTCHG":                false,
-		"SYS_OP_CSCONV":                  false,
-		"SYS_OP_CSCONVTEST":              false,
-		"SYS_OP_CSR":                     false,
-		"SYS_OP_CSX_PATCH":               false,
-		"SYS_OP_CYCLED_SEQ":              false,
-		"SYS_OP_DECOMP":                  false,
-		"SYS_OP_DESCEND":                 false,
-		"SYS_OP_DISTINCT":                false,
-		"SYS_OP_DRA":                     false,
-		"SYS_OP_DUMP":                    false,
-		"SYS_OP_DV_CHECK":                false,
-		"SYS_OP_ENFORCE_NOT_NULL$":       false,
-		"SYS_OP_EXTRACT":                 false,
-		"SYS_OP_GROUPING":                false,
-		"SYS_OP_GUID":                    false,
-		"SYS_OP_HASH":                    false,
-		"SYS_OP_HCS_TABLE":               false,
-		"SYS_OP_IIX":                     false,
-		"SYS_OP_ITR":                     false,
-		"SYS_OP_KEY_VECTOR_CREATE":       false,
-		"SYS_OP_KEY_VECTOR_FILTER_LIST":  false,
-		"SYS_OP_KEY_VECTOR_FILTER":       false,
-		"SYS_OP_KEY_VECTOR_PAYLOAD":      false,
-		"SYS_OP_KEY_VECTOR_SUCCEEDED":    false,
-		"SYS_OP_KEY_VECTOR_USE":          false,
-		"SYS_OP_LBID":                    false,
-		"SYS_OP_LOBLOC2BLOB":             false,
-		"SYS_OP_LOBLOC2CLOB":             false,
-		"SYS_OP_LOBLOC2ID":               false,
-		"SYS_OP_LOBLOC2NCLOB":            false,
-		"SYS_OP_LOBLOC2TYP":              false,
-		"SYS_OP_LSVI":                    false,
-		"SYS_OP_LVL":                     false,
-		"SYS_OP_MAKEOID":                 false,
-		"SYS_OP_MAP_NONNULL":             false,
-		"SYS_OP_MSR":                     false,
-		"SYS_OP_NICOMBINE":               false,
-		"SYS_OP_NIEXTRACT":               false,
-		"SYS_OP_NII":                     false,
-		"SYS_OP_NIX":                     false,
-		"SYS_OP_NOEXPAND":                false,
-		"SYS_OP_NTCIMG$":                 false,
-		"SYS_OP_NUMTORAW":                false,
-		"SYS_OP_OIDVALUE":                false,
-		"SYS_OP_OPNSIZE":                 false,
-		"SYS_OP_PAR_1":                   false,
-		"SYS_OP_PAR":                     false,
-		"SYS_OP_PARGID_1":                false,
-		"SYS_OP_PARGID":                  false,
-		"SYS_OP_PART_ID":                 false,
-		"SYS_OP_PIVOT":                   false,
-		"SYS_OP_R2O":                     false,
-		"SYS_OP_RAWTONUM":                false,
-		"SYS_OP_RDTM":                    false,
-		"SYS_OP_REF":                     false,
-		"SYS_OP_RMTD":                    false,
-		"SYS_OP_ROWIDTOOBJ":              false,
-		"SYS_OP_RPB":                     false,
-		"SYS_OP_TOSETID":                 false,
-		"SYS_OP_TPR":                     false,
-		"SYS_OP_TRTB":                    false,
-		"SYS_OP_UNDESCEND":               false,
-		"SYS_OP_VECAND":                  false,
-		"SYS_OP_VECBIT":                  false,
-		"SYS_OP_VECOR":                   false,
-		"SYS_OP_VECTOR_GROUP_BY":         false,
-		"SYS_OP_VECXOR":                  false,
-		"SYS_OP_VERSION":                 false,
-		"SYS_OP_VREF":                    false,
-		"SYS_OP_VVD":                     false,
-		"SYS_OP_XMLCONS_FOR_CSX":         false,
-		"SYS_OP_XPTHATG":                 false,
-		"SYS_OP_XPTHIDX":                 false,
-		"SYS_OP_XPTHOP":                  false,
-		"SYS_OP_XTNN":                    false,
-		"SYS_OP_XTXT2SQLT":               false,
-		"SYS_OP_ZONE_ID":                 false,
-		"SYS_OPTLOBPRBSC":                false,
-		"SYS_OPTXICMP":                   false,
-		"SYS_OPTXQCASTASNQ":              false,
-		"SYS_ORDERKEY_DEPTH":             false,
-		"SYS_ORDERKEY_MAXCHILD":          false,
-		"SYS_ORDERKEY_PARENT":            false,
-		"SYS_PARALLEL_TXN":               false,
-		"SYS_PATH_REVERSE":               false,
-		"SYS_PATHID_IS_ATTR":             false,
-		"SYS_PATHID_IS_NMSPC":            false,
-		"SYS_PATHID_LASTNAME":            false,
-		"SYS_PATHID_LASTNMSPC":           false,
-		"SYS_PLSQL_COUNT":                false,
-		"SYS_PLSQL_CPU":                  false,
-		"SYS_PLSQL_IO":                   false,
-		"SYS_PXQEXTRACT":                 false,
-		"SYS_RAW_TO_XSID":                false,
-		"SYS_REMAP_XMLTYPE":              false,
-		"SYS_RID_ORDER":                  false,
-		"SYS_ROW_DELTA":                  false,
-		"SYS_SC_2_XMLT":                  false,
-		"SYS_SYNRCIREDO":                 false,
-		"SYS_TYPEID":                     false,
-		"SYS_UMAKEXML":                   false,
-		"SYS_XMLANALYZE":                 false,
-		"SYS_XMLCONTAINS":                false,
-		"SYS_XMLCONV":                    false,
-		"SYS_XMLEXNSURI":                 false,
-		"SYS_XMLGEN":                     false,
-		"SYS_XMLI_LOC_ISNODE":            false,
-		"SYS_XMLI_LOC_ISTEXT":            false,
-		"SYS_XMLINSTR":                   false,
-		"SYS_XMLLOCATOR_GETSVAL":         false,
-		"SYS_XMLNODEID_GETCID":           false,
-		"SYS_XMLNODEID_GETLOCATOR":       false,
-		"SYS_XMLNODEID_GETOKEY":          false,
-		"SYS_XMLNODEID_GETPATHID":        false,
-		"SYS_XMLNODEID_GETPTRID":         false,
-		"SYS_XMLNODEID_GETRID":           false,
-		"SYS_XMLNODEID_GETSVAL":          false,
-		"SYS_XMLNODEID_GETTID":           false,
-		"SYS_XMLNODEID":                  false,
-		"SYS_XMLT_2_SC":                  false,
-		"SYS_XMLTRANSLATE":               false,
-		"SYS_XMLTYPE2SQL":                false,
-		"SYS_XQ_ASQLCNV":                 false,
-		"SYS_XQ_ATOMCNVCHK":              false,
-		"SYS_XQ_NRNG":                    false,
-		"SYS_XQ_PKSQL2XML":               false,
-		"SYS_XQ_UPKXML2SQL":              false,
-		"SYS_XQBASEURI":                  false,
-		"SYS_XQCASTABLEERRH":             false,
-		"SYS_XQCODEP2STR":                false,
-		"SYS_XQCODEPEQ":                  false,
-		"SYS_XQCON2SEQ":                  false,
-		"SYS_XQCONCAT":                   false,
-		"SYS_XQDELETE":                   false,
-		"SYS_XQDFLTCOLATION":             false,
-		"SYS_XQDOC":                      false,
-		"SYS_XQDOCURI":                   false,
-		"SYS_XQDURDIV":                   false,
-		"SYS_XQED4URI":                   false,
-		"SYS_XQENDSWITH":                 false,
-		"SYS_XQERR":                      false,
-		"SYS_XQERRH":                     false,
-		"SYS_XQESHTMLURI":                false,
-		"SYS_XQEXLOBVAL":                 false,
-		"SYS_XQEXSTWRP":                  false,
-		"SYS_XQEXTRACT":                  false,
-		"SYS_XQEXTRREF":                  false,
-		"SYS_XQEXVAL":                    false,
-		"SYS_XQFB2STR":                   false,
-		"SYS_XQFNBOOL":                   false,
-		"SYS_XQFNCMP":                    false,
-		"SYS_XQFNDATIM":                  false,
-		"SYS_XQFNLNAME":                  false,
-		"SYS_XQFNNM":                     false,
-		"SYS_XQFNNSURI":                  false,
-		"SYS_XQFNPREDTRUTH":              false,
-		"SYS_XQFNQNM":                    false,
-		"SYS_XQFNROOT":                   false,
-		"SYS_XQFORMATNUM":                false,
-		"SYS_XQFTCONTAIN":                false,
-		"SYS_XQFUNCR":                    false,
-		"SYS_XQGETCONTENT":               false,
-		"SYS_XQINDXOF":                   false,
-		"SYS_XQINSERT":                   false,
-		"SYS_XQINSPFX":                   false,
-		"SYS_XQIRI2URI":                  false,
-		"SYS_XQLANG":                     false,
-		"SYS_XQLLNMFRMQNM":               false,
-		"SYS_XQMKNODEREF":                false,
-		"SYS_XQNILLED":                   false,
-		"SYS_XQNODENAME":                 false,
-		"SYS_XQNORMSPACE":                false,
-		"SYS_XQNORMUCODE":                false,
-		"SYS_XQNSP4PFX":                  false,
-		"SYS_XQNSPFRMQNM":                false,
-		"SYS_XQPFXFRMQNM":                false,
-		"SYS_XQPOLYABS":                  false,
-		"SYS_XQPOLYADD":                  false,
-		"SYS_XQPOLYCEL":                  false,
-		"SYS_XQPOLYCST":                  false,
-		"SYS_XQPOLYCSTBL":                false,
-		"SYS_XQPOLYDIV":                  false,
-		"SYS_XQPOLYFLR":                  false,
-		"SYS_XQPOLYMOD":                  false,
-		"SYS_XQPOLYMUL":                  false,
-		"SYS_XQPOLYRND":                  false,
-		"SYS_XQPOLYSQRT":                 false,
-		"SYS_XQPOLYSUB":                  false,
-		"SYS_XQPOLYUMUS":                 false,
-		"SYS_XQPOLYUPLS":                 false,
-		"SYS_XQPOLYVEQ":                  false,
-		"SYS_XQPOLYVGE":                  false,
-		"SYS_XQPOLYVGT":                  false,
-		"SYS_XQPOLYVLE":                  false,
-		"SYS_XQPOLYVLT":                  false,
-		"SYS_XQPOLYVNE":                  false,
-		"SYS_XQREF2VAL":                  false,
-		"SYS_XQRENAME":                   false,
-		"SYS_XQREPLACE":                  false,
-		"SYS_XQRESVURI":                  false,
-		"SYS_XQRNDHALF2EVN":              false,
-		"SYS_XQRSLVQNM":                  false,
-		"SYS_XQRYENVPGET":                false,
-		"SYS_XQRYVARGET":                 false,
-		"SYS_XQRYWRP":                    false,
-		"SYS_XQSEQ2CON":                  false,
-		"SYS_XQSEQ2CON4XC":               false,
-		"SYS_XQSEQDEEPEQ":                false,
-		"SYS_XQSEQINSB":                  false,
-		"SYS_XQSEQRM":                    false,
-		"SYS_XQSEQRVS":                   false,
-		"SYS_XQSEQSUB":                   false,
-		"SYS_XQSEQTYPMATCH":              false,
-		"SYS_XQSTARTSWITH":               false,
-		"SYS_XQSTATBURI":                 false,
-		"SYS_XQSTR2CODEP":                false,
-		"SYS_XQSTRJOIN":                  false,
-		"SYS_XQSUBSTRAFT":                false,
-		"SYS_XQSUBSTRBEF":                false,
-		"SYS_XQTOKENIZE":                 false,
-		"SYS_XQTREATAS":                  false,
-		"SYS_XQXFORM":                    false,
-		"SYS_XSID_TO_RAW":                false,
-		"SYS_ZMAP_FILTER":                false,
-		"SYS_ZMAP_REFRESH":               false,
-		"SYSASM":                         false,
-		"SYSAUX":                         false,
-		"SYSBACKUP":                      false,
-		"SYSDATE":                        false,
-		"SYSDBA":                         false,
-		"SYSDG":                          false,
-		"SYSGUID":                        false,
-		"SYSKM":                          false,
-		"SYSOBJ":                         false,
-		"SYSOPER":                        false,
-		"SYSRAC":                         false,
-		"SYSTEM_DEFINED":                 false,
-		"SYSTEM_STATS":                   false,
-		"SYSTEM":                         false,
-		"SYSTIMESTAMP":                   false,
-		"TABLE_LOOKUP_BY_NL":             false,
-		"TABLE_STATS":                    false,
-		"TABLES":                         false,
-		"TABLESPACE_NO":                  false,
-		"TABLESPACE":                     false,
-		"TABNO":                          false,
-		"TAG":                            false,
-		"TAN":                            false,
-		"TANH":                           false,
-		"TARGET":                         false,
-		"TBL$OR$IDX$PART$NUM":            false,
-		"TEMP_TABLE":                     false,
-		"TEMPFILE":                       false,
-		"TEMPLATE":                       false,
-		"TEMPORARY":                      false,
-		"TENANT_ID":                      false,
-		"TEST":                           false,
-		"TEXT":                           false,
-		"THAN":                           true,
-		"THE":                            false,
-		"THREAD":                         false,
-		"THROUGH":                        false,
-		"TIER":                           false,
-		"TIES":                           false,
-		"TIME_ZONE":                      false,
-		"TIME":                           false,
-		"TIMEOUT":                        false,
-		"TIMES":                          false,
-		"TIMESTAMP_TO_NUMBER":            false,
-		"TIMESTAMP":                      false,
-		"TIMEZONE_ABBR":                  false,
-		"TIMEZONE_HOUR":                  false,
-		"TIMEZONE_MINUTE":                false,
-		"TIMEZONE_OFFSET":                false,
-		"TIMEZONE_REGION":                false,
-		"TIV_GB":                         false,
-		"TIV_SSF":                        false,
-		"TO_ACLID":                       false,
-		"TO_APPROX_COUNT_DISTINCT":       false,
-		"TO_APPROX_PERCENTILE":           false,
-		"TO_BINARY_DOUBLE":               false,
-		"TO_BINARY_FLOAT":                false,
-		"TO_BLOB":                        false,
-		"TO_CHAR":                        false,
-		"TO_CLOB":                        false,
-		"TO_DATE":                        false,
-		"TO_DSINTERVAL":                  false,
-		"TO_LOB":                         false,
-		"TO_MULTI_BYTE":                  false,
-		"TO_NCHAR":                       false,
-		"TO_NCLOB":                       false,
-		"TO_NUMBER":                      false,
-		"TO_SINGLE_BYTE":                 false,
-		"TO_TIME_TZ":                     false,
-		"TO_TIME":                        false,
-		"TO_TIMESTAMP_TZ":                false,
-		"TO_TIMESTAMP":                   false,
-		"TO_UTC_TIMESTAMP_TZ":            false,
-		"TO_YMINTERVAL":                  false,
-		"TOKEN":                          false,
-		"TOPLEVEL":                       false,
-		"TRACE":                          false,
-		"TRACING":                        false,
-		"TRACKING":                       false,
-		"TRAILING":                       false,
-		"TRANSACTION":                    false,
-		"TRANSFORM_DISTINCT_AGG":         false,
-		"TRANSITION":                     false,
-		"TRANSITIONAL":                   false,
-		"TRANSLATE":                      false,
-		"TRANSLATION":                    false,
-		"TREAT":                          false,
-		"TRIGGERS":                       false,
-		"TRIM":                           false,
-		"TRUE":                           false,
-		"TRUNC":                          false,
-		"TRUNCATE":                       true,
-		"TRUST":                          false,
-		"TRUSTED":                        false,
-		"TUNING":                         false,
-		"TX":                             false,
-		"TYPE":                           false,
-		"TYPES":                          false,
-		"TZ_OFFSET":                      false,
-		"UB2":                            false,
-		"UBA":                            false,
-		"UCS2":                           false,
-		"UID":                            false,
-		"UNARCHIVED":                     false,
-		"UNBOUND":                        false,
-		"UNBOUNDED":                      false,
-		"UNCONDITIONAL":                  false,
-		"UNDER":                          false,
-		"UNDO":                           false,
-		"UNDROP":                         false,
-		"UNIFORM":                        false,
-		"UNINSTALL":                      false,
-		"UNISTR":                         false,
-		"UNITE":                          false,
-		"UNIXTIME":                       false,
-		"UNLIMITED":                      false,
-		"UNLOAD":                         false,
-		"UNLOCK":                         false,
-		"UNMATCHED":                      false,
-		"UNNEST_INNERJ_DISTINCT_VIEW":    false,
-		"UNNEST_NOSEMIJ_NODISTINCTVIEW":  false,
-		"UNNEST_SEMIJ_VIEW":              false,
-		"UNNEST":                         false,
-		"UNPACKED":                       false,
-		"UNPIVOT":                        false,
-		"UNPLUG":                         false,
-		"UNPROTECTED":                    false,
-		"UNQUIESCE":                      false,
-		"UNRECOVERABLE":                  false,
-		"UNRESTRICTED":                   false,
-		"UNSUBSCRIBE":                    false,
-		"UNTIL":                          false,
-		"UNUSABLE":                       false,
-		"UNUSED":                         false,
-		"UPD_INDEXES":                    false,
-		"UPD_JOININDEX":                  false,
-		"UPDATABLE":                      false,
-		"UPDATED":                        false,
-		"UPDATEXML":                      false,
-		"UPGRADE":                        false,
-		"UPPER":                          false,
-		"UPSERT":                         false,
-		"UROWID":                         false,
-		"USABLE":                         false,
-		"USAGE":                          false,
-		"USE_ANTI":                       false,
-		"USE_CONCAT":                     false,
-		"USE_CUBE":                       false,
-		"USE_DAGG_UNION_ALL_GSETS":       false,
-		"USE_HASH_AGGREGATION":           false,
-		"USE_HASH_GBY_FOR_DAGGPSHD":      false,
-		"USE_HASH_GBY_FOR_PUSHDOWN":      false,
-		"USE_HASH":                       false,
-		"USE_HIDDEN_PARTITIONS":          false,
-		"USE_INVISIBLE_INDEXES":          false,
-		"USE_MERGE_CARTESIAN":            false,
-		"USE_MERGE":                      false,
-		"USE_NL_WITH_INDEX":              false,
-		"USE_NL":                         false,
-		"USE_PARTITION_WISE_DISTINCT":    false,
-		"USE_PARTITION_WISE_GBY":         false,
-		"USE_PARTITION_WISE_WIF":         false,
-		"USE_PRIVATE_OUTLINES":           false,
-		"USE_SEMI":                       false,
-		"USE_STORED_OUTLINES":            false,
-		"USE_TTT_FOR_GSETS":              false,
-		"USE_VECTOR_AGGREGATION":         false,
-		"USE_WEAK_NAME_RESL":             false,
-		"USE":                            false,
-		"USER_DATA":                      false,
-		"USER_DEFINED":                   false,
-		"USER_RECYCLEBIN":                false,
-		"USER_TABLESPACES":               false,
-		"USER":                           false,
-		"USERENV":                        false,
-		"USERGROUP":                      false,
-		"USERS":                          false,
-		"USING_NO_EXPAND":                false,
-		"USING":                          true,
-		"UTF16BE":                        false,
-		"UTF16LE":                        false,
-		"UTF32":                          false,
-		"UTF8":                           false,
-		"V1":                             false,
-		"V2":                             false,
-		"VALID_TIME_END":                 false,
-		"VALIDATE_CONVERSION":            false,
-		"VALIDATE":                       false,
-		"VALIDATION":                     false,
-		"VALUE":                          false,
-		"VAR_POP":                        false,
-		"VAR_SAMP":                       false,
-		"VARIANCE":                       false,
-		"VARRAY":                         false,
-		"VARRAYS":                        false,
-		"VARYING":                        false,
-		"VECTOR_ENCODE":                  false,
-		"VECTOR_READ_TRACE":              false,
-		"VECTOR_READ":                    false,
-		"VECTOR_TRANSFORM_DIMS":          false,
-		"VECTOR_TRANSFORM_FACT":          false,
-		"VECTOR_TRANSFORM":               false,
-		"VECTOR":                         false,
-		"VERIFIER":                       false,
-		"VERIFY":                         false,
-		"VERSION":                        false,
-		"VERSIONING":                     false,
-		"VERSIONS_ENDSCN":                false,
-		"VERSIONS_ENDTIME":               false,
-		"VERSIONS_OPERATION":             false,
-		"VERSIONS_STARTSCN":              false,
-		"VERSIONS_STARTTIME":             false,
-		"VERSIONS_XID":                   false,
-		"VERSIONS":                       false,
-		"VIOLATION":                      false,
-		"VIRTUAL":                        false,
-		"VISIBILITY":                     false,
-		"VISIBLE":                        false,
-		"VOLUME":                         false,
-		"VSIZE":                          false,
-		"WAIT":                           false,
-		"WALLET":                         false,
-		"WEEK":                           false,
-		"WEEKS":                          false,
-		"WELLFORMED":                     false,
-		"WHEN":                           true,
-		"WHENEVER":                       false,
-		"WHITESPACE":                     false,
-		"WIDTH_BUCKET":                   false,
-		"WITH_EXPRESSION":                false,
-		"WITH_PLSQL":                     false,
-		"WITHIN":                         false,
-		"WITHOUT":                        false,
-		"WORK":                           false,
-		"WRAPPED":                        false,
-		"WRAPPER":                        false,
-		"WRITE":                          false,
-		"X_DYN_PRUNE":                    false,
-		"XDB_FASTPATH_INSERT":            false,
-		"XID":                            false,
-		"XML_DML_RWT_STMT":               false,
-		"XML":                            false,
-		"XML2OBJECT":                     false,
-		"XMLATTRIBUTES":                  false,
-		"XMLCAST":                        false,
-		"XMLCDATA":                       false,
-		"XMLCOLATTVAL":                   false,
-		"XMLCOMMENT":                     false,
-		"XMLCONCAT":                      false,
-		"XMLDIFF":                        false,
-		"XMLELEMENT":                     false,
-		"XMLEXISTS":                      false,
-		"XMLEXISTS2":                     false,
-		"XMLFOREST":                      false,
-		"XMLINDEX_REWRITE_IN_SELECT":     false,
-		"XMLINDEX_REWRITE":               false,
-		"XMLINDEX_SEL_IDX_TBL":           false,
-		"XMLISNODE":                      false,
-		"XMLISVALID":                     false,
-		"XMLNAMESPACES":                  false,
-		"XMLPARSE":                       false,
-		"XMLPATCH":                       false,
-		"XMLPI":                          false,
-		"XMLQUERY":                       false,
-		"XMLQUERYVAL":                    false,
-		"XMLROOT":                        false,
-		"XMLSCHEMA":                      false,
-		"XMLSERIALIZE":                   false,
-		"XMLTABLE":                       false,
-		"XMLTOJSON":                      false,
-		"XMLTOKENSET":                    false,
-		"XMLTRANSFORM":                   false,
-		"XMLTRANSFORMBLOB":               false,
-		"XMLTSET_DML_ENABLE":             false,
-		"XMLTYPE":                        false,
-		"XPATHTABLE":                     false,
-		"XS_SYS_CONTEXT":                 false,
-		"XS":                             false,
-		"YEAR":                           false,
-		"YEARS":                          false,
-		"YES":                            false,
-		"ZONE":                           false,
-		"ZONEMAP":                        false,
-		"ALL":                            true,
-		"ALTER":                          true,
-		"AND":                            true,
-		"ANY":                            true,
-		"AS":                             true,
-		"ASC":                            true,
-		"BETWEEN":                        true,
-		"BY":                             true,
-		"CHAR":                           true,
-		"CHECK":                          true,
-		"CLUSTER":                        true,
-		"COMPRESS":                       true,
-		"CONNECT":                        true,
-		"CREATE":                         true,
-		"DATE":                           true,
-		"DECIMAL":                        true,
-		"DEFAULT":                        true,
-		"DELETE":                         true,
-		"DESC":                           true,
-		"DISTINCT":                       true,
-		"DROP":                           true,
-		"ELSE":                           true,
-		"EXCLUSIVE":                      true,
-		"EXISTS":                         true,
-		"FLOAT":                          true,
-		"FOR":                            true,
-		"FROM":                           true,
-		"GRANT":                          true,
-		"GROUP":                          true,
-		"HAVING":                         true,
-		"IDENTIFIED":                     true,
-		"IN":                             true,
-		"INDEX":                          true,
-		"INSERT":                         true,
-		"INTEGER":                        true,
-		"INTERSECT":                      true,
-		"INTO":                           true,
-		"IS":                             true,
-		"LIKE":                           true,
-		"LOCK":                           true,
-		"LONG":                           true,
-		"MINUS":                          true,
-		"MODE":                           true,
-		"NOCOMPRESS":                     true,
-		"NOT":                            true,
-		"NOWAIT":                         true,
-		"NULL":                           true,
-		"NUMBER":                         true,
-		"OF":                             true,
-		"ON":                             true,
-		"OPTION":                         true,
-		"OR":                             true,
-		"ORDER":                          true,
-		"PCTFREE":                        true,
-		"PRIOR":                          true,
-		"PUBLIC":                         true,
-		"RAW":                            true,
-		"RENAME":                         true,
-		"RESOURCE":                       true,
-		"REVOKE":                         true,
-		"SELECT":                         true,
-		"SET":                            true,
-		"SHARE":                          true,
-		"SIZE":                           true,
-		"SMALLINT":                       true,
-		"START":                          true,
-		"SYNONYM":                        true,
-		"TABLE":                          true,
-		"THEN":                           true,
-		"TO":                             true,
-		"TRIGGER":                        true,
-		"UNION":                          true,
-		"UNIQUE":                         true,
-		"UPDATE":                         true,
-		"VALUES":                         true,
-		"VARCHAR":                        true,
-		"VARCHAR2":                       true,
-		"VIEW":                           true,
-		"WHERE":                          true,
-		"WITH":                           true,
+// oracleDatatypeLimits is the range of the parameters of the Oracle
+// datatypes (with the default MAX_STRING_SIZE = STANDARD)
+var oracleDatatypeLimits = map[string]paramLimits{
+	"char":                           {minLength: 1, maxLength: 2000},
+	"nchar":                          {minLength: 1, maxLength: 2000},
+	"varchar":                        {minLength: 1, maxLength: 4000},
+	"varchar2":                       {minLength: 1, maxLength: 4000},
+	"nvarchar2":                      {minLength: 1, maxLength: 4000},
+	"raw":                            {minLength: 1, maxLength: 2000},
+	"urowid":                         {minLength: 1, maxLength: 4000},
+	"number":                         {minPrecision: 1, maxPrecision: 38, minScale: -84, maxScale: 127},
+	"float":                          {minPrecision: 1, maxPrecision: 126},
+	"timestamp":                      {maxPrecision: 9},
+	"timestamp with time zone":       {maxPrecision: 9},
+	"timestamp with local time zone": {maxPrecision: 9},
+	"interval day to second":         {maxPrecision: 9, maxScale: 9},
+	"interval year to month":         {maxPrecision: 9},
+}
+
+// oracleExtendedDatatypeLimits is the range of the parameters of the
+// Oracle datatypes when MAX_STRING_SIZE = EXTENDED
+var oracleExtendedDatatypeLimits = func() map[string]paramLimits {
+
+	m := make(map[string]paramLimits)
+	for k, v := range oracleDatatypeLimits {
+		m[k] = v
 	}
+	for _, k := range []string{"varchar", "varchar2", "nvarchar2", "raw"} {
+		l := m[k]
+		l.maxLength = 32767
+		m[k] = l
+	}
+
+	return m
+}()
+
+// oracleDatatypeInfo is the metadata for the Oracle datatypes
+var oracleDatatypeInfo = map[string]DatatypeInfo{
+	"bfile":                          lobInfo,
+	"binary_double":                  {Category: ApproximateNumericCategory, Size: 8, MinValue: "-1.79769313486231E+308", MaxValue: "1.79769313486231E+308"},
+	"binary_float":                   {Category: ApproximateNumericCategory, Size: 4, MinValue: "-3.40282E+38", MaxValue: "3.40282E+38"},
+	"binary_integer":                 integerInfo, // PL/SQL
+	"blob":                           lobInfo,
+	"boolean":                        booleanInfo,
+	"char":                           charInfo,
+	"clob":                           lobInfo,
+	"date":                           {Category: TemporalCategory, Size: 7, MinValue: "-4712-01-01", MaxValue: "9999-12-31"},
+	"float":                          floatInfo,
+	"identity":                       deprecated(decimalInfo), // use number GENERATED AS IDENTITY
+	"integer":                        decimalInfo,             // number (38)
+	"interval day to second":         {Category: TemporalCategory, Size: 11},
+	"interval year to month":         {Category: TemporalCategory, Size: 5},
+	"long":                           deprecated(charInfo),
+	"long raw":                       deprecated(binaryInfo),
+	"nchar":                          charInfo,
+	"nclob":                          lobInfo,
+	"number":                         {Category: ExactNumericCategory, MinValue: "-9.99999999999999999999999999999999999999E+125", MaxValue: "9.99999999999999999999999999999999999999E+125"},
+	"nvarchar2":                      charInfo,
+	"pls_integer":                    integerInfo, // PL/SQL
+	"raw":                            binaryInfo,
+	"ref cursor":                     otherInfo,
+	"rowid":                          {Category: OtherCategory, Size: 10},
+	"smallint":                       decimalInfo, // number (38)
+	"timestamp":                      {Category: TemporalCategory, Size: 11, MinValue: "-4712-01-01", MaxValue: "9999-12-31"},
+	"timestamp with local time zone": {Category: TemporalCategory, Size: 11, MinValue: "-4712-01-01", MaxValue: "9999-12-31"},
+	"timestamp with time zone":       {Category: TemporalCategory, Size: 13, MinValue: "-4712-01-01", MaxValue: "9999-12-31"},
+	"urowid":                         otherInfo,
+	"varchar":                        charInfo,
+	"varchar2":                       charInfo,
+}
+
+// oracleDatatypeAliases maps the Oracle datatype synonyms to their
+// canonical form
+var oracleDatatypeAliases = map[string]string{
+	"binary_integer": "pls_integer",
+	"integer":        "number(38)",
+	"smallint":       "number(38)",
+	"varchar":        "varchar2",
+}
+
+// IsDatatype returns a boolean indicating if the supplied string
+// (or string slice) is considered to be a datatype in Oracle
+func (d OracleDialect) IsDatatype(s ...string) bool {
+	_, err := d.ParseDatatype(s...)
+	return err == nil
+}
+
+// ParseDatatype returns the parsed form of the supplied datatype
+// tokens, or an error if they are not a valid Oracle datatype
+func (d OracleDialect) ParseDatatype(tokens ...string) (Datatype, error) {
+	return parseDatatype(d, d.parseRules(), tokens)
+}
+
+// parseRules returns the rules for parsing Oracle datatypes
+func (d OracleDialect) parseRules() datatypeRules {
 
-	var oraclePLKeywords = map[string]bool{
-		// "A": false,
-		// "C": false,
-		"ACCESSIBLE":      false,
-		"ADD":             false,
-		"AGENT":           false,
-		"AGGREGATE":       false,
-		"ALL":             false,
-		"ALTER":           false,
-		"AND":             true,
-		"ANY":             true,
-		"ARRAY":           false,
-		"ASC":             false,
-		"AS":              false,
-		"AT":              false,
-		"ATTRIBUTE":       false,
-		"AUTHID":          false,
-		"AVG":             false,
-		"BEGIN":           true,
-		"BETWEEN":         true,
-		"BFILE_BASE":      false,
-		"BINARY":          false,
-		"BLOB_BASE":       false,
-		"BLOCK":           false,
-		"BODY":            false,
-		"BOTH":            false,
-		"BOUND":           false,
-		"BULK":            false,
-		"BY":              false,
-		"BYTE":            false,
-		"CALL":            false,
-		"CALLING":         false,
-		"CASCADE":         false,
-		"CASE":            false,
-		"CHARACTER":       false,
-		"CHAR_BASE":       false,
-		"CHAR":            false,
-		"CHARSET":         false,
-		"CHARSETFORM":     false,
-		"CHARSETID":       false,
-		"CHECK":           false,
-		"CLOB_BASE":       false,
-		"CLONE":           false,
-		"CLOSE":           true,
-		"CLUSTER":         false,
-		"CLUSTERS":        false,
-		"COLAUTH":         false,
-		"COLLECT":         false,
-		"COLUMNS":         false,
-		"COMMENT":         true,
-		"COMMIT":          true,
-		"COMMITTED":       false,
-		"COMPILED":        false,
-		"COMPRESS":        false,
-		"CONNECT":         false,
-		"CONSTANT":        false,
-		"CONSTRUCTOR":     false,
-		"CONTEXT":         false,
-		"CONTINUE":        true,
-		"CONVERT":         false,
-		"COUNT":           false,
-		"CRASH":           false,
-		"CREATE":          true,
-		"CREDENTIAL":      false,
-		"CURRENT":         false,
-		"CURSOR":          false,
-		"CUSTOMDATUM":     false,
-		"DANGLING":        false,
-		"DATA":            false,
-		"DATE_BASE":       false,
-		"DATE":            false,
-		"DAY":             false,
-		"DECLARE":         false,
-		"DEFAULT":         false,
-		"DEFINE":          false,
-		"DELETE":          false,
-		"DESC":            false,
-		"DETERMINISTIC":   false,
-		"DIRECTORY":       false,
-		"DISTINCT":        false,
-		"DOUBLE":          false,
-		"DROP":            false,
-		"DURATION":        false,
-		"ELEMENT":         false,
-		"ELSE":            false,
-		"ELSIF":           false,
-		"EMPTY":           false,
-		"END":             false,
-		"ESCAPE":          false,
-		"EXCEPT":          false,
-		"EXCEPTION":       false,
-		"EXCEPTIONS":      false,
-		"EXCLUSIVE":       false,
-		"EXECUTE":         false,
-		"EXISTS":          false,
-		"EXIT":            false,
-		"EXTERNAL":        false,
-		"FETCH":           false,
-		"FINAL":           false,
-		"FIRST":           false,
-		"FIXED":           false,
-		"FLOAT":           false,
-		"FORALL":          false,
-		"FORCE":           false,
-		"FOR":             false,
-		"FROM":            false,
-		"FUNCTION":        false,
-		"GENERAL":         false,
-		"GOTO":            false,
-		"GRANT":           false,
-		"GROUP":           false,
-		"HASH":            false,
-		"HAVING":          false,
-		"HEAP":            false,
-		"HIDDEN":          false,
-		"HOUR":            false,
-		"IDENTIFIED":      false,
-		"IF":              false,
-		"IMMEDIATE":       false,
-		"INCLUDING":       false,
-		"INDEXES":         false,
-		"INDEX":           false,
-		"INDICATOR":       false,
-		"INDICES":         false,
-		"IN":              false,
-		"INFINITE":        false,
-		"INSERT":          false,
-		"INSTANTIABLE":    false,
-		"INTERFACE":       false,
-		"INTERSECT":       false,
-		"INTERVAL":        false,
-		"INT":             false,
-		"INTO":            false,
-		"INVALIDATE":      false,
-		"IS":              false,
-		"ISOLATION":       false,
-		"JAVA":            false,
-		"LANGUAGE":        false,
-		"LARGE":           false,
-		"LEADING":         false,
-		"LENGTH":          false,
-		"LEVEL":           false,
-		"LIBRARY":         false,
-		"LIKE2":           false,
-		"LIKE4":           false,
-		"LIKEC":           false,
-		"LIKE":            false,
-		"LIMITED":         false,
-		"LIMIT":           false,
-		"LOCAL":           false,
-		"LOCK":            false,
-		"LONG":            false,
-		"LOOP":            false,
-		"MAP":             false,
-		"MAX":             false,
-		"MAXLEN":          false,
-		"MEMBER":          false,
-		"MERGE":           false,
-		"MIN":             false,
-		"MINUS":           false,
-		"MINUTE":          false,
-		"MODE":            false,
-		"MOD":             false,
-		"MODIFY":          false,
-		"MONTH":           false,
-		"MULTISET":        false,
-		"NAME":            false,
-		"NAN":             false,
-		"NATIONAL":        false,
-		"NATIVE":          false,
-		"NCHAR":           false,
-		"NEW":             false,
-		"NOCOMPRESS":      false,
-		"NOCOPY":          false,
-		"NOT":             false,
-		"NOWAIT":          false,
-		"NULL":            false,
-		"NUMBER_BASE":     false,
-		"OBJECT":          false,
-		"OCICOLL":         false,
-		"OCIDATE":         false,
-		"OCIDATETIME":     false,
-		"OCIDURATION":     false,
-		"OCIINTERVAL":     false,
-		"OCILOBLOCATOR":   false,
-		"OCINUMBER":       false,
-		"OCIRAW":          false,
-		"OCIREFCURSOR":    false,
-		"OCIREF":          false,
-		"OCIROWID":        false,
-		"OCISTRING":       false,
-		"OCITYPE":         false,
-		"OF":              false,
-		"OLD":             false,
-		"ON":              false,
-		"ONLY":            false,
-		"OPAQUE":          false,
-		"OPEN":            false,
-		"OPERATOR":        false,
-		"OPTION":          false,
-		"ORACLE":          false,
-		"ORADATA":         false,
-		"ORDER":           false,
-		"OR":              false,
-		"ORGANIZATION":    false,
-		"ORLANY":          false,
-		"ORLVARY":         false,
-		"OTHERS":          false,
-		"OUT":             false,
-		"OVERLAPS":        false,
-		"OVERRIDING":      false,
-		"PACKAGE":         false,
-		"PARALLEL_ENABLE": false,
-		"PARAMETER":       false,
-		"PARAMETERS":      false,
-		"PARENT":          false,
-		"PARTITION":       false,
-		"PASCAL":          false,
-		"PERSISTABLE":     false,
-		"PIPE":            false,
-		"PIPELINED":       false,
-		"PLUGGABLE":       false,
-		"POLYMORPHIC":     false,
-		"PRAGMA":          false,
-		"PRECISION":       false,
-		"PRIOR":           false,
-		"PRIVATE":         false,
-		"PROCEDURE":       false,
-		"PUBLIC":          false,
-		"RAISE":           false,
-		"RANGE":           false,
-		"RAW":             false,
-		"READ":            false,
-		"RECORD":          false,
-		"REFERENCE":       false,
-		"REF":             false,
-		"RELIES_ON":       false,
-		"REMAINDER":       false,
-		"REM":             false,
-		"RENAME":          false,
-		"RESOURCE":        false,
-		"RESULT_CACHE":    false,
-		"RESULT":          false,
-		"RETURN":          false,
-		"RETURNING":       false,
-		"REVERSE":         false,
-		"REVOKE":          false,
-		"ROLLBACK":        false,
-		"ROW":             false,
-		"SAMPLE":          false,
-		"SAVE":            false,
-		"SAVEPOINT":       false,
-		"SB1":             false,
-		"SB2":             false,
-		"SB4":             false,
-		"SECOND":          false,
-		"SEGMENT":         false,
-		"SELECT":          false,
-		"SELF":            false,
-		"SEPARATE":        false,
-		"SEQUENCE":        false,
-		"SERIALIZABLE":    false,
-		"SET":             false,
-		"SHARE":           false,
-		"SHORT":           false,
-		"SIZE":            false,
-		"SIZE_T":          false,
-		"SOME":            false,
-		"SPARSE":          false,
-		"SQLCODE":         false,
-		"SQLDATA":         false,
-		"SQL":             false,
-		"SQLNAME":         false,
-		"SQLSTATE":        false,
-		"STANDARD":        false,
-		"START":           false,
-		"STATIC":          false,
-		"STDDEV":          false,
-		"STORED":          false,
-		"STRING":          false,
-		"STRUCT":          false,
-		"STYLE":           false,
-		"SUBMULTISET":     false,
-		"SUBPARTITION":    false,
-		"SUBSTITUTABLE":   false,
-		"SUBTYPE":         false,
-		"SUM":             false,
-		"SYNONYM":         false,
-		"TABAUTH":         false,
-		"TABLE":           false,
-		"TDO":             false,
-		"THE":             false,
-		"THEN":            false,
-		"TIME":            false,
-		"TIMESTAMP":       false,
-		"TIMEZONE_ABBR":   false,
-		"TIMEZONE_HOUR":   false,
-		"TIMEZONE_MINUTE": false,
-		"TIMEZONE_REGION": false,
-		"TO":              false,
-		"TRAILING":        false,
-		"TRANSACTIONAL":   false,
-		"TRANSACTION":     false,
-		"TRUSTED":         false,
-		"TYPE":            false,
-		"UB1":             false,
-		"UB2":             false,
-		"UB4":             false,
-		"UNDER":           false,
-		"UNION":           false,
-		"UNIQUE":          false,
-		"UNPLUG":          false,
-		"UNSIGNED":        false,
-		"UNTRUSTED":       false,
-		"UPDATE":          false,
-		"USE":             false,
-		"USING":           false,
-		"VALIST":          false,
-		"VALUE":           false,
-		"VALUES":          false,
-		"VARIABLE":        false,
-		"VARIANCE":        false,
-		"VARRAY":          false,
-		"VARYING":         false,
-		"VIEW":            false,
-		"VIEWS":           false,
-		"VOID":            false,
-		"WHEN":            false,
-		"WHERE":           false,
-		"WHILE":           false,
-		"WITH":            false,
-		"WORK":            false,
-		"WRAPPED":         false,
-		"WRITE":           false,
-		"YEAR":            false,
-		"ZONE":            false,
+	limits := oracleDatatypeLimits
+	if d.extendedStringSize {
+		limits = oracleExtendedDatatypeLimits
 	}
 
+	return datatypeRules{types: oracleDatatypes, signed: true, lengths: oracleLengthDatatypes, limits: limits}
+}
+
+// IsDatatypePart returns a boolean indicating if the supplied string
+// is considered to be part of a datatype definition in Oracle
+func (d OracleDialect) IsDatatypePart(s string) bool {
+	return oracleDatatypeParts[strings.ToLower(s)]
+}
+
+// DatatypeInfo returns the metadata for the named Oracle datatype
+func (d OracleDialect) DatatypeInfo(name string) (DatatypeInfo, bool) {
+	return datatypeInfo(d, oracleDatatypeInfo, name)
+}
+
+// Datatypes returns the metadata for all of the Oracle datatypes
+func (d OracleDialect) Datatypes() []DatatypeInfo {
+	return datatypeInfos(oracleDatatypeInfo)
+}
+
+// CanonicalDatatype returns the supplied datatype with any synonym
+// replaced by the canonical Oracle form (e.g. integer becomes number (38))
+func (d OracleDialect) CanonicalDatatype(t Datatype) Datatype {
+	return canonicalDatatype(d, oracleDatatypeAliases, t)
+}
+
+/*
+   Oracle keywords
+
+   SELECT  CASE
+               WHEN length ( keyword ) = 1 AND reserved = 'Y' THEN '// "' || keyword || '": true,'
+               WHEN length ( keyword ) = 1 THEN '// "' || keyword || '": false,'
+               WHEN reserved = 'Y' THEN '"' || keyword || '": true,'
+               ELSE '"' || keyword || '": false,'
+               END
+       FROM v$reserved_words
+       WHERE keyword IS NOT NULL
+           AND regexp_like ( keyword, '^[A-Z]' )
+       ORDER BY CASE
+               WHEN length ( keyword ) = 1 THEN 1
+               ELSE 2
+               END,
+               keyword ;
+
+
+   PL/SQL Reserved Words and Keywords
+
+   https://docs.oracle.com/en/database/oracle/oracle-database/19/lnpls/plsql-reserved-words-keywords.html
+
+   bdr549
+
+*/
+
+var oracleSQLKeywords = map[string]bool{
+	// "A": false,
+	// "D": false,
+	// "E": false,
+	// "G": false,
+	// "H": false,
+	// "K": false,
+	// "M": false,
+	// "P": false,
+	// "T": false,
+	// "U": false,
+	"ABORT":                          false,
+	"ABS":                            false,
+	"ABSENT":                         false,
+	"ACCESS":                         false,
+	"ACCESSED":                       false,
+	"ACCOUNT":                        false,
+	"ACL":                            false,
+	"ACOS":                           false,
+	"ACROSS":                         false,
+	"ACTION":                         false,
+	"ACTIONS":                        false,
+	"ACTIVATE":                       false,
+	"ACTIVE_COMPONENT":               false,
+	"ACTIVE_DATA":                    false,
+	"ACTIVE_FUNCTION":                false,
+	"ACTIVE_TAG":                     false,
+	"ACTIVE":                         false,
+	"ACTIVITY":                       false,
+	"ADAPTIVE_PLAN":                  false,
+	"ADD_COLUMN":                     false,
+	"ADD_GROUP":                      false,
+	"ADD_MONTHS":                     false,
+	"ADD":                            false,
+	"ADG_REDIRECT_DML":               false,
+	"ADG_REDIRECT_PLSQL":             false,
+	"ADJ_DATE":                       false,
+	"ADMIN":                          false,
+	"ADMINISTER":                     false,
+	"ADMINISTRATOR":                  false,
+	"ADVANCED":                       false,
+	"ADVISE":                         false,
+	"ADVISOR":                        false,
+	"AFD_DISKSTRING":                 false,
+	"AFFINITY":                       false,
+	"AFTER":                          true,
+	"AGGREGATE":                      false,
+	"AGGREGATES":                     false,
+	"ALGORITHM":                      false,
+	"ALIAS":                          false,
+	"ALL_ROWS":                       false,
+	"ALLOCATE":                       false,
+	"ALLOW":                          false,
+	"ALTERNATE":                      false,
+	"ALWAYS":                         false,
+	"ANALYTIC":                       false,
+	"ANALYZE":                        false,
+	"ANCESTOR":                       false,
+	"ANCILLARY":                      false,
+	"AND_EQUAL":                      false,
+	"ANOMALY":                        false,
+	"ANSI_REARCH":                    false,
+	"ANSWER_QUERY_USING_STATS":       false,
+	"ANTIJOIN":                       false,
+	"ANYSCHEMA":                      false,
+	"APPEND_VALUES":                  false,
+	"APPEND":                         false,
+	"APPENDCHILDXML":                 false,
+	"APPLICATION":                    false,
+	"APPLY":                          false,
+	"APPROX_COUNT_DISTINCT_AGG":      false,
+	"APPROX_COUNT_DISTINCT_DETAIL":   false,
+	"APPROX_COUNT_DISTINCT":          false,
+	"APPROX_COUNT":                   false,
+	"APPROX_MEDIAN":                  false,
+	"APPROX_PERCENTILE_AGG":          false,
+	"APPROX_PERCENTILE_DETAIL":       false,
+	"APPROX_PERCENTILE":              false,
+	"APPROX_RANK":                    false,
+	"APPROX_SUM":                     false,
+	"ARCHIVAL":                       false,
+	"ARCHIVE":                        false,
+	"ARCHIVED":                       false,
+	"ARCHIVELOG":                     false,
+	"ARRAY":                          false,
+	"AS_JSON":                        false,
+	"ASCII":                          false,
+	"ASCIISTR":                       false,
+	"ASIN":                           false,
+	"ASIS":                           false,
+	"ASSEMBLY":                       false,
+	"ASSIGN":                         false,
+	"ASSOCIATE":                      false,
+	"ASYNC":                          false,
+	"ASYNCHRONOUS":                   false,
+	"AT":                             false,
+	"ATAN":                           false,
+	"ATAN2":                          false,
+	"ATTRIBUTE":                      false,
+	"ATTRIBUTES":                     false,
+	"AUDIT":                          false,
+	"AUTHENTICATED":                  false,
+	"AUTHENTICATION":                 false,
+	"AUTHID":                         false,
+	"AUTHORIZATION":                  false,
+	"AUTO_LOGIN":                     false,
+	"AUTO_REOPTIMIZE":                false,
+	"AUTO":                           false,
+	"AUTOALLOCATE":                   false,
+	"AUTOEXTEND":                     false,
+	"AUTOMATIC":                      false,
+	"AV_CACHE":                       false,
+	"AVAILABILITY":                   false,
+	"AVCACHE_OP":                     false,
+	"AVERAGE_RANK":                   false,
+	"AVG":                            false,
+	"AVMDX_OP":                       false,
+	"AVRO":                           false,
+	"AW":                             false,
+	"BACKGROUND":                     false,
+	"BACKUP":                         false,
+	"BAND_JOIN":                      false,
+	"BASIC":                          false,
+	"BASICFILE":                      false,
+	"BATCH_TABLE_ACCESS_BY_ROWID":    false,
+	"BATCH":                          false,
+	"BATCHSIZE":                      false,
+	"BECOME":                         false,
+	"BEFORE":                         true,
+	"BEGIN_OUTLINE_DATA":             false,
+	"BEGIN":                          true,
+	"BEGINNING":                      false,
+	"BEHALF":                         false,
+	"BEQUEATH":                       false,
+	"BFILE":                          false,
+	"BFILENAME":                      false,
+	"BIGFILE":                        false,
+	"BIN_TO_NUM":                     false,
+	"BINARY_DOUBLE_INFINITY":         false,
+	"BINARY_DOUBLE_NAN":              false,
+	"BINARY_DOUBLE":                  false,
+	"BINARY_FLOAT_INFINITY":          false,
+	"BINARY_FLOAT_NAN":               false,
+	"BINARY_FLOAT":                   false,
+	"BINARY":                         false,
+	"BIND_AWARE":                     false,
+	"BINDING":                        false,
+	"BITAND":                         false,
+	"BITMAP_AND":                     false,
+	"BITMAP_TREE":                    false,
+	"BITMAP":                         false,
+	"BITMAPS":                        false,
+	"BITS":                           false,
+	"BLOB":                           false,
+	"BLOCK_RANGE":                    false,
+	"BLOCK":                          false,
+	"BLOCKS":                         false,
+	"BLOCKSIZE":                      false,
+	"BODY":                           true,
+	"BOTH":                           false,
+	"BOUND":                          false,
+	"BRANCH":                         false,
+	"BREADTH":                        false,
+	"BROADCAST":                      false,
+	"BSON":                           false,
+	"BUFFER_CACHE":                   false,
+	"BUFFER_POOL":                    false,
+	"BUFFER":                         false,
+	"BUILD":                          false,
+	"BULK":                           false,
+	"BUSHY_JOIN":                     false,
+	"BYPASS_RECURSIVE_CHECK":         false,
+	"BYPASS_UJVC":                    false,
+	"BYTE":                           false,
+	"CACHE_CB":                       false,
+	"CACHE_INSTANCES":                false,
+	"CACHE_TEMP_TABLE":               false,
+	"CACHE":                          false,
+	"CACHING":                        false,
+	"CALCULATED":                     false,
+	"CALL":                           false,
+	"CALLBACK":                       false,
+	"CANCEL":                         false,
+	"CAPACITY":                       false,
+	"CAPTION":                        false,
+	"CARDINALITY":                    false,
+	"CASCADE":                        true,
+	"CASE":                           true,
+	"CAST":                           false,
+	"CATEGORY":                       false,
+	"CDB_HTTP_HANDLER":               false,
+	"CDB$DEFAULT":                    false,
+	"CEIL":                           false,
+	"CELL_FLASH_CACHE":               false,
+	"CELLMEMORY":                     false,
+	"CERTIFICATE":                    false,
+	"CFILE":                          false,
+	"CHAINED":                        false,
+	"CHANGE_DUPKEY_ERROR_INDEX":      false,
+	"CHANGE":                         false,
+	"CHAR_CS":                        false,
+	"CHARACTER":                      false,
+	"CHARTOROWID":                    false,
+	"CHECK_ACL_REWRITE":              false,
+	"CHECKPOINT":                     false,
+	"CHILD":                          false,
+	"CHOOSE":                         false,
+	"CHR":                            false,
+	"CHUNK":                          false,
+	"CLASS":                          false,
+	"CLASSIFICATION":                 false,
+	"CLASSIFIER":                     false,
+	"CLAUSE":                         false,
+	"CLEAN":                          false,
+	"CLEANUP":                        false,
+	"CLEAR":                          false,
+	"CLIENT":                         false,
+	"CLOB":                           false,
+	"CLONE":                          false,
+	"CLOSE_CACHED_OPEN_CURSORS":      false,
+	"CLOSE":                          false,
+	"CLOSEST":                        false,
+	"CLUSTER_BY_ROWID":               false,
+	"CLUSTER_DETAILS":                false,
+	"CLUSTER_DISTANCE":               false,
+	"CLUSTER_ID":                     false,
+	"CLUSTER_PROBABILITY":            false,
+	"CLUSTER_SET":                    false,
+	"CLUSTERING_FACTOR":              false,
+	"CLUSTERING":                     false,
+	"CO_AUTH_IND":                    false,
+	"COALESCE_SQ":                    false,
+	"COALESCE":                       false,
+	"COARSE":                         false,
+	"COLD":                           false,
+	"COLLATE":                        false,
+	"COLLATION":                      false,
+	"COLLECT":                        false,
+	"COLUMN_AUTH_INDICATOR":          false,
+	"COLUMN_AUTHORIZATION_INDICATOR": false,
+	"COLUMN_STATS":                   false,
+	"COLUMN_VALUE":                   false,
+	"COLUMN":                         true,
+	"COLUMNAR":                       false,
+	"COLUMNS":                        false,
+	"COMMENT":                        true,
+	"COMMIT":                         true,
+	"COMMITTED":                      false,
+	"COMMON_DATA_MAP":                false,
+	"COMMON":                         false,
+	"COMPACT":                        false,
+	"COMPATIBILITY":                  false,
+	"COMPILE":                        false,
+	"COMPLETE":                       false,
+	"COMPLIANCE":                     false,
+	"COMPONENT":                      false,
+	"COMPONENTS":                     false,
+	"COMPOSE":                        false,
+	"COMPOSITE_LIMIT":                false,
+	"COMPOSITE":                      false,
+	"COMPOUND":                       false,
+	"COMPUTATION":                    false,
+	"COMPUTE":                        false,
+	"CON_DBID_TO_ID":                 false,
+	"CON_GUID_TO_ID":                 false,
+	"CON_ID_FILTER":                  false,
+	"CON_ID_TO_CON_NAME":             false,
+	"CON_ID_TO_DBID":                 false,
+	"CON_ID":                         false,
+	"CON_NAME_TO_ID":                 false,
+	"CON_UID_TO_ID":                  false,
+	"CONCAT":                         false,
+	"CONDITION":                      false,
+	"CONDITIONAL":                    false,
+	"CONFIRM":                        false,
+	"CONFORMING":                     false,
+	"CONNECT_BY_CB_WHR_ONLY":         false,
+	"CONNECT_BY_COMBINE_SW":          false,
+	"CONNECT_BY_COST_BASED":          false,
+	"CONNECT_BY_ELIM_DUPS":           false,
+	"CONNECT_BY_FILTERING":           false,
+	"CONNECT_BY_ISCYCLE":             false,
+	"CONNECT_BY_ISLEAF":              false,
+	"CONNECT_BY_ROOT":                false,
+	"CONNECT_TIME":                   false,
+	"CONSIDER":                       false,
+	"CONSISTENT":                     false,
+	"CONST":                          false,
+	"CONSTANT":                       true,
+	"CONSTRAINT":                     false,
+	"CONSTRAINTS":                    false,
+	"CONTAINER_DATA_ADMIT_NULL":      false,
+	"CONTAINER_DATA":                 false,
+	"CONTAINER_MAP_OBJECT":           false,
+	"CONTAINER_MAP":                  false,
+	"CONTAINER":                      false,
+	"CONTAINERS_DEFAULT":             false,
+	"CONTAINERS":                     false,
+	"CONTENT":                        false,
+	"CONTENTS":                       false,
+	"CONTEXT":                        false,
+	"CONTINUE":                       true,
+	"CONTROLFILE":                    false,
+	"CONVERSION":                     false,
+	"CONVERT":                        false,
+	"COOKIE":                         false,
+	"COPY":                           false,
+	"CORR_K":                         false,
+	"CORR_S":                         false,
+	"CORR":                           false,
+	"CORRUPT_XID_ALL":                false,
+	"CORRUPT_XID":                    false,
+	"CORRUPTION":                     false,
+	"COS":                            false,
+	"COSH":                           false,
+	"COST_XML_QUERY_REWRITE":         false,
+	"COST":                           false,
+	"COUNT":                          false,
+	"COVAR_POP":                      false,
+	"COVAR_SAMP":                     false,
+	"CPU_COSTING":                    false,
+	"CPU_COUNT":                      false,
+	"CPU_PER_CALL":                   false,
+	"CPU_PER_SESSION":                false,
+	"CRASH":                          false,
+	"CREATE_FILE_DEST":               false,
+	"CREATE_STORED_OUTLINES":         false,
+	"CREATION":                       false,
+	"CREDENTIAL":                     false,
+	"CREDENTIALS":                    false,
+	"CRITICAL":                       false,
+	"CROSS":                          true,
+	"CROSSEDITION":                   false,
+	"CSCONVERT":                      false,
+	"CUBE_AJ":                        false,
+	"CUBE_GB":                        false,
+	"CUBE_SJ":                        false,
+	"CUBE":                           false,
+	"CUME_DIST":                      false,
+	"CUME_DISTM":                     false,
+	"CURRENT_DATE":                   false,
+	"CURRENT_INSTANCE":               false,
+	"CURRENT_SCHEMA":                 false,
+	"CURRENT_TIME":                   false,
+	"CURRENT_TIMESTAMP":              false,
+	"CURRENT_USER":                   false,
+	"CURRENT":                        false,
+	"CURRENTV":                       false,
+	"CURSOR_SHARING_EXACT":           false,
+	"CURSOR_SPECIFIC_SEGMENT":        false,
+	"CURSOR":                         false,
+	"CV":                             false,
+	"CYCLE":                          false,
+	"DANGLING":                       false,
+	"DATA_LINK_DML":                  false,
+	"DATA_SECURITY_REWRITE_LIMIT":    false,
+	"DATA_VALIDATE":                  false,
+	"DATA":                           false,
+	"DATABASE":                       false,
+	"DATAFILE":                       false,
+	"DATAFILES":                      false,
+	"DATAMOVEMENT":                   false,
+	"DATAOBJ_TO_MAT_PARTITION":       false,
+	"DATAOBJ_TO_PARTITION":           false,
+	"DATAOBJNO":                      false,
+	"DATAPUMP":                       false,
+	"DATASTORE":                      false,
+	"DATE_MODE":                      false,
+	"DAY":                            false,
+	"DAYS":                           false,
+	"DB_ROLE_CHANGE":                 false,
+	"DB_UNIQUE_NAME":                 false,
+	"DB_VERSION":                     false,
+	"DBA_RECYCLEBIN":                 false,
+	"DBA":                            false,
+	"DBMS_STATS":                     false,
+	"DBTIMEZONE":                     false,
+	"DDL":                            false,
+	"DEALLOCATE":                     false,
+	"DEBUG":                          false,
+	"DEBUGGER":                       false,
+	"DEC":                            false,
+	"DECLARE":                        false,
+	"DECODE":                         false,
+	"DECOMPOSE":                      false,
+	"DECOMPRESS":                     false,
+	"DECORRELATE":                    false,
+	"DECR":                           false,
+	"DECREMENT":                      false,
+	"DECRYPT":                        false,
+	"DEDUPLICATE":                    false,
+	"DEFAULT_COLLATION":              false,
+	"DEFAULT_PDB_HINT":               false,
+	"DEFAULTS":                       false,
+	"DEFERRABLE":                     false,
+	"DEFERRED":                       false,
+	"DEFINE":                         false,
+	"DEFINED":                        false,
+	"DEFINER":                        false,
+	"DEFINITION":                     false,
+	"DEGREE":                         false,
+	"DELAY":                          false,
+	"DELEGATE":                       false,
+	"DELETE_ALL":                     false,
+	"DELETEXML":                      false,
+	"DEMAND":                         false,
+	"DENSE_RANK":                     false,
+	"DENSE_RANKM":                    false,
+	"DEPENDENT":                      false,
+	"DEPTH":                          false,
+	"DEQUEUE":                        false,
+	"DEREF_NO_REWRITE":               false,
+	"DEREF":                          false,
+	"DESCENDANT":                     false,
+	"DESCRIPTION":                    false,
+	"DESTROY":                        false,
+	"DETACHED":                       false,
+	"DETERMINED":                     false,
+	"DETERMINES":                     false,
+	"DETERMINISTIC":                  false,
+	"DG_GATHER_STATS":                false,
+	"DICTIONARY":                     false,
+	"DIGEST":                         false,
+	"DIMENSION":                      false,
+	"DIMENSIONS":                     false,
+	"DIRECT_LOAD":                    false,
+	"DIRECT_PATH":                    false,
+	"DIRECTORY":                      false,
+	"DISABLE_ALL":                    false,
+	"DISABLE_CONTAINER_MAP":          false,
+	"DISABLE_CONTAINERS_DEFAULT":     false,
+	"DISABLE_PARALLEL_DML":           false,
+	"DISABLE_PRESET":                 false,
+	"DISABLE_RPKE":                   false,
+	"DISABLE":                        true,
+	"DISALLOW":                       false,
+	"DISASSOCIATE":                   false,
+	"DISCARD":                        false,
+	"DISCONNECT":                     false,
+	"DISK":                           false,
+	"DISKGROUP":                      false,
+	"DISKS":                          false,
+	"DISMOUNT":                       false,
+	"DIST_AGG_PROLLUP_PUSHDOWN":      false,
+	"DISTINGUISHED":                  false,
+	"DISTRIBUTE":                     false,
+	"DISTRIBUTED":                    false,
+	"DML_UPDATE":                     false,
+	"DML":                            false,
+	"DOCFIDELITY":                    false,
+	"DOCUMENT":                       false,
+	"DOMAIN_INDEX_FILTER":            false,
+	"DOMAIN_INDEX_NO_SORT":           false,
+	"DOMAIN_INDEX_SORT":              false,
+	"DOUBLE":                         false,
+	"DOWNGRADE":                      false,
+	"DRIVING_SITE":                   false,
+	"DROP_COLUMN":                    false,
+	"DROP_GROUP":                     false,
+	"DST_UPGRADE_INSERT_CONV":        false,
+	"DUMP":                           false,
+	"DUPLICATE":                      false,
+	"DUPLICATED":                     false,
+	"DV":                             false,
+	"DYNAMIC_SAMPLING_EST_CDN":       false,
+	"DYNAMIC_SAMPLING":               false,
+	"DYNAMIC":                        false,
+	"EACH":                           false,
+	"EDITION":                        false,
+	"EDITIONABLE":                    false,
+	"EDITIONING":                     false,
+	"EDITIONS":                       false,
+	"ELEMENT":                        false,
+	"ELIM_GROUPBY":                   false,
+	"ELIMINATE_JOIN":                 false,
+	"ELIMINATE_OBY":                  false,
+	"ELIMINATE_OUTER_JOIN":           false,
+	"ELIMINATE_SQ":                   false,
+	"EM":                             false,
+	"EMPTY_BLOB":                     false,
+	"EMPTY_CLOB":                     false,
+	"EMPTY":                          false,
+	"ENABLE_ALL":                     false,
+	"ENABLE_PARALLEL_DML":            false,
+	"ENABLE_PRESET":                  false,
+	"ENABLE":                         true,
+	"ENCODE":                         false,
+	"ENCODING":                       false,
+	"ENCRYPT":                        false,
+	"ENCRYPTION":                     false,
+	"END_OUTLINE_DATA":               false,
+	"END":                            true,
+	"ENFORCE":                        false,
+	"ENFORCED":                       false,
+	"ENQUEUE":                        false,
+	"ENTERPRISE":                     false,
+	"ENTITYESCAPING":                 false,
+	"ENTRY":                          false,
+	"EQUIPART":                       false,
+	"ERROR_ARGUMENT":                 false,
+	"ERROR_ON_OVERLAP_TIME":          false,
+	"ERROR":                          false,
+	"ERRORS":                         false,
+	"ESCAPE":                         false,
+	"ESTIMATE":                       false,
+	"EVAL":                           false,
+	"EVALNAME":                       false,
+	"EVALUATE":                       false,
+	"EVALUATION":                     false,
+	"EVENTS":                         false,
+	"EVERY":                          false,
+	"EXCEPT":                         false,
+	"EXCEPTIONS":                     false,
+	"EXCHANGE":                       false,
+	"EXCLUDE":                        false,
+	"EXCLUDING":                      false,
+	"EXECUTE":                        false,
+	"EXEMPT":                         false,
+	"EXISTING":                       false,
+	"EXISTSNODE":                     false,
+	"EXP":                            false,
+	"EXPAND_GSET_TO_UNION":           false,
+	"EXPAND_TABLE":                   false,
+	"EXPIRE":                         false,
+	"EXPLAIN":                        false,
+	"EXPLOSION":                      false,
+	"EXPORT":                         false,
+	"EXPR_CORR_CHECK":                false,
+	"EXPRESS":                        false,
+	"EXTEND":                         false,
+	"EXTENDED":                       false,
+	"EXTENDS":                        false,
+	"EXTENT":                         false,
+	"EXTENTS":                        false,
+	"EXTERNAL":                       false,
+	"EXTERNALLY":                     false,
+	"EXTRA":                          false,
+	"EXTRACT":                        false,
+	"EXTRACTCLOBXML":                 false,
+	"EXTRACTVALUE":                   false,
+	"FACILITY":                       false,
+	"FACT":                           false,
+	"FACTOR":                         false,
+	"FACTORIZE_JOIN":                 false,
+	"FAILED_LOGIN_ATTEMPTS":          false,
+	"FAILED":                         false,
+	"FAILGROUP":                      false,
+	"FAILOVER":                       false,
+	"FAILURE":                        false,
+	"FALSE":                          false,
+	"FAMILY":                         false,
+	"FAR":                            false,
+	"FAST":                           false,
+	"FBTSCAN":                        false,
+	"FEATURE_COMPARE":                false,
+	"FEATURE_DETAILS":                false,
+	"FEATURE_ID":                     false,
+	"FEATURE_SET":                    false,
+	"FEATURE_VALUE":                  false,
+	"FEATURE":                        false,
+	"FEDERATION":                     false,
+	"FETCH":                          false,
+	"FILE_NAME_CONVERT":              false,
+	"FILE":                           false,
+	"FILEGROUP":                      false,
+	"FILESYSTEM_LIKE_LOGGING":        false,
+	"FILTER":                         false,
+	"FINAL":                          false,
+	"FINE":                           false,
+	"FINISH":                         false,
+	"FIRST_ROWS":                     false,
+	"FIRST_VALUE":                    false,
+	"FIRST":                          false,
+	"FIRSTM":                         false,
+	"FIXED_VIEW_DATA":                false,
+	"FLAGGER":                        false,
+	"FLASH_CACHE":                    false,
+	"FLASHBACK":                      false,
+	"FLEX":                           false,
+	"FLOB":                           false,
+	"FLOOR":                          false,
+	"FLUSH":                          false,
+	"FOLDER":                         false,
+	"FOLLOWING":                      false,
+	"FOLLOWS":                        false,
+	"FORCE_SPATIAL":                  false,
+	"FORCE_XML_QUERY_REWRITE":        false,
+	"FORCE":                          true,
+	"FOREIGN":                        false,
+	"FOREVER":                        false,
+	"FORMAT":                         false,
+	"FORWARD":                        false,
+	"FRAGMENT_NUMBER":                false,
+	"FREE":                           false,
+	"FREELIST":                       false,
+	"FREELISTS":                      false,
+	"FREEPOOLS":                      false,
+	"FRESH_MV":                       false,
+	"FRESH":                          false,
+	"FROM_TZ":                        false,
+	"FULL_OUTER_JOIN_TO_OUTER":       false,
+	"FULL":                           true,
+	"FUNCTION":                       false,
+	"FUNCTIONS":                      false,
+	"GATHER_OPTIMIZER_STATISTICS":    false,
+	"GATHER_PLAN_STATISTICS":         false,
+	"GBY_CONC_ROLLUP":                false,
+	"GBY_PUSHDOWN":                   false,
+	"GENERATED":                      false,
+	"GET":                            false,
+	"GLOBAL_NAME":                    false,
+	"GLOBAL_TOPIC_ENABLED":           false,
+	"GLOBAL":                         false,
+	"GLOBALLY":                       false,
+	"GRANTED":                        false,
+	"GRANULAR":                       false,
+	"GREATEST":                       false,
+	"GROUP_BY":                       false,
+	"GROUP_ID":                       false,
+	"GROUPING_ID":                    false,
+	"GROUPING":                       false,
+	"GROUPS":                         false,
+	"GUARANTEE":                      false,
+	"GUARANTEED":                     false,
+	"GUARD":                          false,
+	"HALF_YEARS":                     false,
+	"HASH_AJ":                        false,
+	"HASH_SJ":                        false,
+	"HASH":                           false,
+	"HASHKEYS":                       false,
+	"HEADER":                         false,
+	"HEAP":                           false,
+	"HELP":                           false,
+	"HEXTORAW":                       false,
+	"HEXTOREF":                       false,
+	"HIDDEN":                         false,
+	"HIDE":                           false,
+	"HIER_ANCESTOR":                  false,
+	"HIER_CAPTION":                   false,
+	"HIER_CHILD_COUNT":               false,
+	"HIER_COLUMN":                    false,
+	"HIER_DEPTH":                     false,
+	"HIER_DESCRIPTION":               false,
+	"HIER_HAS_CHILDREN":              false,
+	"HIER_LAG":                       false,
+	"HIER_LEAD":                      false,
+	"HIER_LEVEL":                     false,
+	"HIER_MEMBER_NAME":               false,
+	"HIER_MEMBER_UNIQUE_NAME":        false,
+	"HIER_ORDER":                     false,
+	"HIER_PARENT":                    false,
+	"HIER_WINDOW":                    false,
+	"HIERARCHICAL":                   false,
+	"HIERARCHIES":                    false,
+	"HIERARCHY":                      false,
+	"HIGH":                           false,
+	"HINTSET_BEGIN":                  false,
+	"HINTSET_END":                    false,
+	"HOST":                           false,
+	"HOT":                            false,
+	"HOUR":                           false,
+	"HOURS":                          false,
+	"HTTP":                           false,
+	"HWM_BROKERED":                   false,
+	"HYBRID":                         false,
+	"ID":                             false,
+	"IDENTIFIER":                     false,
+	"IDENTITY":                       false,
+	"IDGENERATORS":                   false,
+	"IDLE_TIME":                      false,
+	"IF":                             true,
+	"IGNORE_OPTIM_EMBEDDED_HINTS":    false,
+	"IGNORE_ROW_ON_DUPKEY_INDEX":     false,
+	"IGNORE_WHERE_CLAUSE":            false,
+	"IGNORE":                         false,
+	"ILM":                            false,
+	"IMMEDIATE":                      false,
+	"IMPACT":                         false,
+	"IMPORT":                         false,
+	"IN_MEMORY_METADATA":             false,
+	"IN_XQUERY":                      false,
+	"INACTIVE_ACCOUNT_TIME":          false,
+	"INACTIVE":                       false,
+	"INCLUDE_VERSION":                false,
+	"INCLUDE":                        false,
+	"INCLUDES":                       false,
+	"INCLUDING":                      false,
+	"INCR":                           false,
+	"INCREMENT":                      false,
+	"INCREMENTAL":                    false,
+	"INDENT":                         false,
+	"INDEX_ASC":                      false,
+	"INDEX_COMBINE":                  false,
+	"INDEX_DESC":                     false,
+	"INDEX_FFS":                      false,
+	"INDEX_FILTER":                   false,
+	"INDEX_JOIN":                     false,
+	"INDEX_ROWS":                     false,
+	"INDEX_RRS":                      false,
+	"INDEX_RS_ASC":                   false,
+	"INDEX_RS_DESC":                  false,
+	"INDEX_RS":                       false,
+	"INDEX_SCAN":                     false,
+	"INDEX_SKIP_SCAN":                false,
+	"INDEX_SS_ASC":                   false,
+	"INDEX_SS_DESC":                  false,
+	"INDEX_SS":                       false,
+	"INDEX_STATS":                    false,
+	"INDEXED":                        false,
+	"INDEXES":                        false,
+	"INDEXING":                       false,
+	"INDEXTYPE":                      false,
+	"INDEXTYPES":                     false,
+	"INDICATOR":                      false,
+	"INFINITE":                       false,
+	"INFORMATIONAL":                  false,
+	"INHERIT":                        false,
+	"INITCAP":                        false,
+	"INITIAL":                        false,
+	"INITIALIZED":                    false,
+	"INITIALLY":                      false,
+	"INITRANS":                       false,
+	"INLINE_XMLTYPE_NT":              false,
+	"INLINE_XT":                      false,
+	"INLINE":                         false,
+	"INMEMORY_PRUNING":               false,
+	"INMEMORY":                       false,
+	"INNER":                          true,
+	"INPLACE":                        false,
+	"INSERTCHILDXML":                 false,
+	"INSERTCHILDXMLAFTER":            false,
+	"INSERTCHILDXMLBEFORE":           false,
+	"INSERTXMLAFTER":                 false,
+	"INSERTXMLBEFORE":                false,
+	"INSTALL":                        false,
+	"INSTANCE":                       false,
+	"INSTANCES":                      false,
+	"INSTANTIABLE":                   false,
+	"INSTANTLY":                      false,
+	"INSTEAD":                        false,
+	"INSTR":                          false,
+	"INSTR2":                         false,
+	"INSTR4":                         false,
+	"INSTRB":                         false,
+	"INSTRC":                         false,
+	"INT":                            false,
+	"INTERLEAVED":                    false,
+	"INTERMEDIATE":                   false,
+	"INTERNAL_CONVERT":               false,
+	"INTERNAL_USE":                   false,
+	"INTERPRETED":                    false,
+	"INTERVAL":                       false,
+	"INTRA_CDB":                      false,
+	"INVALIDATE":                     false,
+	"INVALIDATION":                   false,
+	"INVISIBLE":                      false,
+	"IOSEEKTIM":                      false,
+	"IOTFRSPEED":                     false,
+	"ISOLATE":                        false,
+	"ISOLATION_LEVEL":                false,
+	"ISOLATION":                      false,
+	"ITERATE":                        false,
+	"ITERATION_NUMBER":               false,
+	"JAVA":                           false,
+	"JOB":                            false,
+	"JOIN":                           true,
+	"JSON_ARRAY":                     false,
+	"JSON_ARRAYAGG":                  false,
+	"JSON_EQUAL":                     false,
+	"JSON_EXISTS":                    false,
+	"JSON_EXISTS2":                   false,
+	"JSON_MERGEPATCH":                false,
+	"JSON_OBJECT":                    false,
+	"JSON_OBJECTAGG":                 false,
+	"JSON_PATCH":                     false,
+	"JSON_QUERY":                     false,
+	"JSON_TABLE":                     false,
+	"JSON_TEXTCONTAINS":              false,
+	"JSON_TEXTCONTAINS2":             false,
+	"JSON_TRANSFORM":                 false,
+	"JSON_VALUE":                     false,
+	"JSON":                           false,
+	"JSONGET":                        false,
+	"JSONPARSE":                      false,
+	"JSONTOXML":                      false,
+	"KEEP_DUPLICATES":                false,
+	"KEEP":                           false,
+	"KERBEROS":                       false,
+	"KEY_LENGTH":                     false,
+	"KEY":                            false,
+	"KEYS":                           false,
+	"KEYSIZE":                        false,
+	"KEYSTORE":                       false,
+	"KILL":                           false,
+	"LABEL":                          false,
+	"LAG_DIFF_PERCENT":               false,
+	"LAG_DIFF":                       false,
+	"LAG":                            false,
+	"LANGUAGE":                       false,
+	"LAST_DAY":                       false,
+	"LAST_VALUE":                     false,
+	"LAST":                           false,
+	"LATERAL":                        false,
+	"LAX":                            false,
+	"LAYER":                          false,
+	"LDAP_REG_SYNC_INTERVAL":         false,
+	"LDAP_REGISTRATION_ENABLED":      false,
+	"LDAP_REGISTRATION":              false,
+	"LEAD_CDB_URI":                   false,
+	"LEAD_CDB":                       false,
+	"LEAD_DIFF_PERCENT":              false,
+	"LEAD_DIFF":                      false,
+	"LEAD":                           false,
+	"LEADING":                        false,
+	"LEAF":                           false,
+	"LEAST":                          false,
+	"LEAVES":                         false,
+	"LEFT":                           false,
+	"LENGTH":                         false,
+	"LENGTH2":                        false,
+	"LENGTH4":                        false,
+	"LENGTHB":                        false,
+	"LENGTHC":                        false,
+	"LESS":                           false,
+	"LEVEL":                          false,
+	"LEVELS":                         false,
+	"LIBRARY":                        false,
+	"LIFE":                           false,
+	"LIFECYCLE":                      false,
+	"LIFETIME":                       false,
+	"LIKE2":                          false,
+	"LIKE4":                          false,
+	"LIKEC":                          false,
+	"LIMIT":                          false,
+	"LINEAR":                         false,
+	"LINK":                           false,
+	"LIST":                           false,
+	"LISTAGG":                        false,
+	"LN":                             false,
+	"LNNVL":                          false,
+	"LOAD":                           false,
+	"LOB":                            false,
+	"LOBNVL":                         false,
+	"LOBS":                           false,
+	"LOCAL_INDEXES":                  false,
+	"LOCAL":                          false,
+	"LOCALTIME":                      false,
+	"LOCALTIMESTAMP":                 false,
+	"LOCATION":                       false,
+	"LOCATOR":                        false,
+	"LOCKDOWN":                       false,
+	"LOCKED":                         false,
+	"LOCKING":                        false,
+	"LOG_READ_ONLY_VIOLATIONS":       false,
+	"LOG":                            false,
+	"LOGFILE":                        false,
+	"LOGFILES":                       false,
+	"LOGGING":                        false,
+	"LOGICAL_READS_PER_CALL":         false,
+	"LOGICAL_READS_PER_SESSION":      false,
+	"LOGICAL":                        false,
+	"LOGMINING":                      false,
+	"LOGOFF":                         false,
+	"LOGON":                          false,
+	"LOST":                           false,
+	"LOW":                            false,
+	"LOWER":                          false,
+	"LPAD":                           false,
+	"LTRIM":                          false,
+	"MAIN":                           false,
+	"MAKE_REF":                       false,
+	"MANAGE":                         false,
+	"MANAGED":                        false,
+	"MANAGEMENT":                     false,
+	"MANAGER":                        false,
+	"MANUAL":                         false,
+	"MAP":                            false,
+	"MAPPING":                        false,
+	"MASTER":                         false,
+	"MATCH_NUMBER":                   false,
+	"MATCH_RECOGNIZE":                false,
+	"MATCH":                          false,
+	"MATCHED":                        true,
+	"MATCHES":                        false,
+	"MATERIALIZE":                    false,
+	"MATERIALIZED":                   true,
+	"MATRIX":                         false,
+	"MAX_AUDIT_SIZE":                 false,
+	"MAX_DIAG_SIZE":                  false,
+	"MAX_PDB_SNAPSHOTS":              false,
+	"MAX_SHARED_TEMP_SIZE":           false,
+	"MAX":                            false,
+	"MAXARCHLOGS":                    false,
+	"MAXDATAFILES":                   false,
+	"MAXEXTENTS":                     false,
+	"MAXIMIZE":                       false,
+	"MAXINSTANCES":                   false,
+	"MAXLOGFILES":                    false,
+	"MAXLOGHISTORY":                  false,
+	"MAXLOGMEMBERS":                  false,
+	"MAXSIZE":                        false,
+	"MAXTRANS":                       false,
+	"MAXVALUE":                       false,
+	"MBRC":                           false,
+	"MEASURE":                        false,
+	"MEASURES":                       false,
+	"MEDIAN":                         false,
+	"MEDIUM":                         false,
+	"MEMBER":                         false,
+	"MEMCOMPRESS":                    false,
+	"MEMOPTIMIZE_WRITE":              false,
+	"MEMOPTIMIZE":                    false,
+	"MEMORY":                         false,
+	"MERGE_AJ":                       false,
+	"MERGE_CONST_ON":                 false,
+	"MERGE_SJ":                       false,
+	"MERGE":                          true,
+	"MERGE$ACTIONS":                  false,
+	"METADATA_SOURCE_PDB":            false,
+	"METADATA":                       false,
+	"METHOD":                         false,
+	"MIGRATE_CROSS_CON":              false,
+	"MIGRATE":                        false,
+	"MIGRATION":                      false,
+	"MIN":                            false,
+	"MINEXTENTS":                     false,
+	"MINIMIZE":                       false,
+	"MINIMUM":                        false,
+	"MINING":                         false,
+	"MINUS_NULL":                     false,
+	"MINUTE":                         false,
+	"MINUTES":                        false,
+	"MINVALUE":                       false,
+	"MIRROR":                         false,
+	"MIRRORCOLD":                     false,
+	"MIRRORHOT":                      false,
+	"MLSLABEL":                       false,
+	"MOD":                            false,
+	"MODEL_COMPILE_SUBQUERY":         false,
+	"MODEL_DONTVERIFY_UNIQUENESS":    false,
+	"MODEL_DYNAMIC_SUBQUERY":         false,
+	"MODEL_MIN_ANALYSIS":             false,
+	"MODEL_NB":                       false,
+	"MODEL_NO_ANALYSIS":              false,
+	"MODEL_PBY":                      false,
+	"MODEL_PUSH_REF":                 false,
+	"MODEL_SV":                       false,
+	"MODEL":                          false,
+	"MODIFICATION":                   false,
+	"MODIFY_COLUMN_TYPE":             false,
+	"MODIFY":                         true,
+	"MODULE":                         false,
+	"MONITOR":                        false,
+	"MONITORING":                     false,
+	"MONTH":                          false,
+	"MONTHS_BETWEEN":                 false,
+	"MONTHS":                         false,
+	"MOUNT":                          false,
+	"MOUNTPATH":                      false,
+	"MOVE":                           false,
+	"MOVEMENT":                       false,
+	"MULTIDIMENSIONAL":               false,
+	"MULTISET":                       false,
+	"MV_MERGE":                       false,
+	"NAME":                           false,
+	"NAMED":                          false,
+	"NAMESPACE":                      false,
+	"NAN":                            false,
+	"NANVL":                          false,
+	"NATIONAL":                       false,
+	"NATIVE_FULL_OUTER_JOIN":         false,
+	"NATIVE":                         false,
+	"NATURAL":                        true,
+	"NAV":                            false,
+	"NCHAR_CS":                       false,
+	"NCHAR":                          false,
+	"NCHR":                           false,
+	"NCLOB":                          false,
+	"NEEDED":                         false,
+	"NEG":                            false,
+	"NESTED_TABLE_FAST_INSERT":       false,
+	"NESTED_TABLE_GET_REFS":          false,
+	"NESTED_TABLE_ID":                false,
+	"NESTED_TABLE_SET_REFS":          false,
+	"NESTED_TABLE_SET_SETID":         false,
+	"NESTED":                         false,
+	"NETWORK":                        false,
+	"NEVER":                          false,
+	"NEW_TIME":                       false,
+	"NEW":                            false,
+	"NEXT_DAY":                       false,
+	"NEXT":                           true,
+	"NL_AJ":                          false,
+	"NL_SJ":                          false,
+	"NLJ_BATCHING":                   false,
+	"NLJ_INDEX_FILTER":               false,
+	"NLJ_INDEX_SCAN":                 false,
+	"NLJ_PREFETCH":                   false,
+	"NLS_CALENDAR":                   false,
+	"NLS_CHARACTERSET":               false,
+	"NLS_CHARSET_DECL_LEN":           false,
+	"NLS_CHARSET_ID":                 false,
+	"NLS_CHARSET_NAME":               false,
+	"NLS_COLLATION_ID":               false,
+	"NLS_COLLATION_NAME":             false,
+	"NLS_COMP":                       false,
+	"NLS_CURRENCY":                   false,
+	"NLS_DATE_FORMAT":                false,
+	"NLS_DATE_LANGUAGE":              false,
+	"NLS_INITCAP":                    false,
+	"NLS_ISO_CURRENCY":               false,
+	"NLS_LANG":                       false,
+	"NLS_LANGUAGE":                   false,
+	"NLS_LENGTH_SEMANTICS":           false,
+	"NLS_LOWER":                      false,
+	"NLS_NCHAR_CONV_EXCP":            false,
+	"NLS_NUMERIC_CHARACTERS":         false,
+	"NLS_SORT":                       false,
+	"NLS_SPECIAL_CHARS":              false,
+	"NLS_TERRITORY":                  false,
+	"NLS_UPPER":                      false,
+	"NLSSORT":                        false,
+	"NO_ACCESS":                      false,
+	"NO_ADAPTIVE_PLAN":               false,
+	"NO_ANSI_REARCH":                 false,
+	"NO_ANSWER_QUERY_USING_STATS":    false,
+	"NO_AUTO_REOPTIMIZE":             false,
+	"NO_BAND_JOIN":                   false,
+	"NO_BASETABLE_MULTIMV_REWRITE":   false,
+	"NO_BATCH_TABLE_ACCESS_BY_ROWID": false,
+	"NO_BIND_AWARE":                  false,
+	"NO_BUFFER":                      false,
+	"NO_BUSHY_JOIN":                  false,
+	"NO_CARTESIAN":                   false,
+	"NO_CHECK_ACL_REWRITE":           false,
+	"NO_CLUSTER_BY_ROWID":            false,
+	"NO_CLUSTERING":                  false,
+	"NO_COALESCE_SQ":                 false,
+	"NO_COMMON_DATA":                 false,
+	"NO_CONNECT_BY_CB_WHR_ONLY":      false,
+	"NO_CONNECT_BY_COMBINE_SW":       false,
+	"NO_CONNECT_BY_COST_BASED":       false,
+	"NO_CONNECT_BY_ELIM_DUPS":        false,
+	"NO_CONNECT_BY_FILTERING":        false,
+	"NO_CONTAINERS":                  false,
+	"NO_COST_XML_QUERY_REWRITE":      false,
+	"NO_CPU_COSTING":                 false,
+	"NO_CROSS_CONTAINER":             false,
+	"NO_DATA_SECURITY_REWRITE":       false,
+	"NO_DECORRELATE":                 false,
+	"NO_DIST_AGG_PROLLUP_PUSHDOWN":   false,
+	"NO_DOMAIN_INDEX_FILTER":         false,
+	"NO_DST_UPGRADE_INSERT_CONV":     false,
+	"NO_ELIM_GROUPBY":                false,
+	"NO_ELIMINATE_JOIN":              false,
+	"NO_ELIMINATE_OBY":               false,
+	"NO_ELIMINATE_OUTER_JOIN":        false,
+	"NO_ELIMINATE_SQ":                false,
+	"NO_EXPAND_GSET_TO_UNION":        false,
+	"NO_EXPAND_TABLE":                false,
+	"NO_EXPAND":                      false,
+	"NO_FACT":                        false,
+	"NO_FACTORIZE_JOIN":              false,
+	"NO_FILTERING":                   false,
+	"NO_FULL_OUTER_JOIN_TO_OUTER":    false,
+	"NO_GATHER_OPTIMIZER_STATISTICS": false,
+	"NO_GBY_PUSHDOWN":                false,
+	"NO_INDEX_FFS":                   false,
+	"NO_INDEX_SS":                    false,
+	"NO_INDEX":                       false,
+	"NO_INMEMORY_PRUNING":            false,
+	"NO_INMEMORY":                    false,
+	"NO_LOAD":                        false,
+	"NO_MERGE":                       false,
+	"NO_MODEL_PUSH_REF":              false,
+	"NO_MONITOR":                     false,
+	"NO_MONITORING":                  false,
+	"NO_MULTIMV_REWRITE":             false,
+	"NO_NATIVE_FULL_OUTER_JOIN":      false,
+	"NO_NLJ_BATCHING":                false,
+	"NO_NLJ_PREFETCH":                false,
+	"NO_OBJECT_LINK":                 false,
+	"NO_OR_EXPAND":                   false,
+	"NO_ORDER_ROLLUPS":               false,
+	"NO_OUTER_JOIN_TO_ANTI":          false,
+	"NO_OUTER_JOIN_TO_INNER":         false,
+	"NO_PARALLEL_INDEX":              false,
+	"NO_PARALLEL":                    false,
+	"NO_PARTIAL_COMMIT":              false,
+	"NO_PARTIAL_JOIN":                false,
+	"NO_PARTIAL_ROLLUP_PUSHDOWN":     false,
+	"NO_PLACE_DISTINCT":              false,
+	"NO_PLACE_GROUP_BY":              false,
+	"NO_PQ_CONCURRENT_UNION":         false,
+	"NO_PQ_MAP":                      false,
+	"NO_PQ_REPLICATE":                false,
+	"NO_PQ_SKEW":                     false,
+	"NO_PRUNE_GSETS":                 false,
+	"NO_PULL_PRED":                   false,
+	"NO_PUSH_HAVING_TO_GBY":          false,
+	"NO_PUSH_PRED":                   false,
+	"NO_PUSH_SUBQ":                   false,
+	"NO_PX_FAULT_TOLERANCE":          false,
+	"NO_PX_JOIN_FILTER":              false,
+	"NO_QKN_BUFF":                    false,
+	"NO_QUERY_TRANSFORMATION":        false,
+	"NO_REF_CASCADE":                 false,
+	"NO_REORDER_WIF":                 false,
+	"NO_RESULT_CACHE":                false,
+	"NO_REWRITE":                     false,
+	"NO_ROOT_SW_FOR_LOCAL":           false,
+	"NO_SEMI_TO_INNER":               false,
+	"NO_SEMIJOIN":                    false,
+	"NO_SET_TO_JOIN":                 false,
+	"NO_SQL_TRANSLATION":             false,
+	"NO_SQL_TUNE":                    false,
+	"NO_STAR_TRANSFORMATION":         false,
+	"NO_STATEMENT_QUEUING":           false,
+	"NO_STATS_GSETS":                 false,
+	"NO_SUBQUERY_PRUNING":            false,
+	"NO_SUBSTRB_PAD":                 false,
+	"NO_SWAP_JOIN_INPUTS":            false,
+	"NO_TABLE_LOOKUP_BY_NL":          false,
+	"NO_TEMP_TABLE":                  false,
+	"NO_TRANSFORM_DISTINCT_AGG":      false,
+	"NO_UNNEST":                      false,
+	"NO_USE_CUBE":                    false,
+	"NO_USE_DAGG_UNION_ALL_GSETS":    false,
+	"NO_USE_HASH_AGGREGATION":        false,
+	"NO_USE_HASH_GBY_FOR_DAGGPSHD":   false,
+	"NO_USE_HASH_GBY_FOR_PUSHDOWN":   false,
+	"NO_USE_HASH":                    false,
+	"NO_USE_INVISIBLE_INDEXES":       false,
+	"NO_USE_MERGE":                   false,
+	"NO_USE_NL":                      false,
+	"NO_USE_PARTITION_WISE_DISTINCT": false,
+	"NO_USE_PARTITION_WISE_GBY":      false,
+	"NO_USE_PARTITION_WISE_WIF":      false,
+	"NO_USE_VECTOR_AGGREGATION":      false,
+	"NO_VECTOR_TRANSFORM_DIMS":       false,
+	"NO_VECTOR_TRANSFORM_FACT":       false,
+	"NO_VECTOR_TRANSFORM":            false,
+	"NO_XDB_FASTPATH_INSERT":         false,
+	"NO_XML_DML_REWRITE":             false,
+	"NO_XML_QUERY_REWRITE":           false,
+	"NO_XMLINDEX_REWRITE_IN_SELECT":  false,
+	"NO_XMLINDEX_REWRITE":            false,
+	"NO_ZONEMAP":                     false,
+	"NO":                             false,
+	"NOAPPEND":                       false,
+	"NOARCHIVELOG":                   false,
+	"NOAUDIT":                        false,
+	"NOCACHE":                        false,
+	"NOCOPY":                         false,
+	"NOCPU_COSTING":                  false,
+	"NOCYCLE":                        false,
+	"NODELAY":                        false,
+	"NOENTITYESCAPING":               false,
+	"NOEXTEND":                       false,
+	"NOFORCE":                        false,
+	"NOGUARANTEE":                    false,
+	"NOKEEP":                         false,
+	"NOLOCAL":                        false,
+	"NOLOGGING":                      false,
+	"NOMAPPING":                      false,
+	"NOMAXVALUE":                     false,
+	"NOMINIMIZE":                     false,
+	"NOMINVALUE":                     false,
+	"NOMONITORING":                   false,
+	"NONBLOCKING":                    false,
+	"NONE":                           false,
+	"NONEDITIONABLE":                 false,
+	"NONPARTITIONED":                 false,
+	"NONSCHEMA":                      false,
+	"NOORDER":                        false,
+	"NOOVERRIDE":                     false,
+	"NOPARALLEL_INDEX":               false,
+	"NOPARALLEL":                     false,
+	"NORELOCATE":                     false,
+	"NORELY":                         false,
+	"NOREPAIR":                       false,
+	"NOREPLAY":                       false,
+	"NORESETLOGS":                    false,
+	"NOREVERSE":                      false,
+	"NOREWRITE":                      false,
+	"NORMAL":                         false,
+	"NOROWDEPENDENCIES":              false,
+	"NOSCALE":                        false,
+	"NOSCHEMACHECK":                  false,
+	"NOSEGMENT":                      false,
+	"NOSORT":                         false,
+	"NOSTRICT":                       false,
+	"NOSWITCH":                       false,
+	"NOTHING":                        false,
+	"NOTIFICATION":                   false,
+	"NOVALIDATE":                     false,
+	"NOW":                            false,
+	"NTH_VALUE":                      false,
+	"NTILE":                          false,
+	"NULLIF":                         false,
+	"NULLS":                          false,
+	"NUM_INDEX_KEYS":                 false,
+	"NUMERIC":                        false,
+	"NUMTODSINTERVAL":                false,
+	"NUMTOYMINTERVAL":                false,
+	"NVARCHAR2":                      false,
+	"NVL":                            false,
+	"NVL2":                           false,
+	"OBJ_ID":                         false,
+	"OBJECT":                         false,
+	"OBJECT2XML":                     false,
+	"OBJNO_REUSE":                    false,
+	"OBJNO":                          false,
+	"OCCURENCES":                     false,
+	"OCCURRENCES":                    false,
+	"OFF":                            false,
+	"OFFLINE":                        false,
+	"OFFSET":                         false,
+	"OID":                            false,
+	"OIDINDEX":                       false,
+	"OLAP":                           false,
+	"OLD_PUSH_PRED":                  false,
+	"OLD":                            false,
+	"OLS":                            false,
+	"OLTP":                           false,
+	"OMIT":                           false,
+	"ONE":                            false,
+	"ONLINE":                         false,
+	"ONLY":                           false,
+	"OPAQUE_TRANSFORM":               false,
+	"OPAQUE_XCANONICAL":              false,
+	"OPAQUE":                         false,
+	"OPCODE":                         false,
+	"OPEN":                           false,
+	"OPERATIONS":                     false,
+	"OPERATOR":                       false,
+	"OPT_ESTIMATE":                   false,
+	"OPT_PARAM":                      false,
+	"OPTIMAL":                        false,
+	"OPTIMIZE":                       false,
+	"OPTIMIZER_FEATURES_ENABLE":      false,
+	"OPTIMIZER_GOAL":                 false,
+	"OR_EXPAND":                      false,
+	"OR_PREDICATES":                  false,
+	"ORA_BRANCH":                     false,
+	"ORA_CHECK_ACL":                  false,
+	"ORA_CHECK_PRIVILEGE":            false,
+	"ORA_CHECK_SYS_PRIVILEGE":        false,
+	"ORA_CLUSTERING":                 false,
+	"ORA_CONCAT_RWKEY":               false,
+	"ORA_DM_PARTITION_NAME":          false,
+	"ORA_DST_AFFECTED":               false,
+	"ORA_DST_CONVERT":                false,
+	"ORA_DST_ERROR":                  false,
+	"ORA_GET_ACLIDS":                 false,
+	"ORA_GET_PRIVILEGES":             false,
+	"ORA_HASH":                       false,
+	"ORA_INVOKING_USER":              false,
+	"ORA_INVOKING_USERID":            false,
+	"ORA_INVOKING_XS_USER_GUID":      false,
+	"ORA_INVOKING_XS_USER":           false,
+	"ORA_PARTITION_VALIDATION":       false,
+	"ORA_RAWCOMPARE":                 false,
+	"ORA_RAWCONCAT":                  false,
+	"ORA_ROWSCN_RAW":                 false,
+	"ORA_ROWSCN":                     false,
+	"ORA_ROWVERSION":                 false,
+	"ORA_SEARCH_RWKEY":               false,
+	"ORA_SHARD_ID":                   false,
+	"ORA_TABVERSION":                 false,
+	"ORA_WRITE_TIME":                 false,
+	"ORADEBUG":                       false,
+	"ORDER_SUBQ":                     false,
+	"ORDERED_PREDICATES":             false,
+	"ORDERED":                        false,
+	"ORDINALITY":                     false,
+	"ORGANIZATION":                   false,
+	"OSON":                           false,
+	"OTHER":                          false,
+	"OUT_OF_LINE":                    false,
+	"OUTER_JOIN_TO_ANTI":             false,
+	"OUTER_JOIN_TO_INNER":            false,
+	"OUTER":                          false,
+	"OUTLINE_LEAF":                   false,
+	"OUTLINE":                        false,
+	"OVER":                           false,
+	"OVERFLOW_NOMOVE":                false,
+	"OVERFLOW":                       false,
+	"OVERLAPS":                       false,
+	"OWN":                            false,
+	"OWNER":                          false,
+	"OWNERSHIP":                      false,
+	"PACKAGE":                        false,
+	"PACKAGES":                       false,
+	"PARALLEL_INDEX":                 false,
+	"PARALLEL":                       false,
+	"PARAM":                          false,
+	"PARAMETERS":                     false,
+	"PARENT":                         false,
+	"PARITY":                         false,
+	"PART$NUM$INST":                  false,
+	"PARTIAL_JOIN":                   false,
+	"PARTIAL_ROLLUP_PUSHDOWN":        false,
+	"PARTIAL":                        false,
+	"PARTIALLY":                      false,
+	"PARTITION_CONTAINED":            false,
+	"PARTITION_HASH":                 false,
+	"PARTITION_LIST":                 false,
+	"PARTITION_RANGE":                false,
+	"PARTITION":                      false,
+	"PARTITIONING":                   false,
+	"PARTITIONS":                     false,
+	"PARTITIONSET":                   false,
+	"PASSING":                        false,
+	"PASSWORD_GRACE_TIME":            false,
+	"PASSWORD_LIFE_TIME":             false,
+	"PASSWORD_LOCK_TIME":             false,
+	"PASSWORD_REUSE_MAX":             false,
+	"PASSWORD_REUSE_TIME":            false,
+	"PASSWORD_VERIFY_FUNCTION":       false,
+	"PASSWORD":                       false,
+	"PAST":                           false,
+	"PATCH":                          false,
+	"PATH_PREFIX":                    false,
+	"PATH":                           false,
+	"PATHS":                          false,
+	"PATTERN":                        false,
+	"PBL_HS_BEGIN":                   false,
+	"PBL_HS_END":                     false,
+	"PCTINCREASE":                    false,
+	"PCTTHRESHOLD":                   false,
+	"PCTUSED":                        false,
+	"PCTVERSION":                     false,
+	"PDB_LOCAL_ONLY":                 false,
+	"PENDING":                        false,
+	"PER":                            false,
+	"PERCENT_RANK":                   false,
+	"PERCENT_RANKM":                  false,
+	"PERCENT":                        false,
+	"PERCENTILE_CONT":                false,
+	"PERCENTILE_DISC":                false,
+	"PERFORMANCE":                    false,
+	"PERIOD":                         false,
+	"PERMANENT":                      false,
+	"PERMISSION":                     false,
+	"PERMUTE":                        false,
+	"PERSISTABLE":                    false,
+	"PFILE":                          false,
+	"PHYSICAL":                       false,
+	"PIKEY":                          false,
+	"PIV_GB":                         false,
+	"PIV_SSF":                        false,
+	"PIVOT":                          true,
+	"PLACE_DISTINCT":                 false,
+	"PLACE_GROUP_BY":                 false,
+	"PLAN":                           false,
+	"PLSCOPE_SETTINGS":               false,
+	"PLSQL_CCFLAGS":                  false,
+	"PLSQL_CODE_TYPE":                false,
+	"PLSQL_DEBUG":                    false,
+	"PLSQL_OPTIMIZE_LEVEL":           false,
+	"PLSQL_WARNINGS":                 false,
+	"PLUGGABLE":                      false,
+	"POINT":                          false,
+	"POLICY":                         false,
+	"POOL_16K":                       false,
+	"POOL_2K":                        false,
+	"POOL_32K":                       false,
+	"POOL_4K":                        false,
+	"POOL_8K":                        false,
+	"PORT":                           false,
+	"POSITION":                       false,
+	"POST_TRANSACTION":               false,
+	"POWER":                          false,
+	"POWERMULTISET_BY_CARDINALITY":   false,
+	"POWERMULTISET":                  false,
+	"PQ_CONCURRENT_UNION":            false,
+	"PQ_DISTRIBUTE_WINDOW":           false,
+	"PQ_DISTRIBUTE":                  false,
+	"PQ_FILTER":                      false,
+	"PQ_MAP":                         false,
+	"PQ_NOMAP":                       false,
+	"PQ_REPLICATE":                   false,
+	"PQ_SKEW":                        false,
+	"PREBUILT":                       false,
+	"PRECEDES":                       false,
+	"PRECEDING":                      false,
+	"PRECISION":                      false,
+	"PRECOMPUTE_SUBQUERY":            false,
+	"PREDICATE_REORDERS":             false,
+	"PREDICTION_BOUNDS":              false,
+	"PREDICTION_COST":                false,
+	"PREDICTION_DETAILS":             false,
+	"PREDICTION_PROBABILITY":         false,
+	"PREDICTION_SET":                 false,
+	"PREDICTION":                     false,
+	"PRELOAD":                        false,
+	"PREPARE":                        false,
+	"PRESENT":                        false,
+	"PRESENTNNV":                     false,
+	"PRESENTV":                       false,
+	"PRESERVE_OID":                   false,
+	"PRESERVE":                       false,
+	"PRETTY":                         false,
+	"PREV":                           false,
+	"PREVIOUS":                       false,
+	"PRIMARY":                        false,
+	"PRINTBLOBTOCLOB":                false,
+	"PRIORITY":                       false,
+	"PRIVATE_SGA":                    false,
+	"PRIVATE":                        false,
+	"PRIVILEGE":                      false,
+	"PRIVILEGED":                     false,
+	"PRIVILEGES":                     false,
+	"PROCEDURAL":                     false,
+	"PROCEDURE":                      false,
+	"PROCESS":                        false,
+	"PROFILE":                        false,
+	"PROGRAM":                        false,
+	"PROJECT":                        false,
+	"PROPAGATE":                      false,
+	"PROPERTY":                       false,
+	"PROTECTED":                      false,
+	"PROTECTION":                     false,
+	"PROXY":                          false,
+	"PRUNING":                        false,
+	"PULL_PRED":                      false,
+	"PURGE":                          false,
+	"PUSH_HAVING_TO_GBY":             false,
+	"PUSH_PRED":                      false,
+	"PUSH_SUBQ":                      false,
+	"PX_FAULT_TOLERANCE":             false,
+	"PX_GRANULE":                     false,
+	"PX_JOIN_FILTER":                 false,
+	"QB_NAME":                        false,
+	"QUALIFY":                        false,
+	"QUARTERS":                       false,
+	"QUERY_BLOCK":                    false,
+	"QUERY":                          false,
+	"QUEUE_CURR":                     false,
+	"QUEUE_ROWP":                     false,
+	"QUEUE":                          false,
+	"QUIESCE":                        false,
+	"QUORUM":                         false,
+	"QUOTA":                          false,
+	"QUOTAGROUP":                     false,
+	"RANDOM_LOCAL":                   false,
+	"RANDOM":                         false,
+	"RANGE":                          false,
+	"RANK":                           false,
+	"RANKM":                          false,
+	"RAPIDLY":                        false,
+	"RATIO_TO_REPORT":                false,
+	"RAWTOHEX":                       false,
+	"RAWTONHEX":                      false,
+	"RAWTOREF":                       false,
+	"RBA":                            false,
+	"RBO_OUTLINE":                    false,
+	"RDBA":                           false,
+	"READ_OR_WRITE":                  false,
+	"READ":                           false,
+	"READS":                          false,
+	"REAL":                           false,
+	"REALM":                          false,
+	"REBALANCE":                      false,
+	"REBUILD":                        false,
+	"RECONNECT":                      false,
+	"RECORDS_PER_BLOCK":              false,
+	"RECOVER":                        false,
+	"RECOVERABLE":                    false,
+	"RECOVERY":                       false,
+	"RECYCLE":                        false,
+	"RECYCLEBIN":                     false,
+	"REDACTION":                      false,
+	"REDEFINE":                       false,
+	"REDO":                           false,
+	"REDUCED":                        false,
+	"REDUNDANCY":                     false,
+	"REF_CASCADE_CURSOR":             false,
+	"REF":                            false,
+	"REFERENCE":                      false,
+	"REFERENCED":                     false,
+	"REFERENCES":                     false,
+	"REFERENCING":                    false,
+	"REFRESH":                        false,
+	"REFTOHEX":                       false,
+	"REFTORAW":                       false,
+	"REGEXP_COUNT":                   false,
+	"REGEXP_INSTR":                   false,
+	"REGEXP_LIKE":                    false,
+	"REGEXP_REPLACE":                 false,
+	"REGEXP_SUBSTR":                  false,
+	"REGISTER":                       false,
+	"REGR_AVGX":                      false,
+	"REGR_AVGY":                      false,
+	"REGR_COUNT":                     false,
+	"REGR_INTERCEPT":                 false,
+	"REGR_R2":                        false,
+	"REGR_SLOPE":                     false,
+	"REGR_SXX":                       false,
+	"REGR_SXY":                       false,
+	"REGR_SYY":                       false,
+	"REGULAR":                        false,
+	"REJECT":                         false,
+	"REKEY":                          false,
+	"RELATIONAL":                     false,
+	"RELOCATE":                       false,
+	"RELY":                           false,
+	"REMAINDER":                      false,
+	"REMOTE_MAPPED":                  false,
+	"REMOTE":                         false,
+	"REMOVE":                         false,
+	"REORDER_WIF":                    false,
+	"REPAIR":                         false,
+	"REPEAT":                         false,
+	"REPLACE":                        false,
+	"REPLICATION":                    false,
+	"REQUIRED":                       false,
+	"RESERVOIR_SAMPLING":             false,
+	"RESET":                          false,
+	"RESETLOGS":                      false,
+	"RESIZE":                         false,
+	"RESOLVE":                        false,
+	"RESOLVER":                       false,
+	"RESPECT":                        false,
+	"RESTART":                        false,
+	"RESTORE_AS_INTERVALS":           false,
+	"RESTORE":                        false,
+	"RESTRICT_ALL_REF_CONS":          false,
+	"RESTRICT":                       false,
+	"RESTRICTED":                     false,
+	"RESULT_CACHE":                   false,
+	"RESUMABLE":                      false,
+	"RESUME":                         false,
+	"RETENTION":                      false,
+	"RETRY_ON_ROW_CHANGE":            false,
+	"RETURN":                         true,
+	"RETURNING":                      true,
+	"REUSE":                          false,
+	"REVERSE":                        false,
+	"REWRITE_OR_ERROR":               false,
+	"REWRITE":                        false,
+	"RIGHT":                          false,
+	"RIM":                            false,
+	"ROLE":                           true,
+	"ROLES":                          false,
+	"ROLESET":                        false,
+	"ROLLBACK":                       false,
+	"ROLLING":                        false,
+	"ROLLUP":                         false,
+	"ROOT":                           false,
+	"ROUND_TIES_TO_EVEN":             false,
+	"ROUND":                          false,
+	"ROW_LENGTH":                     false,
+	"ROW_NUMBER":                     false,
+	"ROW":                            false,
+	"ROWDEPENDENCIES":                false,
+	"ROWID_MAPPING_TABLE":            false,
+	"ROWID":                          false,
+	"ROWIDTOCHAR":                    false,
+	"ROWIDTONCHAR":                   false,
+	"ROWNUM":                         false,
+	"ROWS":                           false,
+	"RPAD":                           false,
+	"RTRIM":                          false,
+	"RULE":                           false,
+	"RULES":                          false,
+	"RUNNING":                        false,
+	"SALT":                           false,
+	"SAMPLE":                         false,
+	"SAVE_AS_INTERVALS":              false,
+	"SAVE":                           false,
+	"SAVEPOINT":                      false,
+	"SB4":                            false,
+	"SCALE_ROWS":                     false,
+	"SCALE":                          false,
+	"SCAN_INSTANCES":                 false,
+	"SCAN":                           false,
+	"SCHEDULER":                      false,
+	"SCHEMA":                         false,
+	"SCHEMACHECK":                    false,
+	"SCN_ASCENDING":                  false,
+	"SCN":                            false,
+	"SCOPE":                          false,
+	"SCRUB":                          false,
+	"SD_ALL":                         false,
+	"SD_INHIBIT":                     false,
+	"SD_SHOW":                        false,
+	"SDO_GEOM_KEY":                   false,
+	"SDO_GEOM_MBB":                   false,
+	"SDO_GEOM_MBR":                   false,
+	"SEARCH":                         false,
+	"SECOND":                         false,
+	"SECONDS":                        false,
+	"SECRET":                         false,
+	"SECUREFILE_DBA":                 false,
+	"SECUREFILE":                     false,
+	"SECURITY":                       false,
+	"SEED":                           false,
+	"SEG_BLOCK":                      false,
+	"SEG_FILE":                       false,
+	"SEGMENT":                        false,
+	"SELECTIVITY":                    false,
+	"SELF":                           false,
+	"SEMI_TO_INNER":                  false,
+	"SEMIJOIN_DRIVER":                false,
+	"SEMIJOIN":                       false,
+	"SEQUENCE":                       false,
+	"SEQUENCED":                      false,
+	"SEQUENTIAL":                     false,
+	"SERIAL":                         false,
+	"SERIALIZABLE":                   false,
+	"SERVERERROR":                    false,
+	"SERVICE_NAME_CONVERT":           false,
+	"SERVICE":                        false,
+	"SERVICES":                       false,
+	"SESSION_CACHED_CURSORS":         false,
+	"SESSION":                        false,
+	"SESSIONS_PER_USER":              false,
+	"SESSIONTIMEZONE":                false,
+	"SESSIONTZNAME":                  false,
+	"SET_TO_JOIN":                    false,
+	"SETS":                           false,
+	"SETTINGS":                       false,
+	"SEVERE":                         false,
+	"SHARD":                          false,
+	"SHARDED":                        false,
+	"SHARDS":                         false,
+	"SHARDSPACE":                     false,
+	"SHARE_OF":                       false,
+	"SHARED_POOL":                    false,
+	"SHARED":                         false,
+	"SHARING":                        false,
+	"SHELFLIFE":                      false,
+	"SHOW":                           false,
+	"SHRINK":                         false,
+	"SHUTDOWN":                       false,
+	"SIBLING":                        false,
+	"SIBLINGS":                       false,
+	"SID":                            false,
+	"SIGN":                           false,
+	"SIGNAL_COMPONENT":               false,
+	"SIGNAL_FUNCTION":                false,
+	"SIMPLE":                         false,
+	"SIN":                            false,
+	"SINGLE":                         false,
+	"SINGLETASK":                     false,
+	"SINH":                           false,
+	"SITE":                           false,
+	"SKIP_EXT_OPTIMIZER":             false,
+	"SKIP_PROXY":                     false,
+	"SKIP_UNQ_UNUSABLE_IDX":          false,
+	"SKIP_UNUSABLE_INDEXES":          false,
+	"SKIP":                           false,
+	"SMALLFILE":                      false,
+	"SNAPSHOT":                       false,
+	"SOME":                           false,
+	"SORT":                           false,
+	"SOUNDEX":                        false,
+	"SOURCE_FILE_DIRECTORY":          false,
+	"SOURCE_FILE_NAME_CONVERT":       false,
+	"SOURCE":                         false,
+	"SPACE":                          false,
+	"SPECIFICATION":                  false,
+	"SPFILE":                         false,
+	"SPLIT":                          false,
+	"SPREADSHEET":                    false,
+	"SQL_SCOPE":                      false,
+	"SQL_TRACE":                      false,
+	"SQL_TRANSLATION_PROFILE":        false,
+	"SQL":                            false,
+	"SQLLDR":                         false,
+	"SQRT":                           false,
+	"STALE":                          false,
+	"STANDALONE":                     false,
+	"STANDARD_HASH":                  false,
+	"STANDBY_MAX_DATA_DELAY":         false,
+	"STANDBY":                        false,
+	"STANDBYS":                       false,
+	"STAR_TRANSFORMATION":            false,
+	"STAR":                           false,
+	"STARTUP":                        false,
+	"STATE":                          false,
+	"STATEMENT_ID":                   false,
+	"STATEMENT_QUEUING":              false,
+	"STATEMENT":                      false,
+	"STATEMENTS":                     false,
+	"STATIC":                         false,
+	"STATISTICS":                     false,
+	"STATS_BINOMIAL_TEST":            false,
+	"STATS_CROSSTAB":                 false,
+	"STATS_F_TEST":                   false,
+	"STATS_KS_TEST":                  false,
+	"STATS_MODE":                     false,
+	"STATS_MW_TEST":                  false,
+	"STATS_ONE_WAY_ANOVA":            false,
+	"STATS_T_TEST_INDEP":             false,
+	"STATS_T_TEST_INDEPU":            false,
+	"STATS_T_TEST_ONE":               false,
+	"STATS_T_TEST_PAIRED":            false,
+	"STATS_WSR_TEST":                 false,
+	"STDDEV_POP":                     false,
+	"STDDEV_SAMP":                    false,
+	"STDDEV":                         false,
+	"STOP":                           false,
+	"STORAGE_INDEX":                  false,
+	"STORAGE":                        false,
+	"STORE":                          false,
+	"STREAM":                         false,
+	"STREAMS":                        false,
+	"STRICT":                         false,
+	"STRING":                         false,
+	"STRIP":                          false,
+	"STRIPE_COLUMNS":                 false,
+	"STRIPE_WIDTH":                   false,
+	"STRUCTURE":                      false,
+	"SUBMULTISET":                    false,
+	"SUBPARTITION_REL":               false,
+	"SUBPARTITION":                   false,
+	"SUBPARTITIONING":                false,
+	"SUBPARTITIONS":                  false,
+	"SUBQUERIES":                     false,
+	"SUBQUERY_PRUNING":               false,
+	"SUBSCRIBE":                      false,
+	"SUBSET":                         false,
+	"SUBSTITUTABLE":                  false,
+	"SUBSTR":                         false,
+	"SUBSTR2":                        false,
+	"SUBSTR4":                        false,
+	"SUBSTRB":                        false,
+	"SUBSTRC":                        false,
+	"SUCCESS":                        false,
+	"SUCCESSFUL":                     false,
+	"SUM":                            false,
+	"SUMMARY":                        false,
+	"SUPPLEMENTAL":                   false,
+	"SUPPRESS_LOAD":                  false,
+	"SUSPEND":                        false,
+	"SWAP_JOIN_INPUTS":               false,
+	"SWITCH":                         false,
+	"SWITCHOVER":                     false,
+	"SYNC":                           false,
+	"SYNCHRONOUS":                    false,
+	"SYS_AUDIT":                      false,
+	"SYS_CHECK_PRIVILEGE":            false,
+	"SYS_CHECKACL":                   false,
+	"SYS_CONNECT_BY_PATH":            false,
+	"SYS_CONTEXT":                    false,
+	"SYS_CTX_CONTAINS2":              false,
+	"SYS_CTX_MKIVIDX":                false,
+	"SYS_CTXINFOPK":                  false,
+	"SYS_DBURIGEN":                   false,
+	"SYS_DL_CURSOR":                  false,
+	"SYS_DM_RXFORM_CHR":              false,
+	"SYS_DM_RXFORM_LAB":              false,
+	"SYS_DM_RXFORM_NUM":              false,
+	"SYS_DOM_COMPARE":                false,
+	"SYS_DST_PRIM2SEC":               false,
+	"SYS_DST_SEC2PRIM":               false,
+	"SYS_ET_BFILE_TO_RAW":            false,
+	"SYS_ET_BLOB_TO_IMAGE":           false,
+	"SYS_ET_IMAGE_TO_BLOB":           false,
+	"SYS_ET_RAW_TO_BFILE":            false,
+	"SYS_EXTPDTXT":                   false,
+	"SYS_EXTRACT_UTC":                false,
+	"SYS_FBT_INSDEL":                 false,
+	"SYS_FILTER_ACLS":                false,
+	"SYS_FNMATCHES":                  false,
+	"SYS_FNREPLACE":                  false,
+	"SYS_GET_ACLIDS":                 false,
+	"SYS_GET_COL_ACLIDS":             false,
+	"SYS_GET_PRIVILEGES":             false,
+	"SYS_GETTOKENID":                 false,
+	"SYS_GETXTIVAL":                  false,
+	"SYS_GUID":                       false,
+	"SYS_MAKE_XMLNODEID":             false,
+	"SYS_MAKEXML":                    false,
+	"SYS_MKXMLATTR":                  false,
+	"SYS_MKXTI":                      false,
+	"SYS_OP_ADT2BIN":                 false,
+	"SYS_OP_ADTCONS":                 false,
+	"SYS_OP_ALSCRVAL":                false,
+	"SYS_OP_ATG":                     false,
+	"SYS_OP_BIN2ADT":                 false,
+	"SYS_OP_BITVEC":                  false,
+	"SYS_OP_BL2R":                    false,
+	"SYS_OP_BLOOM_FILTER_LIST":       false,
+	"SYS_OP_BLOOM_FILTER":            false,
+	"SYS_OP_C2C":                     false,
+	"SYS_OP_CAST":                    false,
+	"SYS_OP_CEG":                     false,
+	"SYS_OP_CL2C":                    false,
+	"SYS_OP_COMBINED_HASH":           false,
+	"SYS_OP_COMP":                    false,
+	"SYS_OP_CONVERT":                 false,
+	"SYS_OP_COUNTCHG":                false,
+	"SYS_OP_CSCONV":                  false,
+	"SYS_OP_CSCONVTEST":              false,
+	"SYS_OP_CSR":                     false,
+	"SYS_OP_CSX_PATCH":               false,
+	"SYS_OP_CYCLED_SEQ":              false,
+	"SYS_OP_DECOMP":                  false,
+	"SYS_OP_DESCEND":                 false,
+	"SYS_OP_DISTINCT":                false,
+	"SYS_OP_DRA":                     false,
+	"SYS_OP_DUMP":                    false,
+	"SYS_OP_DV_CHECK":                false,
+	"SYS_OP_ENFORCE_NOT_NULL$":       false,
+	"SYS_OP_EXTRACT":                 false,
+	"SYS_OP_GROUPING":                false,
+	"SYS_OP_GUID":                    false,
+	"SYS_OP_HASH":                    false,
+	"SYS_OP_HCS_TABLE":               false,
+	"SYS_OP_IIX":                     false,
+	"SYS_OP_ITR":                     false,
+	"SYS_OP_KEY_VECTOR_CREATE":       false,
+	"SYS_OP_KEY_VECTOR_FILTER_LIST":  false,
+	"SYS_OP_KEY_VECTOR_FILTER":       false,
+	"SYS_OP_KEY_VECTOR_PAYLOAD":      false,
+	"SYS_OP_KEY_VECTOR_SUCCEEDED":    false,
+	"SYS_OP_KEY_VECTOR_USE":          false,
+	"SYS_OP_LBID":                    false,
+	"SYS_OP_LOBLOC2BLOB":             false,
+	"SYS_OP_LOBLOC2CLOB":             false,
+	"SYS_OP_LOBLOC2ID":               false,
+	"SYS_OP_LOBLOC2NCLOB":            false,
+	"SYS_OP_LOBLOC2TYP":              false,
+	"SYS_OP_LSVI":                    false,
+	"SYS_OP_LVL":                     false,
+	"SYS_OP_MAKEOID":                 false,
+	"SYS_OP_MAP_NONNULL":             false,
+	"SYS_OP_MSR":                     false,
+	"SYS_OP_NICOMBINE":               false,
+	"SYS_OP_NIEXTRACT":               false,
+	"SYS_OP_NII":                     false,
+	"SYS_OP_NIX":                     false,
+	"SYS_OP_NOEXPAND":                false,
+	"SYS_OP_NTCIMG$":                 false,
+	"SYS_OP_NUMTORAW":                false,
+	"SYS_OP_OIDVALUE":                false,
+	"SYS_OP_OPNSIZE":                 false,
+	"SYS_OP_PAR_1":                   false,
+	"SYS_OP_PAR":                     false,
+	"SYS_OP_PARGID_1":                false,
+	"SYS_OP_PARGID":                  false,
+	"SYS_OP_PART_ID":                 false,
+	"SYS_OP_PIVOT":                   false,
+	"SYS_OP_R2O":                     false,
+	"SYS_OP_RAWTONUM":                false,
+	"SYS_OP_RDTM":                    false,
+	"SYS_OP_REF":                     false,
+	"SYS_OP_RMTD":                    false,
+	"SYS_OP_ROWIDTOOBJ":              false,
+	"SYS_OP_RPB":                     false,
+	"SYS_OP_TOSETID":                 false,
+	"SYS_OP_TPR":                     false,
+	"SYS_OP_TRTB":                    false,
+	"SYS_OP_UNDESCEND":               false,
+	"SYS_OP_VECAND":                  false,
+	"SYS_OP_VECBIT":                  false,
+	"SYS_OP_VECOR":                   false,
+	"SYS_OP_VECTOR_GROUP_BY":         false,
+	"SYS_OP_VECXOR":                  false,
+	"SYS_OP_VERSION":                 false,
+	"SYS_OP_VREF":                    false,
+	"SYS_OP_VVD":                     false,
+	"SYS_OP_XMLCONS_FOR_CSX":         false,
+	"SYS_OP_XPTHATG":                 false,
+	"SYS_OP_XPTHIDX":                 false,
+	"SYS_OP_XPTHOP":                  false,
+	"SYS_OP_XTNN":                    false,
+	"SYS_OP_XTXT2SQLT":               false,
+	"SYS_OP_ZONE_ID":                 false,
+	"SYS_OPTLOBPRBSC":                false,
+	"SYS_OPTXICMP":                   false,
+	"SYS_OPTXQCASTASNQ":              false,
+	"SYS_ORDERKEY_DEPTH":             false,
+	"SYS_ORDERKEY_MAXCHILD":          false,
+	"SYS_ORDERKEY_PARENT":            false,
+	"SYS_PARALLEL_TXN":               false,
+	"SYS_PATH_REVERSE":               false,
+	"SYS_PATHID_IS_ATTR":             false,
+	"SYS_PATHID_IS_NMSPC":            false,
+	"SYS_PATHID_LASTNAME":            false,
+	"SYS_PATHID_LASTNMSPC":           false,
+	"SYS_PLSQL_COUNT":                false,
+	"SYS_PLSQL_CPU":                  false,
+	"SYS_PLSQL_IO":                   false,
+	"SYS_PXQEXTRACT":                 false,
+	"SYS_RAW_TO_XSID":                false,
+	"SYS_REMAP_XMLTYPE":              false,
+	"SYS_RID_ORDER":                  false,
+	"SYS_ROW_DELTA":                  false,
+	"SYS_SC_2_XMLT":                  false,
+	"SYS_SYNRCIREDO":                 false,
+	"SYS_TYPEID":                     false,
+	"SYS_UMAKEXML":                   false,
+	"SYS_XMLANALYZE":                 false,
+	"SYS_XMLCONTAINS":                false,
+	"SYS_XMLCONV":                    false,
+	"SYS_XMLEXNSURI":                 false,
+	"SYS_XMLGEN":                     false,
+	"SYS_XMLI_LOC_ISNODE":            false,
+	"SYS_XMLI_LOC_ISTEXT":            false,
+	"SYS_XMLINSTR":                   false,
+	"SYS_XMLLOCATOR_GETSVAL":         false,
+	"SYS_XMLNODEID_GETCID":           false,
+	"SYS_XMLNODEID_GETLOCATOR":       false,
+	"SYS_XMLNODEID_GETOKEY":          false,
+	"SYS_XMLNODEID_GETPATHID":        false,
+	"SYS_XMLNODEID_GETPTRID":         false,
+	"SYS_XMLNODEID_GETRID":           false,
+	"SYS_XMLNODEID_GETSVAL":          false,
+	"SYS_XMLNODEID_GETTID":           false,
+	"SYS_XMLNODEID":                  false,
+	"SYS_XMLT_2_SC":                  false,
+	"SYS_XMLTRANSLATE":               false,
+	"SYS_XMLTYPE2SQL":                false,
+	"SYS_XQ_ASQLCNV":                 false,
+	"SYS_XQ_ATOMCNVCHK":              false,
+	"SYS_XQ_NRNG":                    false,
+	"SYS_XQ_PKSQL2XML":               false,
+	"SYS_XQ_UPKXML2SQL":              false,
+	"SYS_XQBASEURI":                  false,
+	"SYS_XQCASTABLEERRH":             false,
+	"SYS_XQCODEP2STR":                false,
+	"SYS_XQCODEPEQ":                  false,
+	"SYS_XQCON2SEQ":                  false,
+	"SYS_XQCONCAT":                   false,
+	"SYS_XQDELETE":                   false,
+	"SYS_XQDFLTCOLATION":             false,
+	"SYS_XQDOC":                      false,
+	"SYS_XQDOCURI":                   false,
+	"SYS_XQDURDIV":                   false,
+	"SYS_XQED4URI":                   false,
+	"SYS_XQENDSWITH":                 false,
+	"SYS_XQERR":                      false,
+	"SYS_XQERRH":                     false,
+	"SYS_XQESHTMLURI":                false,
+	"SYS_XQEXLOBVAL":                 false,
+	"SYS_XQEXSTWRP":                  false,
+	"SYS_XQEXTRACT":                  false,
+	"SYS_XQEXTRREF":                  false,
+	"SYS_XQEXVAL":                    false,
+	"SYS_XQFB2STR":                   false,
+	"SYS_XQFNBOOL":                   false,
+	"SYS_XQFNCMP":                    false,
+	"SYS_XQFNDATIM":                  false,
+	"SYS_XQFNLNAME":                  false,
+	"SYS_XQFNNM":                     false,
+	"SYS_XQFNNSURI":                  false,
+	"SYS_XQFNPREDTRUTH":              false,
+	"SYS_XQFNQNM":                    false,
+	"SYS_XQFNROOT":                   false,
+	"SYS_XQFORMATNUM":                false,
+	"SYS_XQFTCONTAIN":                false,
+	"SYS_XQFUNCR":                    false,
+	"SYS_XQGETCONTENT":               false,
+	"SYS_XQINDXOF":                   false,
+	"SYS_XQINSERT":                   false,
+	"SYS_XQINSPFX":                   false,
+	"SYS_XQIRI2URI":                  false,
+	"SYS_XQLANG":                     false,
+	"SYS_XQLLNMFRMQNM":               false,
+	"SYS_XQMKNODEREF":                false,
+	"SYS_XQNILLED":                   false,
+	"SYS_XQNODENAME":                 false,
+	"SYS_XQNORMSPACE":                false,
+	"SYS_XQNORMUCODE":                false,
+	"SYS_XQNSP4PFX":                  false,
+	"SYS_XQNSPFRMQNM":                false,
+	"SYS_XQPFXFRMQNM":                false,
+	"SYS_XQPOLYABS":                  false,
+	"SYS_XQPOLYADD":                  false,
+	"SYS_XQPOLYCEL":                  false,
+	"SYS_XQPOLYCST":                  false,
+	"SYS_XQPOLYCSTBL":                false,
+	"SYS_XQPOLYDIV":                  false,
+	"SYS_XQPOLYFLR":                  false,
+	"SYS_XQPOLYMOD":                  false,
+	"SYS_XQPOLYMUL":                  false,
+	"SYS_XQPOLYRND":                  false,
+	"SYS_XQPOLYSQRT":                 false,
+	"SYS_XQPOLYSUB":                  false,
+	"SYS_XQPOLYUMUS":                 false,
+	"SYS_XQPOLYUPLS":                 false,
+	"SYS_XQPOLYVEQ":                  false,
+	"SYS_XQPOLYVGE":                  false,
+	"SYS_XQPOLYVGT":                  false,
+	"SYS_XQPOLYVLE":                  false,
+	"SYS_XQPOLYVLT":                  false,
+	"SYS_XQPOLYVNE":                  false,
+	"SYS_XQREF2VAL":                  false,
+	"SYS_XQRENAME":                   false,
+	"SYS_XQREPLACE":                  false,
+	"SYS_XQRESVURI":                  false,
+	"SYS_XQRNDHALF2EVN":              false,
+	"SYS_XQRSLVQNM":                  false,
+	"SYS_XQRYENVPGET":                false,
+	"SYS_XQRYVARGET":                 false,
+	"SYS_XQRYWRP":                    false,
+	"SYS_XQSEQ2CON":                  false,
+	"SYS_XQSEQ2CON4XC":               false,
+	"SYS_XQSEQDEEPEQ":                false,
+	"SYS_XQSEQINSB":                  false,
+	"SYS_XQSEQRM":                    false,
+	"SYS_XQSEQRVS":                   false,
+	"SYS_XQSEQSUB":                   false,
+	"SYS_XQSEQTYPMATCH":              false,
+	"SYS_XQSTARTSWITH":               false,
+	"SYS_XQSTATBURI":                 false,
+	"SYS_XQSTR2CODEP":                false,
+	"SYS_XQSTRJOIN":                  false,
+	"SYS_XQSUBSTRAFT":                false,
+	"SYS_XQSUBSTRBEF":                false,
+	"SYS_XQTOKENIZE":                 false,
+	"SYS_XQTREATAS":                  false,
+	"SYS_XQXFORM":                    false,
+	"SYS_XSID_TO_RAW":                false,
+	"SYS_ZMAP_FILTER":                false,
+	"SYS_ZMAP_REFRESH":               false,
+	"SYSASM":                         false,
+	"SYSAUX":                         false,
+	"SYSBACKUP":                      false,
+	"SYSDATE":                        false,
+	"SYSDBA":                         false,
+	"SYSDG":                          false,
+	"SYSGUID":                        false,
+	"SYSKM":                          false,
+	"SYSOBJ":                         false,
+	"SYSOPER":                        false,
+	"SYSRAC":                         false,
+	"SYSTEM_DEFINED":                 false,
+	"SYSTEM_STATS":                   false,
+	"SYSTEM":                         false,
+	"SYSTIMESTAMP":                   false,
+	"TABLE_LOOKUP_BY_NL":             false,
+	"TABLE_STATS":                    false,
+	"TABLES":                         false,
+	"TABLESPACE_NO":                  false,
+	"TABLESPACE":                     false,
+	"TABNO":                          false,
+	"TAG":                            false,
+	"TAN":                            false,
+	"TANH":                           false,
+	"TARGET":                         false,
+	"TBL$OR$IDX$PART$NUM":            false,
+	"TEMP_TABLE":                     false,
+	"TEMPFILE":                       false,
+	"TEMPLATE":                       false,
+	"TEMPORARY":                      false,
+	"TENANT_ID":                      false,
+	"TEST":                           false,
+	"TEXT":                           false,
+	"THAN":                           true,
+	"THE":                            false,
+	"THREAD":                         false,
+	"THROUGH":                        false,
+	"TIER":                           false,
+	"TIES":                           false,
+	"TIME_ZONE":                      false,
+	"TIME":                           false,
+	"TIMEOUT":                        false,
+	"TIMES":                          false,
+	"TIMESTAMP_TO_NUMBER":            false,
+	"TIMESTAMP":                      false,
+	"TIMEZONE_ABBR":                  false,
+	"TIMEZONE_HOUR":                  false,
+	"TIMEZONE_MINUTE":                false,
+	"TIMEZONE_OFFSET":                false,
+	"TIMEZONE_REGION":                false,
+	"TIV_GB":                         false,
+	"TIV_SSF":                        false,
+	"TO_ACLID":                       false,
+	"TO_APPROX_COUNT_DISTINCT":       false,
+	"TO_APPROX_PERCENTILE":           false,
+	"TO_BINARY_DOUBLE":               false,
+	"TO_BINARY_FLOAT":                false,
+	"TO_BLOB":                        false,
+	"TO_CHAR":                        false,
+	"TO_CLOB":                        false,
+	"TO_DATE":                        false,
+	"TO_DSINTERVAL":                  false,
+	"TO_LOB":                         false,
+	"TO_MULTI_BYTE":                  false,
+	"TO_NCHAR":                       false,
+	"TO_NCLOB":                       false,
+	"TO_NUMBER":                      false,
+	"TO_SINGLE_BYTE":                 false,
+	"TO_TIME_TZ":                     false,
+	"TO_TIME":                        false,
+	"TO_TIMESTAMP_TZ":                false,
+	"TO_TIMESTAMP":                   false,
+	"TO_UTC_TIMESTAMP_TZ":            false,
+	"TO_YMINTERVAL":                  false,
+	"TOKEN":                          false,
+	"TOPLEVEL":                       false,
+	"TRACE":                          false,
+	"TRACING":                        false,
+	"TRACKING":                       false,
+	"TRAILING":                       false,
+	"TRANSACTION":                    false,
+	"TRANSFORM_DISTINCT_AGG":         false,
+	"TRANSITION":                     false,
+	"TRANSITIONAL":                   false,
+	"TRANSLATE":                      false,
+	"TRANSLATION":                    false,
+	"TREAT":                          false,
+	"TRIGGERS":                       false,
+	"TRIM":                           false,
+	"TRUE":                           false,
+	"TRUNC":                          false,
+	"TRUNCATE":                       true,
+	"TRUST":                          false,
+	"TRUSTED":                        false,
+	"TUNING":                         false,
+	"TX":                             false,
+	"TYPE":                           false,
+	"TYPES":                          false,
+	"TZ_OFFSET":                      false,
+	"UB2":                            false,
+	"UBA":                            false,
+	"UCS2":                           false,
+	"UID":                            false,
+	"UNARCHIVED":                     false,
+	"UNBOUND":                        false,
+	"UNBOUNDED":                      false,
+	"UNCONDITIONAL":                  false,
+	"UNDER":                          false,
+	"UNDO":                           false,
+	"UNDROP":                         false,
+	"UNIFORM":                        false,
+	"UNINSTALL":                      false,
+	"UNISTR":                         false,
+	"UNITE":                          false,
+	"UNIXTIME":                       false,
+	"UNLIMITED":                      false,
+	"UNLOAD":                         false,
+	"UNLOCK":                         false,
+	"UNMATCHED":                      false,
+	"UNNEST_INNERJ_DISTINCT_VIEW":    false,
+	"UNNEST_NOSEMIJ_NODISTINCTVIEW":  false,
+	"UNNEST_SEMIJ_VIEW":              false,
+	"UNNEST":                         false,
+	"UNPACKED":                       false,
+	"UNPIVOT":                        false,
+	"UNPLUG":                         false,
+	"UNPROTECTED":                    false,
+	"UNQUIESCE":                      false,
+	"UNRECOVERABLE":                  false,
+	"UNRESTRICTED":                   false,
+	"UNSUBSCRIBE":                    false,
+	"UNTIL":                          false,
+	"UNUSABLE":                       false,
+	"UNUSED":                         false,
+	"UPD_INDEXES":                    false,
+	"UPD_JOININDEX":                  false,
+	"UPDATABLE":                      false,
+	"UPDATED":                        false,
+	"UPDATEXML":                      false,
+	"UPGRADE":                        false,
+	"UPPER":                          false,
+	"UPSERT":                         false,
+	"UROWID":                         false,
+	"USABLE":                         false,
+	"USAGE":                          false,
+	"USE_ANTI":                       false,
+	"USE_CONCAT":                     false,
+	"USE_CUBE":                       false,
+	"USE_DAGG_UNION_ALL_GSETS":       false,
+	"USE_HASH_AGGREGATION":           false,
+	"USE_HASH_GBY_FOR_DAGGPSHD":      false,
+	"USE_HASH_GBY_FOR_PUSHDOWN":      false,
+	"USE_HASH":                       false,
+	"USE_HIDDEN_PARTITIONS":          false,
+	"USE_INVISIBLE_INDEXES":          false,
+	"USE_MERGE_CARTESIAN":            false,
+	"USE_MERGE":                      false,
+	"USE_NL_WITH_INDEX":              false,
+	"USE_NL":                         false,
+	"USE_PARTITION_WISE_DISTINCT":    false,
+	"USE_PARTITION_WISE_GBY":         false,
+	"USE_PARTITION_WISE_WIF":         false,
+	"USE_PRIVATE_OUTLINES":           false,
+	"USE_SEMI":                       false,
+	"USE_STORED_OUTLINES":            false,
+	"USE_TTT_FOR_GSETS":              false,
+	"USE_VECTOR_AGGREGATION":         false,
+	"USE_WEAK_NAME_RESL":             false,
+	"USE":                            false,
+	"USER_DATA":                      false,
+	"USER_DEFINED":                   false,
+	"USER_RECYCLEBIN":                false,
+	"USER_TABLESPACES":               false,
+	"USER":                           false,
+	"USERENV":                        false,
+	"USERGROUP":                      false,
+	"USERS":                          false,
+	"USING_NO_EXPAND":                false,
+	"USING":                          true,
+	"UTF16BE":                        false,
+	"UTF16LE":                        false,
+	"UTF32":                          false,
+	"UTF8":                           false,
+	"V1":                             false,
+	"V2":                             false,
+	"VALID_TIME_END":                 false,
+	"VALIDATE_CONVERSION":            false,
+	"VALIDATE":                       false,
+	"VALIDATION":                     false,
+	"VALUE":                          false,
+	"VAR_POP":                        false,
+	"VAR_SAMP":                       false,
+	"VARIANCE":                       false,
+	"VARRAY":                         false,
+	"VARRAYS":                        false,
+	"VARYING":                        false,
+	"VECTOR_ENCODE":                  false,
+	"VECTOR_READ_TRACE":              false,
+	"VECTOR_READ":                    false,
+	"VECTOR_TRANSFORM_DIMS":          false,
+	"VECTOR_TRANSFORM_FACT":          false,
+	"VECTOR_TRANSFORM":               false,
+	"VECTOR":                         false,
+	"VERIFIER":                       false,
+	"VERIFY":                         false,
+	"VERSION":                        false,
+	"VERSIONING":                     false,
+	"VERSIONS_ENDSCN":                false,
+	"VERSIONS_ENDTIME":               false,
+	"VERSIONS_OPERATION":             false,
+	"VERSIONS_STARTSCN":              false,
+	"VERSIONS_STARTTIME":             false,
+	"VERSIONS_XID":                   false,
+	"VERSIONS":                       false,
+	"VIOLATION":                      false,
+	"VIRTUAL":                        false,
+	"VISIBILITY":                     false,
+	"VISIBLE":                        false,
+	"VOLUME":                         false,
+	"VSIZE":                          false,
+	"WAIT":                           false,
+	"WALLET":                         false,
+	"WEEK":                           false,
+	"WEEKS":                          false,
+	"WELLFORMED":                     false,
+	"WHEN":                           true,
+	"WHENEVER":                       false,
+	"WHITESPACE":                     false,
+	"WIDTH_BUCKET":                   false,
+	"WITH_EXPRESSION":                false,
+	"WITH_PLSQL":                     false,
+	"WITHIN":                         false,
+	"WITHOUT":                        false,
+	"WORK":                           false,
+	"WRAPPED":                        false,
+	"WRAPPER":                        false,
+	"WRITE":                          false,
+	"X_DYN_PRUNE":                    false,
+	"XDB_FASTPATH_INSERT":            false,
+	"XID":                            false,
+	"XML_DML_RWT_STMT":               false,
+	"XML":                            false,
+	"XML2OBJECT":                     false,
+	"XMLATTRIBUTES":                  false,
+	"XMLCAST":                        false,
+	"XMLCDATA":                       false,
+	"XMLCOLATTVAL":                   false,
+	"XMLCOMMENT":                     false,
+	"XMLCONCAT":                      false,
+	"XMLDIFF":                        false,
+	"XMLELEMENT":                     false,
+	"XMLEXISTS":                      false,
+	"XMLEXISTS2":                     false,
+	"XMLFOREST":                      false,
+	"XMLINDEX_REWRITE_IN_SELECT":     false,
+	"XMLINDEX_REWRITE":               false,
+	"XMLINDEX_SEL_IDX_TBL":           false,
+	"XMLISNODE":                      false,
+	"XMLISVALID":                     false,
+	"XMLNAMESPACES":                  false,
+	"XMLPARSE":                       false,
+	"XMLPATCH":                       false,
+	"XMLPI":                          false,
+	"XMLQUERY":                       false,
+	"XMLQUERYVAL":                    false,
+	"XMLROOT":                        false,
+	"XMLSCHEMA":                      false,
+	"XMLSERIALIZE":                   false,
+	"XMLTABLE":                       false,
+	"XMLTOJSON":                      false,
+	"XMLTOKENSET":                    false,
+	"XMLTRANSFORM":                   false,
+	"XMLTRANSFORMBLOB":               false,
+	"XMLTSET_DML_ENABLE":             false,
+	"XMLTYPE":                        false,
+	"XPATHTABLE":                     false,
+	"XS_SYS_CONTEXT":                 false,
+	"XS":                             false,
+	"YEAR":                           false,
+	"YEARS":                          false,
+	"YES":                            false,
+	"ZONE":                           false,
+	"ZONEMAP":                        false,
+	"ALL":                            true,
+	"ALTER":                          true,
+	"AND":                            true,
+	"ANY":                            true,
+	"AS":                             true,
+	"ASC":                            true,
+	"BETWEEN":                        true,
+	"BY":                             true,
+	"CHAR":                           true,
+	"CHECK":                          true,
+	"CLUSTER":                        true,
+	"COMPRESS":                       true,
+	"CONNECT":                        true,
+	"CREATE":                         true,
+	"DATE":                           true,
+	"DECIMAL":                        true,
+	"DEFAULT":                        true,
+	"DELETE":                         true,
+	"DESC":                           true,
+	"DISTINCT":                       true,
+	"DROP":                           true,
+	"ELSE":                           true,
+	"EXCLUSIVE":                      true,
+	"EXISTS":                         true,
+	"FLOAT":                          true,
+	"FOR":                            true,
+	"FROM":                           true,
+	"GRANT":                          true,
+	"GROUP":                          true,
+	"HAVING":                         true,
+	"IDENTIFIED":                     true,
+	"IN":                             true,
+	"INDEX":                          true,
+	"INSERT":                         true,
+	"INTEGER":                        true,
+	"INTERSECT":                      true,
+	"INTO":                           true,
+	"IS":                             true,
+	"LIKE":                           true,
+	"LOCK":                           true,
+	"LONG":                           true,
+	"MINUS":                          true,
+	"MODE":                           true,
+	"NOCOMPRESS":                     true,
+	"NOT":                            true,
+	"NOWAIT":                         true,
+	"NULL":                           true,
+	"NUMBER":                         true,
+	"OF":                             true,
+	"ON":                             true,
+	"OPTION":                         true,
+	"OR":                             true,
+	"ORDER":                          true,
+	"PCTFREE":                        true,
+	"PRIOR":                          true,
+	"PUBLIC":                         true,
+	"RAW":                            true,
+	"RENAME":                         true,
+	"RESOURCE":                       true,
+	"REVOKE":                         true,
+	"SELECT":                         true,
+	"SET":                            true,
+	"SHARE":                          true,
+	"SIZE":                           true,
+	"SMALLINT":                       true,
+	"START":                          true,
+	"SYNONYM":                        true,
+	"TABLE":                          true,
+	"THEN":                           true,
+	"TO":                             true,
+	"TRIGGER":                        true,
+	"UNION":                          true,
+	"UNIQUE":                         true,
+	"UPDATE":                         true,
+	"VALUES":                         true,
+	"VARCHAR":                        true,
+	"VARCHAR2":                       true,
+	"VIEW":                           true,
+	"WHERE":                          true,
+	"WITH":                           true,
+}
+
+var oraclePLKeywords = map[string]bool{
+	// "A": false,
+	// "C": false,
+	"ACCESSIBLE":      false,
+	"ADD":             false,
+	"AGENT":           false,
+	"AGGREGATE":       false,
+	"ALL":             false,
+	"ALTER":           false,
+	"AND":             true,
+	"ANY":             true,
+	"ARRAY":           false,
+	"ASC":             false,
+	"AS":              false,
+	"AT":              false,
+	"ATTRIBUTE":       false,
+	"AUTHID":          false,
+	"AVG":             false,
+	"BEGIN":           true,
+	"BETWEEN":         true,
+	"BFILE_BASE":      false,
+	"BINARY":          false,
+	"BLOB_BASE":       false,
+	"BLOCK":           false,
+	"BODY":            false,
+	"BOTH":            false,
+	"BOUND":           false,
+	"BULK":            false,
+	"BY":              false,
+	"BYTE":            false,
+	"CALL":            false,
+	"CALLING":         false,
+	"CASCADE":         false,
+	"CASE":            false,
+	"CHARACTER":       false,
+	"CHAR_BASE":       false,
+	"CHAR":            false,
+	"CHARSET":         false,
+	"CHARSETFORM":     false,
+	"CHARSETID":       false,
+	"CHECK":           false,
+	"CLOB_BASE":       false,
+	"CLONE":           false,
+	"CLOSE":           true,
+	"CLUSTER":         false,
+	"CLUSTERS":        false,
+	"COLAUTH":         false,
+	"COLLECT":         false,
+	"COLUMNS":         false,
+	"COMMENT":         true,
+	"COMMIT":          true,
+	"COMMITTED":       false,
+	"COMPILED":        false,
+	"COMPRESS":        false,
+	"CONNECT":         false,
+	"CONSTANT":        false,
+	"CONSTRUCTOR":     false,
+	"CONTEXT":         false,
+	"CONTINUE":        true,
+	"CONVERT":         false,
+	"COUNT":           false,
+	"CRASH":           false,
+	"CREATE":          true,
+	"CREDENTIAL":      false,
+	"CURRENT":         false,
+	"CURSOR":          false,
+	"CUSTOMDATUM":     false,
+	"DANGLING":        false,
+	"DATA":            false,
+	"DATE_BASE":       false,
+	"DATE":            false,
+	"DAY":             false,
+	"DECLARE":         false,
+	"DEFAULT":         false,
+	"DEFINE":          false,
+	"DELETE":          false,
+	"DESC":            false,
+	"DETERMINISTIC":   false,
+	"DIRECTORY":       false,
+	"DISTINCT":        false,
+	"DOUBLE":          false,
+	"DROP":            false,
+	"DURATION":        false,
+	"ELEMENT":         false,
+	"ELSE":            false,
+	"ELSIF":           false,
+	"EMPTY":           false,
+	"END":             false,
+	"ESCAPE":          false,
+	"EXCEPT":          false,
+	"EXCEPTION":       false,
+	"EXCEPTIONS":      false,
+	"EXCLUSIVE":       false,
+	"EXECUTE":         false,
+	"EXISTS":          false,
+	"EXIT":            false,
+	"EXTERNAL":        false,
+	"FETCH":           false,
+	"FINAL":           false,
+	"FIRST":           false,
+	"FIXED":           false,
+	"FLOAT":           false,
+	"FORALL":          false,
+	"FORCE":           false,
+	"FOR":             false,
+	"FROM":            false,
+	"FUNCTION":        false,
+	"GENERAL":         false,
+	"GOTO":            false,
+	"GRANT":           false,
+	"GROUP":           false,
+	"HASH":            false,
+	"HAVING":          false,
+	"HEAP":            false,
+	"HIDDEN":          false,
+	"HOUR":            false,
+	"IDENTIFIED":      false,
+	"IF":              false,
+	"IMMEDIATE":       false,
+	"INCLUDING":       false,
+	"INDEXES":         false,
+	"INDEX":           false,
+	"INDICATOR":       false,
+	"INDICES":         false,
+	"IN":              false,
+	"INFINITE":        false,
+	"INSERT":          false,
+	"INSTANTIABLE":    false,
+	"INTERFACE":       false,
+	"INTERSECT":       false,
+	"INTERVAL":        false,
+	"INT":             false,
+	"INTO":            false,
+	"INVALIDATE":      false,
+	"IS":              false,
+	"ISOLATION":       false,
+	"JAVA":            false,
+	"LANGUAGE":        false,
+	"LARGE":           false,
+	"LEADING":         false,
+	"LENGTH":          false,
+	"LEVEL":           false,
+	"LIBRARY":         false,
+	"LIKE2":           false,
+	"LIKE4":           false,
+	"LIKEC":           false,
+	"LIKE":            false,
+	"LIMITED":         false,
+	"LIMIT":           false,
+	"LOCAL":           false,
+	"LOCK":            false,
+	"LONG":            false,
+	"LOOP":            false,
+	"MAP":             false,
+	"MAX":             false,
+	"MAXLEN":          false,
+	"MEMBER":          false,
+	"MERGE":           false,
+	"MIN":             false,
+	"MINUS":           false,
+	"MINUTE":          false,
+	"MODE":            false,
+	"MOD":             false,
+	"MODIFY":          false,
+	"MONTH":           false,
+	"MULTISET":        false,
+	"NAME":            false,
+	"NAN":             false,
+	"NATIONAL":        false,
+	"NATIVE":          false,
+	"NCHAR":           false,
+	"NEW":             false,
+	"NOCOMPRESS":      false,
+	"NOCOPY":          false,
+	"NOT":             false,
+	"NOWAIT":          false,
+	"NULL":            false,
+	"NUMBER_BASE":     false,
+	"OBJECT":          false,
+	"OCICOLL":         false,
+	"OCIDATE":         false,
+	"OCIDATETIME":     false,
+	"OCIDURATION":     false,
+	"OCIINTERVAL":     false,
+	"OCILOBLOCATOR":   false,
+	"OCINUMBER":       false,
+	"OCIRAW":          false,
+	"OCIREFCURSOR":    false,
+	"OCIREF":          false,
+	"OCIROWID":        false,
+	"OCISTRING":       false,
+	"OCITYPE":         false,
+	"OF":              false,
+	"OLD":             false,
+	"ON":              false,
+	"ONLY":            false,
+	"OPAQUE":          false,
+	"OPEN":            false,
+	"OPERATOR":        false,
+	"OPTION":          false,
+	"ORACLE":          false,
+	"ORADATA":         false,
+	"ORDER":           false,
+	"OR":              false,
+	"ORGANIZATION":    false,
+	"ORLANY":          false,
+	"ORLVARY":         false,
+	"OTHERS":          false,
+	"OUT":             false,
+	"OVERLAPS":        false,
+	"OVERRIDING":      false,
+	"PACKAGE":         false,
+	"PARALLEL_ENABLE": false,
+	"PARAMETER":       false,
+	"PARAMETERS":      false,
+	"PARENT":          false,
+	"PARTITION":       false,
+	"PASCAL":          false,
+	"PERSISTABLE":     false,
+	"PIPE":            false,
+	"PIPELINED":       false,
+	"PLUGGABLE":       false,
+	"POLYMORPHIC":     false,
+	"PRAGMA":          false,
+	"PRECISION":       false,
+	"PRIOR":           false,
+	"PRIVATE":         false,
+	"PROCEDURE":       false,
+	"PUBLIC":          false,
+	"RAISE":           false,
+	"RANGE":           false,
+	"RAW":             false,
+	"READ":            false,
+	"RECORD":          false,
+	"REFERENCE":       false,
+	"REF":             false,
+	"RELIES_ON":       false,
+	"REMAINDER":       false,
+	"REM":             false,
+	"RENAME":          false,
+	"RESOURCE":        false,
+	"RESULT_CACHE":    false,
+	"RESULT":          false,
+	"RETURN":          false,
+	"RETURNING":       false,
+	"REVERSE":         false,
+	"REVOKE":          false,
+	"ROLLBACK":        false,
+	"ROW":             false,
+	"SAMPLE":          false,
+	"SAVE":            false,
+	"SAVEPOINT":       false,
+	"SB1":             false,
+	"SB2":             false,
+	"SB4":             false,
+	"SECOND":          false,
+	"SEGMENT":         false,
+	"SELECT":          false,
+	"SELF":            false,
+	"SEPARATE":        false,
+	"SEQUENCE":        false,
+	"SERIALIZABLE":    false,
+	"SET":             false,
+	"SHARE":           false,
+	"SHORT":           false,
+	"SIZE":            false,
+	"SIZE_T":          false,
+	"SOME":            false,
+	"SPARSE":          false,
+	"SQLCODE":         false,
+	"SQLDATA":         false,
+	"SQL":             false,
+	"SQLNAME":         false,
+	"SQLSTATE":        false,
+	"STANDARD":        false,
+	"START":           false,
+	"STATIC":          false,
+	"STDDEV":          false,
+	"STORED":          false,
+	"STRING":          false,
+	"STRUCT":          false,
+	"STYLE":           false,
+	"SUBMULTISET":     false,
+	"SUBPARTITION":    false,
+	"SUBSTITUTABLE":   false,
+	"SUBTYPE":         false,
+	"SUM":             false,
+	"SYNONYM":         false,
+	"TABAUTH":         false,
+	"TABLE":           false,
+	"TDO":             false,
+	"THE":             false,
+	"THEN":            false,
+	"TIME":            false,
+	"TIMESTAMP":       false,
+	"TIMEZONE_ABBR":   false,
+	"TIMEZONE_HOUR":   false,
+	"TIMEZONE_MINUTE": false,
+	"TIMEZONE_REGION": false,
+	"TO":              false,
+	"TRAILING":        false,
+	"TRANSACTIONAL":   false,
+	"TRANSACTION":     false,
+	"TRUSTED":         false,
+	"TYPE":            false,
+	"UB1":             false,
+	"UB2":             false,
+	"UB4":             false,
+	"UNDER":           false,
+	"UNION":           false,
+	"UNIQUE":          false,
+	"UNPLUG":          false,
+	"UNSIGNED":        false,
+	"UNTRUSTED":       false,
+	"UPDATE":          false,
+	"USE":             false,
+	"USING":           false,
+	"VALIST":          false,
+	"VALUE":           false,
+	"VALUES":          false,
+	"VARIABLE":        false,
+	"VARIANCE":        false,
+	"VARRAY":          false,
+	"VARYING":         false,
+	"VIEW":            false,
+	"VIEWS":           false,
+	"VOID":            false,
+	"WHEN":            false,
+	"WHERE":           false,
+	"WHILE":           false,
+	"WITH":            false,
+	"WORK":            false,
+	"WRAPPED":         false,
+	"WRITE":           false,
+	"YEAR":            false,
+	"ZONE":            false,
+}
+
+func (d OracleDialect) keyword(s string) (bool, bool) {
+
 	v, ok := oracleSQLKeywords[strings.ToUpper(s)]
 	if ok {
 		return ok, v
@@ -2915,31 +3148,31 @@ func (d OracleDialect) IsReservedKeyword(s string) bool {
 	return false
 }
 
+var oracleOperators = map[string]bool{
+	"^=":  true,
+	"<=":  true,
+	"<":   true,
+	"=":   true,
+	">=":  true,
+	">":   true,
+	"¬=":  true,
+	"||":  true,
+	"<>":  true,
+	"-":   true,
+	":=":  true,
+	"!=":  true,
+	"/":   true,
+	"(+)": true,
+	"*":   true,
+	"+":   true,
+	"=>":  true, // Added fat comma for function/procedure calls
+	"..":  true, // Added for loop ranges
+}
+
 // IsOperator returns a boolean indicating if the supplied string
 // is considered to be an operator in Oracle
 func (d OracleDialect) IsOperator(s string) bool {
 
-	var oracleOperators = map[string]bool{
-		"^=":  true,
-		"<=":  true,
-		"<":   true,
-		"=":   true,
-		">=":  true,
-		">":   true,
-		"¬=":  true,
-		"||":  true,
-		"<>":  true,
-		"-":   true,
-		":=":  true,
-		"!=":  true,
-		"/":   true,
-		"(+)": true,
-		"*":   true,
-		"+":   true,
-		"=>":  true, // Added fat comma for function/procedure calls
-		"..":  true, // Added for loop ranges
-	}
-
 	// // "!": true,
 	// "!=": false,
 	// // "$": false,
@@ -3025,3 +3258,15 @@ func (d OracleDialect) IsIdentifier(s string) bool {
 
 	return true
 }
+
+// PlaceholderStyles returns the bind parameter placeholder styles
+// supported by Oracle, in order of preference
+func (d OracleDialect) PlaceholderStyles() []int {
+	return []int{ColonNumberPlaceholder, ColonNamePlaceholder}
+}
+
+// IsPlaceholder returns a boolean indicating if the supplied string
+// is considered to be a bind parameter placeholder in Oracle
+func (d OracleDialect) IsPlaceholder(s string) bool {
+	return isPlaceholder(d, s)
+}
@@ -0,0 +1,229 @@
+package dialect
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Placeholders returns the bind parameter placeholder tokens found in
+// the supplied SQL using the rules of the supplied dialect
+func Placeholders(d DbDialect, sql string) []Token {
+
+	var placeholders []Token
+	for _, t := range Tokenize(d, sql) {
+		if t.Type == PlaceholderToken {
+			placeholders = append(placeholders, t)
+		}
+	}
+
+	return placeholders
+}
+
+// RewritePlaceholders rewrites the bind parameter placeholders in the
+// supplied SQL from the placeholder styles of one dialect to a
+// placeholder style of another dialect (e.g. "?" to "$1" when going to
+// PostgreSQL). Placeholders are located using the rules of the "from"
+// dialect so that lookalikes in strings, comments and casts are left
+// alone.
+//
+// The target style is the first style of the "to" dialect that can
+// represent the placeholders without changing the order (or number)
+// of the arguments. Positional "?" placeholders are numbered in order
+// of appearance and named placeholders are numbered in order of first
+// appearance when going to a numbered style. When going to a named
+// style from numbered placeholders the names are p1, p2, etc.
+func RewritePlaceholders(from, to DbDialect, sql string) (string, error) {
+
+	tokens := Tokenize(from, sql)
+
+	// Work out the argument number (and name) for each placeholder
+	var refs []placeholderRef
+	names := make(map[string]int)
+	named := 0
+	maxNum := 0
+
+	for i, t := range tokens {
+		if t.Type != PlaceholderToken {
+			continue
+		}
+
+		r := placeholderRef{idx: i}
+		switch placeholderStyle(from, t.Value) {
+		case QuestionPlaceholder:
+			r.num = maxNum + 1
+		case QuestionNumberPlaceholder, DollarNumberPlaceholder, ColonNumberPlaceholder:
+			r.num, _ = strconv.Atoi(t.Value[1:])
+		default:
+			r.name = t.Value[1:]
+			if _, ok := names[r.name]; !ok {
+				names[r.name] = len(names) + 1
+			}
+			r.num = names[r.name]
+			named++
+		}
+
+		if r.num > maxNum {
+			maxNum = r.num
+		}
+		refs = append(refs, r)
+	}
+
+	if len(refs) == 0 {
+		return sql, nil
+	}
+	if named > 0 && named < len(refs) {
+		return "", errors.New("cannot rewrite a mix of named and positional placeholders")
+	}
+
+	style := 0
+	for _, v := range to.PlaceholderStyles() {
+		if v != QuestionPlaceholder || isSequential(refs) {
+			style = v
+			break
+		}
+	}
+	if style == 0 {
+		return "", fmt.Errorf("cannot rewrite placeholders for %s without reordering or repeating arguments", to.DialectName())
+	}
+
+	for _, r := range refs {
+		tokens[r.idx].Value = formatPlaceholder(style, r.num, r.name)
+	}
+
+	return JoinTokens(tokens), nil
+}
+
+// placeholderRef is a placeholder found in a token stream
+type placeholderRef struct {
+	idx  int    // index of the token
+	num  int    // argument number
+	name string // argument name, for named placeholders
+}
+
+// isSequential returns a boolean indicating if the placeholders refer
+// to the arguments in order with each argument used exactly once
+func isSequential(refs []placeholderRef) bool {
+	for i, r := range refs {
+		if r.num != i+1 {
+			return false
+		}
+	}
+	return true
+}
+
+// formatPlaceholder returns the placeholder of the supplied style for
+// the supplied argument number (and name, for named styles)
+func formatPlaceholder(style, num int, name string) string {
+
+	n := strconv.Itoa(num)
+	if name == "" {
+		name = "p" + n
+	}
+
+	switch style {
+	case QuestionPlaceholder:
+		return "?"
+	case QuestionNumberPlaceholder:
+		return "?" + n
+	case DollarNumberPlaceholder:
+		return "$" + n
+	case DollarNamePlaceholder:
+		return "$" + name
+	case ColonNumberPlaceholder:
+		return ":" + n
+	case ColonNamePlaceholder:
+		return ":" + name
+	case AtNamePlaceholder:
+		return "@" + name
+	}
+
+	return ""
+}
+
+// placeholderStyle returns the placeholder style of the supplied
+// string for the supplied dialect, or 0 if the string is not a
+// placeholder
+func placeholderStyle(d DbDialect, s string) int {
+
+	if s == "" {
+		return 0
+	}
+
+	p, rest := s[0], s[1:]
+
+	// SQLite parameter names may start with a digit, so :1, @1 and $1
+	// are named parameters (that are numbered by first appearance)
+	if d.Dialect() == SQLite && rest != "" && isDigit(rest[0]) && isPlaceholderName("_"+rest) {
+		switch p {
+		case ':':
+			return ColonNamePlaceholder
+		case '@':
+			return AtNamePlaceholder
+		case '$':
+			return DollarNamePlaceholder
+		}
+	}
+
+	switch {
+	case p == '?' && rest == "":
+		return QuestionPlaceholder
+	case p == '?' && isPlaceholderNumber(rest):
+		return QuestionNumberPlaceholder
+	case p == '$' && isPlaceholderNumber(rest):
+		return DollarNumberPlaceholder
+	case p == '$' && isPlaceholderName(rest):
+		return DollarNamePlaceholder
+	case p == ':' && isPlaceholderNumber(rest):
+		return ColonNumberPlaceholder
+	case p == ':' && isPlaceholderName(rest):
+		return ColonNamePlaceholder
+	case p == '@' && isPlaceholderName(rest):
+		return AtNamePlaceholder
+	}
+
+	return 0
+}
+
+// isPlaceholder returns a boolean indicating if the supplied string is
+// a placeholder of one of the styles of the supplied dialect
+func isPlaceholder(d DbDialect, s string) bool {
+
+	style := placeholderStyle(d, s)
+	for _, v := range d.PlaceholderStyles() {
+		if v == style && style != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isPlaceholderNumber(s string) bool {
+
+	if s == "" || s[0] == '0' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPlaceholderName(s string) bool {
+
+	if s == "" || !(isAlpha(s[0]) || s[0] == '_' || s[0] >= 0x80) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if !(isAlpha(c) || isDigit(c) || c == '_' || c == '$' || c == '#' || c >= 0x80) {
+			return false
+		}
+	}
+
+	return true
+}
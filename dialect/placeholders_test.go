@@ -0,0 +1,108 @@
+package dialect
+
+import "testing"
+
+func TestPlaceholders(t *testing.T) {
+
+	tests := []struct {
+		d    DbDialect
+		sql  string
+		want []string
+	}{
+		{NewSQLiteDialect(), "select ?, ?2, :a, @b, $c, :1, @2, $3 from t", []string{"?", "?2", ":a", "@b", "$c", ":1", "@2", "$3"}},
+		{NewSQLiteDialect(), "select :1a, $_x, '?', ?0", []string{":1a", "$_x", "?"}},
+		{NewPostgreSQLDialect(), "select $1, $a, ?, :a, a::int", []string{"$1"}},
+		{NewOracleDialect(), "select :1, :a, :a1, ?, $1 from dual", []string{":1", ":a", ":a1"}},
+		{NewMSSQLDialect(), "select @p1, @a, ?, :a", []string{"@p1", "@a"}},
+		{NewMySQLDialect(), "select ?, :a, $1, @a", []string{"?"}},
+		{NewStandardSQLDialect(), "select ?, :a, :1", []string{"?", ":a"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, tk := range Placeholders(tt.d, tt.sql) {
+			got = append(got, tk.Value)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: Placeholders(%q) = %q, expected %q", tt.d.DialectName(), tt.sql, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: Placeholders(%q) = %q, expected %q", tt.d.DialectName(), tt.sql, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestPlaceholderStyle(t *testing.T) {
+
+	sq, ora := NewSQLiteDialect(), NewOracleDialect()
+
+	tests := []struct {
+		d    DbDialect
+		in   string
+		want int
+	}{
+		{sq, ":1", ColonNamePlaceholder},
+		{sq, "@2", AtNamePlaceholder},
+		{sq, "$3", DollarNamePlaceholder},
+		{sq, "?3", QuestionNumberPlaceholder},
+		{ora, ":1", ColonNumberPlaceholder},
+		{ora, ":a", ColonNamePlaceholder},
+		{NewPostgreSQLDialect(), "$1", DollarNumberPlaceholder},
+		{NewMSSQLDialect(), "@1", 0},
+	}
+
+	for _, tt := range tests {
+		if got := placeholderStyle(tt.d, tt.in); got != tt.want {
+			t.Errorf("%s: placeholderStyle(%q) = %d, expected %d", tt.d.DialectName(), tt.in, got, tt.want)
+		}
+	}
+
+	// SQLite numbers the named parameters by first appearance
+	got, err := RewritePlaceholders(sq, NewPostgreSQLDialect(), "select :2, :1, :2")
+	if want := "select $1, $2, $1"; err != nil || got != want {
+		t.Errorf("RewritePlaceholders(:2, :1, :2) = %q %v, expected %q", got, err, want)
+	}
+}
+
+func TestRewritePlaceholders(t *testing.T) {
+
+	std := NewStandardSQLDialect()
+	ora := NewOracleDialect()
+	pg := NewPostgreSQLDialect()
+
+	tests := []struct {
+		from, to DbDialect
+		sql      string
+		want     string
+		wantErr  bool
+	}{
+		{std, pg, "select ? from t where b = '?' and c = ? -- ?", "select $1 from t where b = '?' and c = $2 -- ?", false},
+		{std, ora, "select ?, ?", "select :1, :2", false},
+		{std, NewMSSQLDialect(), "select ?, ?", "select @p1, @p2", false},
+		{std, NewMySQLDialect(), "select ?, ?", "select ?, ?", false},
+		{std, pg, "select :a, :b, :a", "select $1, $2, $1", false},
+		{ora, pg, "select :2, :1", "select $2, $1", false},
+		{ora, NewMySQLDialect(), "select :2, :1", "", true},
+		{std, pg, "select :a, ?", "", true},
+		{pg, ora, "select $1 from t", "select :1 from t", false},
+		{pg, std, "select 1", "select 1", false},
+		{std, pg, "select a::int, ?", "select a::int, $1", false},
+		{std, pg, "select :a::int, b:::c", "select $1::int, b:::c", false},
+		{NewSQLiteDialect(), ora, "select x::text, ?", "select x::text, :1", false},
+	}
+
+	for _, tt := range tests {
+		got, err := RewritePlaceholders(tt.from, tt.to, tt.sql)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("RewritePlaceholders(%s, %s, %q) error %v", tt.from.DialectName(), tt.to.DialectName(), tt.sql, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("RewritePlaceholders(%s, %s, %q) = %q, expected %q", tt.from.DialectName(), tt.to.DialectName(), tt.sql, got, tt.want)
+		}
+	}
+}
@@ -1,13 +1,28 @@
 package dialect
 
 import (
-	"regexp"
+	"fmt"
 	"strings"
 )
 
 type PostgreSQLDialect struct {
 	dialect int
 	name    string
+
+	// userTypes is the metadata for the registered user-defined types.
+	// It is replaced (never modified) on registration so that copies of
+	// the dialect keep their own registry and the dialect stays
+	// comparable
+	userTypes *pgUserTypes
+}
+
+// pgDefaultSchema is the schema of the default PostgreSQL search_path
+const pgDefaultSchema = "public"
+
+// pgUserTypes is the metadata for the registered user-defined types,
+// by (optionally schema qualified) unquoted name
+type pgUserTypes struct {
+	types map[string]DatatypeInfo
 }
 
 func NewPostgreSQLDialect() PostgreSQLDialect {
@@ -19,6 +34,53 @@ func NewPostgreSQLDialect() PostgreSQLDialect {
 	return d
 }
 
+// RegisterDatatype registers a user-defined datatype (an enum, domain,
+// composite type, etc.) so that it is accepted by ParseDatatype, or
+// returns an error if the name is not a valid (optionally schema
+// qualified) identifier. A schema qualified name (e.g.
+// "public.my_enum") only matches in that schema. The category (e.g.
+// CharacterCategory for an enum) is used for the DatatypeInfo of the
+// datatype.
+//
+// The registry belongs to the dialect value: a copy of the dialect
+// doesn't see datatypes registered after the copy was made, so
+// register the datatypes before copying the dialect. NewDialect
+// returns a *PostgreSQLDialect so that the datatypes can be
+// registered on the returned dialect
+func (d *PostgreSQLDialect) RegisterDatatype(name string, category int) error {
+
+	parts, ok := pgTypeNameParts(d, name)
+	if !ok {
+		return fmt.Errorf("%q is not a valid %s datatype name", name, d.DialectName())
+	}
+
+	key := strings.Join(parts, ".")
+	qualified := make([]string, len(parts))
+	for i, p := range parts {
+		// double quoted identifiers can hold any name so there is no error
+		qualified[i], _ = d.FormatIdentifier(p)
+	}
+
+	types := make(map[string]DatatypeInfo, len(d.registered())+1)
+	for k, v := range d.registered() {
+		types[k] = v
+	}
+	types[key] = DatatypeInfo{Name: strings.Join(qualified, "."), Category: category}
+	d.userTypes = &pgUserTypes{types: types}
+
+	return nil
+}
+
+// registered returns the metadata for the registered user-defined
+// datatypes
+func (d PostgreSQLDialect) registered() map[string]DatatypeInfo {
+
+	if d.userTypes == nil {
+		return nil
+	}
+	return d.userTypes.types
+}
+
 func (d PostgreSQLDialect) Dialect() int {
 	return d.dialect
 }
@@ -44,1058 +106,1570 @@ func (d PostgreSQLDialect) MaxOperatorLength() int {
 	return 63
 }
 
+// LineCommentChars returns the character sequences that start a
+// comment that runs to the end of the line
+func (d PostgreSQLDialect) LineCommentChars() []string {
+	return []string{"--"}
+}
+
+// BlockCommentChars returns the character sequences that start and
+// end a block comment
+func (d PostgreSQLDialect) BlockCommentChars() (string, string) {
+	return "/*", "*/"
+}
+
+// NestedComments returns a boolean indicating if block comments
+// may be nested in PostgreSQL
+func (d PostgreSQLDialect) NestedComments() bool {
+	return true
+}
+
+// IsComment returns a boolean indicating if the supplied string
+// is considered to be a complete comment in PostgreSQL
+func (d PostgreSQLDialect) IsComment(s string) bool {
+	return isComment(d, s)
+}
+
+// IsHint returns a boolean indicating if the supplied string
+// is considered to be an optimizer hint in PostgreSQL
+func (d PostgreSQLDialect) IsHint(s string) bool {
+	return false
+}
+
+// IsExecutableComment returns a boolean indicating if the supplied
+// string is considered to be an executable comment in PostgreSQL
+func (d PostgreSQLDialect) IsExecutableComment(s string) bool {
+	return false
+}
+
+// stringForms returns the forms of string literal supported by
+// PostgreSQL
+func (d PostgreSQLDialect) stringForms() []stringForm {
+
+	// Per https://www.postgresql.org/docs/current/sql-syntax-lexical.html
+	// (assuming standard_conforming_strings is on)
+	return []stringForm{
+		{"", '\'', stringPlain},
+		{"E", '\'', stringEscape},
+		{"N", '\'', stringPlain},
+		{"U&", '\'', stringUnicode},
+		{"X", '\'', stringHex},
+		{"B", '\'', stringBit},
+		{"$", '$', stringDollar},
+	}
+}
+
+// IsStringLiteral returns a boolean indicating if the supplied string
+// is considered to be a complete string literal in PostgreSQL
+func (d PostgreSQLDialect) IsStringLiteral(s string) bool {
+	return isStringLiteral(d.stringForms(), s)
+}
+
+// UnquoteString returns the value of the supplied PostgreSQL string literal
+func (d PostgreSQLDialect) UnquoteString(s string) (string, error) {
+	return unquoteString(d.stringForms(), s)
+}
+
+// QuoteString returns the supplied string quoted as a string literal
+// for PostgreSQL with any special characters escaped
+func (d PostgreSQLDialect) QuoteString(s string) string {
+
+	// Control characters are written using E'...' escapes as they are
+	// easily lost or mangled in plain string literals
+	if hasControlChars(s) {
+		return quoteEscape(s)
+	}
+	return quotePlain(s)
+}
+
+// identQuotes returns the forms of quoted identifier supported by
+// PostgreSQL, with the preferred form first
+func (d PostgreSQLDialect) identQuotes() []identQuote {
+	return []identQuote{
+		{'"', '"', true},
+	}
+}
+
+// QuoteIdentifier returns the supplied identifier quoted for PostgreSQL
+// with any embedded quote characters escaped
+func (d PostgreSQLDialect) QuoteIdentifier(s string) (string, error) {
+	return quoteIdentifier(d.identQuotes()[0], s)
+}
+
+// UnquoteIdentifier returns the name of the supplied quoted PostgreSQL
+// identifier
+func (d PostgreSQLDialect) UnquoteIdentifier(s string) (string, error) {
+	return unquoteIdentifier(d.identQuotes(), s)
+}
+
+// FormatIdentifier returns the supplied identifier as it should be
+// written in PostgreSQL, quoting it only when needed
+func (d PostgreSQLDialect) FormatIdentifier(s string) (string, error) {
+	return formatIdentifier(d, s)
+}
+
+// numberForm returns the forms of numeric literal supported by
+// PostgreSQL
+func (d PostgreSQLDialect) numberForm() numberForm {
+
+	// Non-decimal integer literals and underscores as digit separators
+	// are supported as of PostgreSQL 16
+	return numberForm{hex: true, octal: true, binary: true, underscores: true}
+}
+
+// IsNumericLiteral returns a boolean indicating if the supplied string
+// is considered to be a numeric literal in PostgreSQL
+func (d PostgreSQLDialect) IsNumericLiteral(s string) bool {
+	return isNumericLiteral(d.numberForm(), s)
+}
+
+// ParseNumericLiteral returns the parsed form of the supplied PostgreSQL
+// numeric literal
+func (d PostgreSQLDialect) ParseNumericLiteral(s string) (NumericLiteral, error) {
+	return parseNumericLiteral(d.numberForm(), s)
+}
+
+var pgDatatypes = map[string]bool{
+	"bigint":                           true,
+	"bigserial":                        true,
+	"bit":                              true, // [(n)]
+	"bit (n)":                          true, // [(n)]
+	"bit varying":                      true, // [(n)]
+	"bit varying (n)":                  true, // [(n)]
+	"boolean":                          true,
+	"bool":                             true, // alternate/abbreviated form
+	"box":                              true,
+	"bytea":                            true,
+	"\"char\"":                         true, // datatype seen in pg_catalog
+	"\"char\" (n)":                     true, // datatype seen in pg_catalog
+	"char":                             true, // [(n)] alternate/abbreviated form
+	"char (n)":                         true, // [(n)] alternate/abbreviated form
+	"character":                        true, // [(n)]
+	"character (n)":                    true, // [(n)]
+	"character varying":                true, // [(n)]
+	"character varying (n)":            true, // [(n)]
+	"cidr":                             true,
+	"circle":                           true,
+	"datemultirange":                   true,
+	"daterange":                        true,
+	"date":                             true,
+	"decimal":                          true, // [p[,s])
+	"decimal (n)":                      true, // [p[,s])
+	"decimal (n,n)":                    true, // [p[,s])
+	"double precision":                 true,
+	"float":                            true, // [(p)] alternate/abbreviated form
+	"float (n)":                        true, // [(p)] alternate/abbreviated form
+	"float4":                           true, // alternate/abbreviated form
+	"float8":                           true, // alternate/abbreviated form
+	"inet":                             true,
+	"integer":                          true,
+	"int":                              true, // alternate/abbreviated form
+	"int2":                             true, // alternate/abbreviated form
+	"int4multirange":                   true,
+	"int4range":                        true,
+	"int4":                             true, // alternate/abbreviated form
+	"int8multirange":                   true,
+	"int8range":                        true,
+	"int8":                             true, // alternate/abbreviated form
+	"interval":                         true, // [(p)]
+	"interval (n)":                     true, // [(p)]
+	"interval day to hour":             true, // expanded fields
+	"interval day to minute":           true, // expanded fields
+	"interval day to second":           true, // expanded fields
+	"interval day to second (n)":       true, // expanded fields
+	"interval day":                     true, // expanded fields
+	"interval hour to minute":          true, // expanded fields
+	"interval hour to second":          true, // expanded fields
+	"interval hour to second (n)":      true, // expanded fields
+	"interval hour":                    true, // expanded fields
+	"interval minute to second":        true, // expanded fields
+	"interval minute to second (n)":    true, // expanded fields
+	"interval minute":                  true, // expanded fields
+	"interval month":                   true, // expanded fields
+	"interval second":                  true, // expanded fields
+	"interval second (n)":              true, // expanded fields
+	"interval year to month":           true, // expanded fields
+	"interval year":                    true, // expanded fields
+	"jsonb":                            true,
+	"json":                             true,
+	"line":                             true,
+	"lseg":                             true,
+	"macaddr8":                         true,
+	"macaddr":                          true,
+	"money":                            true,
+	"name":                             true, // datatype seen in pg_catalog
+	"numeric":                          true, // [p[,s])
+	"numeric (n)":                      true, // [p[,s])
+	"numeric (n,n)":                    true, // [p[,s])
+	"nummultirange":                    true,
+	"numrange":                         true,
+	"path":                             true,
+	"pg_lsn":                           true,
+	"pg_snapshot":                      true,
+	"point":                            true,
+	"polygon":                          true,
+	"real":                             true,
+	"serial":                           true,
+	"smallint":                         true,
+	"smallserial":                      true,
+	"text":                             true,
+	"timestamp":                        true,
+	"timestamp (n)":                    true,
+	"timestamp without time zone":      true,
+	"timestamp (n) without time zone":  true,
+	"timestamp with time zone":         true,
+	"timestamp (n) with time zone":     true,
+	"timestamptz":                      true, // alternate/abbreviated form
+	"timestamptz (n)":                  true, // alternate/abbreviated form
+	"timetz":                           true, // alternate/abbreviated form
+	"timetz (n)":                       true, // alternate/abbreviated form
+	"time":                             true,
+	"time (n)":                         true,
+	"time without time zone":           true, // [(p)]
+	"time (n) without time zone":       true, // [(p)]
+	"time with time zone":              true, // [(p)]
+	"time (n) with time zone":          true, // [(p)]
+	"tsmultirange":                     true,
+	"tsquery":                          true,
+	"tsrange":                          true,
+	"tstzmultirange":                   true,
+	"tstzrange":                        true,
+	"tsvector":                         true,
+	"txid_snapshot":                    true,
+	"uuid":                             true,
+	"varchar":                          true, // [(n)]
+	"varchar (n)":                      true, // [(n)]
+	"xml":                              true,
+	"oid":                              true, // object identifier types
+	"regclass":                         true, // object identifier types
+	"regcollation":                     true, // object identifier types
+	"regconfig":                        true, // object identifier types
+	"regdictionary":                    true, // object identifier types
+	"regnamespace":                     true, // object identifier types
+	"regoper":                          true, // object identifier types
+	"regoperator":                      true, // object identifier types
+	"regproc":                          true, // object identifier types
+	"regprocedure":                     true, // object identifier types
+	"regrole":                          true, // object identifier types
+	"regtype":                          true, // object identifier types
+	"box2d":                            true, // PostGIS extension
+	"box3d":                            true, // PostGIS extension
+	"geography":                        true, // PostGIS extension
+	"geography (geometrycollection,n)": true, // PostGIS extension
+	"geography (geometrycollection)":   true, // PostGIS extension
+	"geography (linestring,n)":         true, // PostGIS extension
+	"geography (linestring)":           true, // PostGIS extension
+	"geography (multilinestring,n)":    true, // PostGIS extension
+	"geography (multilinestring)":      true, // PostGIS extension
+	"geography (multipoint,n)":         true, // PostGIS extension
+	"geography (multipoint)":           true, // PostGIS extension
+	"geography (multipolygon,n)":       true, // PostGIS extension
+	"geography (multipolygon)":         true, // PostGIS extension
+	"geography (point,n)":              true, // PostGIS extension
+	"geography (point)":                true, // PostGIS extension
+	"geography (polygon,n)":            true, // PostGIS extension
+	"geography (polygon)":              true, // PostGIS extension
+	"geometry_dump":                    true, // PostGIS extension
+	"geometry":                         true, // PostGIS extension
+	"geometry (geometrycollection,n)":  true, // PostGIS extension
+	"geometry (geometrycollection)":    true, // PostGIS extension
+	"geometry (linestring,n)":          true, // PostGIS extension
+	"geometry (linestring)":            true, // PostGIS extension
+	"geometry (multilinestring,n)":     true, // PostGIS extension
+	"geometry (multilinestring)":       true, // PostGIS extension
+	"geometry (multipoint,n)":          true, // PostGIS extension
+	"geometry (multipoint)":            true, // PostGIS extension
+	"geometry (multipolygon,n)":        true, // PostGIS extension
+	"geometry (multipolygon)":          true, // PostGIS extension
+	"geometry (point,n)":               true, // PostGIS extension
+	"geometry (point)":                 true, // PostGIS extension
+	"geometry (polygon,n)":             true, // PostGIS extension
+	"geometry (polygon)":               true, // PostGIS extension
+}
+
+// pgLengthDatatypes is the set of PostgreSQL datatypes whose
+// parameter is a length (as opposed to a precision)
+var pgLengthDatatypes = map[string]bool{
+	"\"char\"":          true,
+	"bit":               true,
+	"bit varying":       true,
+	"char":              true,
+	"character":         true,
+	"character varying": true,
+	"varchar":           true,
+}
+
+// pgDatatypeParts is the set of words used in PostgreSQL datatypes
+var pgDatatypeParts = datatypeParts(pgDatatypes, "array", "pg_catalog")
+
+// pgDatatypeLimits is the range of the parameters of the PostgreSQL
+// datatypes
+var pgDatatypeLimits = map[string]paramLimits{
+	"char":                        {minLength: 1, maxLength: 10485760},
+	"character":                   {minLength: 1, maxLength: 10485760},
+	"varchar":                     {minLength: 1, maxLength: 10485760},
+	"character varying":           {minLength: 1, maxLength: 10485760},
+	"bit":                         {minLength: 1, maxLength: 83886080},
+	"bit varying":                 {minLength: 1, maxLength: 83886080},
+	"decimal":                     {minPrecision: 1, maxPrecision: 1000, minScale: -1000, maxScale: 1000},
+	"numeric":                     {minPrecision: 1, maxPrecision: 1000, minScale: -1000, maxScale: 1000},
+	"float":                       {minPrecision: 1, maxPrecision: 53},
+	"interval":                    {maxPrecision: 6},
+	"interval day to second":      {maxScale: 6},
+	"interval hour to second":     {maxScale: 6},
+	"interval minute to second":   {maxScale: 6},
+	"interval second":             {maxPrecision: 6},
+	"time":                        {maxPrecision: 6},
+	"time with time zone":         {maxPrecision: 6},
+	"time without time zone":      {maxPrecision: 6},
+	"timestamp":                   {maxPrecision: 6},
+	"timestamp with time zone":    {maxPrecision: 6},
+	"timestamp without time zone": {maxPrecision: 6},
+	"timestamptz":                 {maxPrecision: 6},
+	"timetz":                      {maxPrecision: 6},
+}
+
+// Metadata shared by a number of PostgreSQL datatypes
+var (
+	pgIntervalInfo  = DatatypeInfo{Category: TemporalCategory, Size: 16, MinValue: "-178000000 years", MaxValue: "178000000 years"}
+	pgTimeInfo      = DatatypeInfo{Category: TemporalCategory, Size: 8, MinValue: "00:00:00", MaxValue: "24:00:00"}
+	pgTimeTZInfo    = DatatypeInfo{Category: TemporalCategory, Size: 12, MinValue: "00:00:00+1559", MaxValue: "24:00:00-1559"}
+	pgTimestampInfo = DatatypeInfo{Category: TemporalCategory, Size: 8, MinValue: "4713-01-01 BC", MaxValue: "294276-12-31"}
+)
+
+// pgDatatypeInfo is the metadata for the PostgreSQL datatypes
+var pgDatatypeInfo = map[string]DatatypeInfo{
+	"\"char\"":                    {Category: CharacterCategory, Size: 1},
+	"bigint":                      bigintInfo,
+	"bigserial":                   {Category: ExactNumericCategory, Size: 8, MinValue: "1", MaxValue: "9223372036854775807"},
+	"bit":                         binaryInfo,
+	"bit varying":                 binaryInfo,
+	"bool":                        booleanInfo,
+	"boolean":                     booleanInfo,
+	"box":                         {Category: SpatialCategory, Size: 32},
+	"box2d":                       spatialInfo, // PostGIS
+	"box3d":                       spatialInfo, // PostGIS
+	"bytea":                       binaryInfo,
+	"char":                        charInfo,
+	"character":                   charInfo,
+	"character varying":           charInfo,
+	"cidr":                        otherInfo,
+	"circle":                      {Category: SpatialCategory, Size: 24},
+	"date":                        {Category: TemporalCategory, Size: 4, MinValue: "4713-01-01 BC", MaxValue: "5874897-12-31"},
+	"datemultirange":              otherInfo,
+	"daterange":                   otherInfo,
+	"decimal":                     decimalInfo,
+	"double precision":            doubleInfo,
+	"float":                       doubleInfo,
+	"float4":                      realInfo,
+	"float8":                      doubleInfo,
+	"geography":                   spatialInfo, // PostGIS
+	"geometry":                    spatialInfo, // PostGIS
+	"geometry_dump":               spatialInfo, // PostGIS
+	"inet":                        otherInfo,
+	"int":                         integerInfo,
+	"int2":                        smallintInfo,
+	"int4":                        integerInfo,
+	"int4multirange":              otherInfo,
+	"int4range":                   otherInfo,
+	"int8":                        bigintInfo,
+	"int8multirange":              otherInfo,
+	"int8range":                   otherInfo,
+	"integer":                     integerInfo,
+	"interval":                    pgIntervalInfo,
+	"interval day":                pgIntervalInfo,
+	"interval day to hour":        pgIntervalInfo,
+	"interval day to minute":      pgIntervalInfo,
+	"interval day to second":      pgIntervalInfo,
+	"interval hour":               pgIntervalInfo,
+	"interval hour to minute":     pgIntervalInfo,
+	"interval hour to second":     pgIntervalInfo,
+	"interval minute":             pgIntervalInfo,
+	"interval minute to second":   pgIntervalInfo,
+	"interval month":              pgIntervalInfo,
+	"interval second":             pgIntervalInfo,
+	"interval year":               pgIntervalInfo,
+	"interval year to month":      pgIntervalInfo,
+	"json":                        jsonInfo,
+	"jsonb":                       jsonInfo,
+	"line":                        {Category: SpatialCategory, Size: 24},
+	"lseg":                        {Category: SpatialCategory, Size: 32},
+	"macaddr":                     {Category: OtherCategory, Size: 6},
+	"macaddr8":                    {Category: OtherCategory, Size: 8},
+	"money":                       {Category: ExactNumericCategory, Size: 8, MinValue: "-92233720368547758.08", MaxValue: "92233720368547758.07"},
+	"name":                        {Category: CharacterCategory, Size: 64},
+	"numeric":                     decimalInfo,
+	"nummultirange":               otherInfo,
+	"numrange":                    otherInfo,
+	"oid":                         {Category: OtherCategory, Size: 4},
+	"path":                        spatialInfo,
+	"pg_lsn":                      {Category: OtherCategory, Size: 8},
+	"pg_snapshot":                 otherInfo,
+	"point":                       {Category: SpatialCategory, Size: 16},
+	"polygon":                     spatialInfo,
+	"real":                        realInfo,
+	"regclass":                    {Category: OtherCategory, Size: 4},
+	"regcollation":                {Category: OtherCategory, Size: 4},
+	"regconfig":                   {Category: OtherCategory, Size: 4},
+	"regdictionary":               {Category: OtherCategory, Size: 4},
+	"regnamespace":                {Category: OtherCategory, Size: 4},
+	"regoper":                     {Category: OtherCategory, Size: 4},
+	"regoperator":                 {Category: OtherCategory, Size: 4},
+	"regproc":                     {Category: OtherCategory, Size: 4},
+	"regprocedure":                {Category: OtherCategory, Size: 4},
+	"regrole":                     {Category: OtherCategory, Size: 4},
+	"regtype":                     {Category: OtherCategory, Size: 4},
+	"serial":                      {Category: ExactNumericCategory, Size: 4, MinValue: "1", MaxValue: "2147483647"},
+	"smallint":                    smallintInfo,
+	"smallserial":                 {Category: ExactNumericCategory, Size: 2, MinValue: "1", MaxValue: "32767"},
+	"text":                        charInfo,
+	"time":                        pgTimeInfo,
+	"time with time zone":         pgTimeTZInfo,
+	"time without time zone":      pgTimeInfo,
+	"timestamp":                   pgTimestampInfo,
+	"timestamp with time zone":    pgTimestampInfo,
+	"timestamp without time zone": pgTimestampInfo,
+	"timestamptz":                 pgTimestampInfo,
+	"timetz":                      pgTimeTZInfo,
+	"tsmultirange":                otherInfo,
+	"tsquery":                     otherInfo,
+	"tsrange":                     otherInfo,
+	"tstzmultirange":              otherInfo,
+	"tstzrange":                   otherInfo,
+	"tsvector":                    otherInfo,
+	"txid_snapshot":               deprecated(otherInfo), // replaced by pg_snapshot
+	"uuid":                        {Category: OtherCategory, Size: 16},
+	"varchar":                     charInfo,
+	"xml":                         xmlInfo,
+}
+
+// pgDatatypeAliases maps the PostgreSQL datatype synonyms to their
+// canonical form
+var pgDatatypeAliases = map[string]string{
+	"bool":        "boolean",
+	"char":        "character",
+	"decimal":     "numeric",
+	"float4":      "real",
+	"float8":      "double precision",
+	"int":         "integer",
+	"int2":        "smallint",
+	"int4":        "integer",
+	"int8":        "bigint",
+	"time":        "time without time zone",
+	"timestamp":   "timestamp without time zone",
+	"timestamptz": "timestamp with time zone",
+	"timetz":      "time with time zone",
+	"varchar":     "character varying",
+}
+
 // IsDatatype returns a boolean indicating if the supplied string
 // (or string slice) is considered to be a datatype in PostgreSQL
 func (d PostgreSQLDialect) IsDatatype(s ...string) bool {
+	_, err := d.ParseDatatype(s...)
+	return err == nil
+}
 
-	var pgDatatypes = map[string]bool{
-		"bigint":                           true,
-		"bigserial":                        true,
-		"bit":                              true, // [(n)]
-		"bit (n)":                          true, // [(n)]
-		"bit varying":                      true, // [(n)]
-		"bit varying (n)":                  true, // [(n)]
-		"boolean":                          true,
-		"bool":                             true, // alternate/abbreviated form
-		"box":                              true,
-		"bytea":                            true,
-		"\"char\"":                         true, // datatype seen in pg_catalog
-		"\"char\" (n)":                     true, // datatype seen in pg_catalog
-		"char":                             true, // [(n)] alternate/abbreviated form
-		"char (n)":                         true, // [(n)] alternate/abbreviated form
-		"character":                        true, // [(n)]
-		"character (n)":                    true, // [(n)]
-		"character varying":                true, // [(n)]
-		"character varying (n)":            true, // [(n)]
-		"cidr":                             true,
-		"circle":                           true,
-		"datemultirange":                   true,
-		"daterange":                        true,
-		"date":                             true,
-		"decimal":                          true, // [p[,s])
-		"decimal (n)":                      true, // [p[,s])
-		"decimal (n,n)":                    true, // [p[,s])
-		"double precision":                 true,
-		"float":                            true, // [(p)] alternate/abbreviated form
-		"float4":                           true, // alternate/abbreviated form
-		"float8":                           true, // alternate/abbreviated form
-		"inet":                             true,
-		"integer":                          true,
-		"int":                              true, // alternate/abbreviated form
-		"int2":                             true, // alternate/abbreviated form
-		"int4multirange":                   true,
-		"int4range":                        true,
-		"int4":                             true, // alternate/abbreviated form
-		"int8multirange":                   true,
-		"int8range":                        true,
-		"int8":                             true, // alternate/abbreviated form
-		"interval":                         true, // [(p)]
-		"interval (n)":                     true, // [(p)]
-		"interval day to hour":             true, // expanded fields
-		"interval day to minute":           true, // expanded fields
-		"interval day to second":           true, // expanded fields
-		"interval day":                     true, // expanded fields
-		"interval hour to minute":          true, // expanded fields
-		"interval hour to second":          true, // expanded fields
-		"interval hour":                    true, // expanded fields
-		"interval minute to second":        true, // expanded fields
-		"interval minute":                  true, // expanded fields
-		"interval month":                   true, // expanded fields
-		"interval second":                  true, // expanded fields
-		"interval year to month":           true, // expanded fields
-		"interval year":                    true, // expanded fields
-		"jsonb":                            true,
-		"json":                             true,
-		"line":                             true,
-		"lseg":                             true,
-		"macaddr8":                         true,
-		"macaddr":                          true,
-		"money":                            true,
-		"name":                             true, // datatype seen in pg_catalog
-		"numeric":                          true, // [p[,s])
-		"numeric (n)":                      true, // [p[,s])
-		"numeric (n,n)":                    true, // [p[,s])
-		"nummultirange":                    true,
-		"numrange":                         true,
-		"path":                             true,
-		"pg_lsn":                           true,
-		"pg_snapshot":                      true,
-		"point":                            true,
-		"polygon":                          true,
-		"real":                             true,
-		"serial":                           true,
-		"smallint":                         true,
-		"smallserial":                      true,
-		"text":                             true,
-		"timestamp":                        true,
-		"timestamp (n)":                    true,
-		"timestamp without time zone":      true,
-		"timestamp (n) without time zone":  true,
-		"timestamp with time zone":         true,
-		"timestamp (n) with time zone":     true,
-		"timestamptz":                      true, // alternate/abbreviated form
-		"timetz":                           true, // alternate/abbreviated form
-		"time":                             true,
-		"time (n)":                         true,
-		"time without time zone":           true, // [(p)]
-		"time (n) without time zone":       true, // [(p)]
-		"time with time zone":              true, // [(p)]
-		"time (n) with time zone":          true, // [(p)]
-		"tsmultirange":                     true,
-		"tsquery":                          true,
-		"tsrange":                          true,
-		"tstzmultirange":                   true,
-		"tstzrange":                        true,
-		"tsvector":                         true,
-		"txid_snapshot":                    true,
-		"uuid":                             true,
-		"varchar":                          true, // [(n)]
-		"varchar (n)":                      true, // [(n)]
-		"xml":                              true,
-		"oid":                              true, // object identifier types
-		"regclass":                         true, // object identifier types
-		"regcollation":                     true, // object identifier types
-		"regconfig":                        true, // object identifier types
-		"regdictionary":                    true, // object identifier types
-		"regnamespace":                     true, // object identifier types
-		"regoper":                          true, // object identifier types
-		"regoperator":                      true, // object identifier types
-		"regproc":                          true, // object identifier types
-		"regprocedure":                     true, // object identifier types
-		"regrole":                          true, // object identifier types
-		"regtype":                          true, // object identifier types
-		"box2d":                            true, // PostGIS extension
-		"box3d":                            true, // PostGIS extension
-		"geography":                        true, // PostGIS extension
-		"geography (geometrycollection,n)": true, // PostGIS extension
-		"geography (geometrycollection)":   true, // PostGIS extension
-		"geography (linestring,n)":         true, // PostGIS extension
-		"geography (linestring)":           true, // PostGIS extension
-		"geography (multilinestring,n)":    true, // PostGIS extension
-		"geography (multilinestring)":      true, // PostGIS extension
-		"geography (multipoint,n)":         true, // PostGIS extension
-		"geography (multipoint)":           true, // PostGIS extension
-		"geography (multipolygon,n)":       true, // PostGIS extension
-		"geography (multipolygon)":         true, // PostGIS extension
-		"geography (point,n)":              true, // PostGIS extension
-		"geography (point)":                true, // PostGIS extension
-		"geography (polygon,n)":            true, // PostGIS extension
-		"geography (polygon)":              true, // PostGIS extension
-		"geometry_dump":                    true, // PostGIS extension
-		"geometry":                         true, // PostGIS extension
-		"geometry (geometrycollection,n)":  true, // PostGIS extension
-		"geometry (geometrycollection)":    true, // PostGIS extension
-		"geometry (linestring,n)":          true, // PostGIS extension
-		"geometry (linestring)":            true, // PostGIS extension
-		"geometry (multilinestring,n)":     true, // PostGIS extension
-		"geometry (multilinestring)":       true, // PostGIS extension
-		"geometry (multipoint,n)":          true, // PostGIS extension
-		"geometry (multipoint)":            true, // PostGIS extension
-		"geometry (multipolygon,n)":        true, // PostGIS extension
-		"geometry (multipolygon)":          true, // PostGIS extension
-		"geometry (point,n)":               true, // PostGIS extension
-		"geometry (point)":                 true, // PostGIS extension
-		"geometry (polygon,n)":             true, // PostGIS extension
-		"geometry (polygon)":               true, // PostGIS extension
-	}
+// ParseDatatype returns the parsed form of the supplied datatype
+// tokens, or an error if they are not a valid PostgreSQL datatype.
+// Besides the built in datatypes (which may be qualified with the
+// pg_catalog schema) this accepts arrays (e.g. "int[]", "text[][]" or
+// "integer array[3]") and the registered user-defined datatypes (see
+// RegisterDatatype)
+func (d PostgreSQLDialect) ParseDatatype(tokens ...string) (Datatype, error) {
 
-	var z []string
-	rn := regexp.MustCompile(`^[0-9]+$`)
-	pv := ""
+	schema, toks := pgSplitSchema(d, splitDatatype(tokens))
+	err := fmt.Errorf("%q is not a valid %s datatype", strings.Join(tokens, " "), d.DialectName())
 
-	for i, v := range s {
-		switch v {
-		case "(":
-			z = append(z, " "+v)
-		case ")", ",", "[", "]":
-			z = append(z, v)
-		default:
-			switch {
-			case rn.MatchString(v):
-				z = append(z, "n")
-			case i == 0:
-				z = append(z, v)
-			case pv == "(":
-				z = append(z, v)
-			default:
-				z = append(z, " "+v)
+	if schema == "" || schema == "pg_catalog" {
+		var t Datatype
+		t, err = parseDatatype(d, d.parseRules(), toks)
+		if err == nil {
+			t.Schema = schema
+			return t, nil
+		}
+	}
+
+	// user-defined datatypes don't take parameters. The names are
+	// quoted as needed so that the datatype string can be parsed again
+	toks, dims := splitArrayDims(toks)
+	if len(toks) == 1 {
+		name := pgIdentName(d, toks[0])
+		if _, ok := d.userType(schema, name); ok {
+			t := Datatype{ArrayDims: dims}
+			t.Name, _ = d.FormatIdentifier(name)
+			if schema != "" {
+				t.Schema, _ = d.FormatIdentifier(schema)
 			}
+			return t, nil
 		}
-		pv = v
 	}
 
-	k := strings.ToLower(strings.Join(z, ""))
-	if _, ok := pgDatatypes[k]; ok {
-		return true
+	return Datatype{}, err
+}
+
+// parseRules returns the rules for parsing PostgreSQL datatypes
+func (d PostgreSQLDialect) parseRules() datatypeRules {
+
+	// A negative numeric scale is allowed as of PostgreSQL 15
+	return datatypeRules{
+		types:   pgDatatypes,
+		signed:  true,
+		arrays:  true,
+		lengths: pgLengthDatatypes,
+		limits:  pgDatatypeLimits,
 	}
+}
+
+// IsDatatypePart returns a boolean indicating if the supplied string
+// is considered to be part of a datatype definition in PostgreSQL
+func (d PostgreSQLDialect) IsDatatypePart(s string) bool {
 
-	// Check for an array of the datatype
-	k = strings.TrimRight(k, "[]")
-	if _, ok := pgDatatypes[k]; ok {
+	switch {
+	case pgDatatypeParts[strings.ToLower(s)], s == ".":
 		return true
+	case strings.Contains(s, "."):
+		// a schema qualified name
+		return d.IsDatatype(s)
+	}
+
+	// the schemas and names of the user-defined datatypes
+	n := pgIdentName(d, s)
+	for k := range d.registered() {
+		for _, p := range strings.Split(k, ".") {
+			if p == n {
+				return true
+			}
+		}
 	}
 
 	return false
 }
 
-func (d PostgreSQLDialect) keyword(s string) (bool, bool) {
+// DatatypeInfo returns the metadata for the named PostgreSQL datatype
+func (d PostgreSQLDialect) DatatypeInfo(name string) (DatatypeInfo, bool) {
 
-	/*
-	   PostgreSQL keywords
+	if i, ok := datatypeInfo(d, pgDatatypeInfo, name); ok {
+		return i, true
+	}
 
-	   https://www.postgresql.org/docs/current/sql-keywords-appendix.html
+	t, err := d.ParseDatatype(name)
+	if err != nil {
+		return DatatypeInfo{}, false
+	}
 
-	*/
+	i, ok := d.userType(pgIdentName(d, t.Schema), pgIdentName(d, t.Name))
+	if !ok {
+		return DatatypeInfo{}, false
+	}
+	i.Name = Datatype{Schema: t.Schema, Name: t.Name}.String()
+
+	return i, true
+}
+
+// Datatypes returns the metadata for all of the PostgreSQL datatypes
+func (d PostgreSQLDialect) Datatypes() []DatatypeInfo {
+
+	infos := make(map[string]DatatypeInfo, len(pgDatatypeInfo)+len(d.registered()))
+	for k, v := range pgDatatypeInfo {
+		infos[k] = v
+	}
+	for _, v := range d.registered() {
+		infos[v.Name] = v
+	}
+
+	return datatypeInfos(infos)
+}
+
+// userType returns the metadata for the registered user-defined
+// datatype with the supplied schema (if any) and name. A schema
+// qualified name matches the registrations for that schema, or the
+// unqualified registrations when the schema is on the default
+// search_path
+func (d PostgreSQLDialect) userType(schema, name string) (DatatypeInfo, bool) {
+
+	if schema != "" {
+		if i, ok := d.registered()[schema+"."+name]; ok {
+			return i, true
+		}
+		if schema != pgDefaultSchema {
+			return DatatypeInfo{}, false
+		}
+		// an unqualified name is registered for the search_path,
+		// which is the public schema by default
+		i, ok := d.registered()[name]
+		return i, ok
+	}
+
+	if i, ok := d.registered()[name]; ok {
+		return i, true
+	}
+
+	// an unqualified name may be any schema (as for the search_path)
+	for k, i := range d.registered() {
+		if strings.HasSuffix(k, "."+name) {
+			return i, true
+		}
+	}
+
+	return DatatypeInfo{}, false
+}
+
+// pgSplitSchema returns the schema of a schema qualified datatype and
+// the datatype tokens with the schema removed. The qualified name may
+// be a single token ("public.my_enum") or separate tokens ("public",
+// ".", "my_enum")
+func pgSplitSchema(d DbDialect, toks []string) (string, []string) {
+
+	for len(toks) > 2 && toks[1] == "." {
+		toks = append([]string{toks[0] + "." + toks[2]}, toks[3:]...)
+	}
+	if len(toks) == 0 {
+		return "", toks
+	}
+
+	// the last dot that isn't in a quoted identifier
+	dot := -1
+	quoted := false
+	for i := 0; i < len(toks[0]); i++ {
+		switch toks[0][i] {
+		case '"':
+			quoted = !quoted
+		case '.':
+			if !quoted {
+				dot = i
+			}
+		}
+	}
+	if dot < 0 {
+		return "", toks
+	}
+
+	schema := pgIdentName(d, toks[0][:dot])
+	return schema, append([]string{toks[0][dot+1:]}, toks[1:]...)
+}
+
+// pgTypeNameParts returns the unquoted schema (if any) and name of the
+// supplied user-defined datatype name, or false if it is not a valid
+// (optionally schema qualified) identifier
+func pgTypeNameParts(d DbDialect, name string) ([]string, bool) {
+
+	var parts []string
+	dot := true
+	for _, t := range Tokenize(d, name) {
+		switch {
+		case t.Type == WhitespaceToken:
+		case t.Value == ".":
+			if dot {
+				return nil, false
+			}
+			dot = true
+		case !dot:
+			return nil, false
+		case t.Type == IdentifierToken, t.Type == KeywordToken:
+			parts = append(parts, strings.ToLower(t.Value))
+			dot = false
+		case t.Type == QuotedIdentifierToken:
+			u, err := d.UnquoteIdentifier(t.Value)
+			if err != nil || u == "" {
+				return nil, false
+			}
+			parts = append(parts, u)
+			dot = false
+		default:
+			return nil, false
+		}
+	}
+
+	return parts, !dot && len(parts) <= 2
+}
+
+// pgIdentName returns the supplied (possibly quoted) identifier as it
+// is stored, unquoted identifiers are folded to lower case
+func pgIdentName(d DbDialect, s string) string {
+
+	if strings.HasPrefix(s, "\"") {
+		if u, err := d.UnquoteIdentifier(s); err == nil {
+			return u
+		}
+	}
+
+	return strings.ToLower(s)
+}
+
+// CanonicalDatatype returns the supplied datatype with any synonym
+// replaced by the canonical PostgreSQL form (e.g. int4 becomes integer)
+func (d PostgreSQLDialect) CanonicalDatatype(t Datatype) Datatype {
 
-	// map[keyword]isReserved
-	var pgKeywords = map[string]bool{
-		"ABORT":                         false,
-		"ACCESS":                        false,
-		"AGGREGATE":                     false,
-		"ALSO":                          false,
-		"ANALYSE":                       true,
-		"ANALYZE":                       true,
-		"ATTACH":                        false,
-		"BACKWARD":                      false,
-		"BIT":                           false,
-		"BIT_LENGTH":                    false,
-		"CACHE":                         false,
-		"CHECKPOINT":                    false,
-		"CLASS":                         false,
-		"CLUSTER":                       false,
-		"COMMENT":                       false,
-		"COMMENTS":                      false,
-		"COMPRESSION":                   false,
-		"CONCURRENTLY":                  true,
-		"CONFIGURATION":                 false,
-		"CONFLICT":                      false,
-		"CONVERSION":                    false,
-		"COST":                          false,
-		"CSV":                           false,
-		"DATABASE":                      false,
-		"DELIMITER":                     false,
-		"DELIMITERS":                    false,
-		"DEPENDS":                       false,
-		"DETACH":                        false,
-		"DICTIONARY":                    false,
-		"DISABLE":                       false,
-		"DISCARD":                       false,
-		"DO":                            true,
-		"ENABLE":                        false,
-		"ENCRYPTED":                     false,
-		"ENUM":                          false,
-		"EVENT":                         false,
-		"EXCEPTION":                     false,
-		"EXCLUSIVE":                     false,
-		"EXPLAIN":                       false,
-		"EXTENSION":                     false,
-		"FAMILY":                        false,
-		"FINALIZE":                      false,
-		"FORCE":                         false,
-		"FORWARD":                       false,
-		"FREEZE":                        true,
-		"FUNCTIONS":                     false,
-		"HANDLER":                       false,
-		"HEADER":                        false,
-		"IF":                            false,
-		"ILIKE":                         true,
-		"IMMUTABLE":                     false,
-		"IMPLICIT":                      false,
-		"INCLUDE":                       false,
-		"INDEX":                         false,
-		"INDEXES":                       false,
-		"INHERIT":                       false,
-		"INHERITS":                      false,
-		"INLINE":                        false,
-		"ISNULL":                        true,
-		"LABEL":                         false,
-		"LEAKPROOF":                     false,
-		"LISTEN":                        false,
-		"LOAD":                          false,
-		"LOCK":                          false,
-		"LOCKED":                        false,
-		"LOGGED":                        false,
-		"MATERIALIZED":                  false,
-		"MODE":                          false,
-		"MOVE":                          false,
-		"NOTHING":                       false,
-		"NOTIFY":                        false,
-		"NOTNULL":                       true,
-		"NOWAIT":                        false,
-		"OIDS":                          false,
-		"OPERATOR":                      false,
-		"OWNED":                         false,
-		"OWNER":                         false,
-		"PARALLEL":                      false,
-		"PARSER":                        false,
-		"PASSWORD":                      false,
-		"PLANS":                         false,
-		"POLICY":                        false,
-		"PREPARED":                      false,
-		"PROCEDURAL":                    false,
-		"PROCEDURES":                    false,
-		"PROGRAM":                       false,
-		"PUBLICATION":                   false,
-		"QUOTE":                         false,
-		"REASSIGN":                      false,
-		"RECHECK":                       false,
-		"REFRESH":                       false,
-		"REINDEX":                       false,
-		"RENAME":                        false,
-		"REPLACE":                       false,
-		"REPLICA":                       false,
-		"RESET":                         false,
-		"ROUTINES":                      false,
-		"RULE":                          false,
-		"SCHEMAS":                       false,
-		"SEQUENCES":                     false,
-		"SETOF":                         false,
-		"SHARE":                         false,
-		"SNAPSHOT":                      false,
-		"SQLCODE":                       false,
-		"SQLERROR":                      false,
-		"STABLE":                        false,
-		"STATISTICS":                    false,
-		"STDIN":                         false,
-		"STDOUT":                        false,
-		"STORAGE":                       false,
-		"STORED":                        false,
-		"STRICT":                        false,
-		"SUBSCRIPTION":                  false,
-		"SUPPORT":                       false,
-		"SYSID":                         false,
-		"TABLES":                        false,
-		"TABLESPACE":                    false,
-		"TEMP":                          false,
-		"TEMPLATE":                      false,
-		"TEXT":                          false,
-		"TRUSTED":                       false,
-		"TYPES":                         false,
-		"UNENCRYPTED":                   false,
-		"UNLISTEN":                      false,
-		"UNLOGGED":                      false,
-		"UNTIL":                         false,
-		"VACUUM":                        false,
-		"VALIDATE":                      false,
-		"VALIDATOR":                     false,
-		"VARIADIC":                      true,
-		"VERBOSE":                       true,
-		"VIEWS":                         false,
-		"VOLATILE":                      false,
-		"XMLROOT":                       false,
-		"A":                             false,
-		"ABSOLUTE":                      false,
-		"ACCORDING":                     false,
-		"ACTION":                        false,
-		"ADA":                           false,
-		"ADD":                           false,
-		"ADMIN":                         false,
-		"AFTER":                         false,
-		"ALWAYS":                        false,
-		"ASC":                           true,
-		"ASSERTION":                     false,
-		"ASSIGNMENT":                    false,
-		"ATTRIBUTE":                     false,
-		"ATTRIBUTES":                    false,
-		"BASE64":                        false,
-		"BEFORE":                        false,
-		"BERNOULLI":                     false,
-		"BLOCKED":                       false,
-		"BOM":                           false,
-		"BREADTH":                       false,
-		"C":                             false,
-		"CASCADE":                       false,
-		"CATALOG":                       false,
-		"CATALOG_NAME":                  false,
-		"CHAIN":                         false,
-		"CHAINING":                      false,
-		"CHARACTER_​SET_​CATALOG":       false,
-		"CHARACTER_SET_NAME":            false,
-		"CHARACTER_SET_SCHEMA":          false,
-		"CHARACTERISTICS":               false,
-		"CHARACTERS":                    false,
-		"CLASS_ORIGIN":                  false,
-		"COBOL":                         false,
-		"COLLATION":                     true,
-		"COLLATION_CATALOG":             false,
-		"COLLATION_NAME":                false,
-		"COLLATION_SCHEMA":              false,
-		"COLUMN_NAME":                   false,
-		"COLUMNS":                       false,
-		"COMMAND_FUNCTION":              false,
-		"COMMAND_​FUNCTION_​CODE":       false,
-		"COMMITTED":                     false,
-		"CONDITION_NUMBER":              false,
-		"CONDITIONAL":                   false,
-		"CONNECTION":                    false,
-		"CONNECTION_NAME":               false,
-		"CONSTRAINT_CATALOG":            false,
-		"CONSTRAINT_NAME":               false,
-		"CONSTRAINT_SCHEMA":             false,
-		"CONSTRAINTS":                   false,
-		"CONSTRUCTOR":                   false,
-		"CONTENT":                       false,
-		"CONTINUE":                      false,
-		"CONTROL":                       false,
-		"COPARTITION":                   false,
-		"CURSOR_NAME":                   false,
-		"DATA":                          false,
-		"DATETIME_​INTERVAL_​CODE":      false,
-		"DATETIME_​INTERVAL_​PRECISION": false,
-		"DB":                            false,
-		"DEFAULTS":                      false,
-		"DEFERRABLE":                    true,
-		"DEFERRED":                      false,
-		"DEFINED":                       false,
-		"DEFINER":                       false,
-		"DEGREE":                        false,
-		"DEPTH":                         false,
-		"DERIVED":                       false,
-		"DESC":                          true,
-		"DESCRIPTOR":                    false,
-		"DIAGNOSTICS":                   false,
-		"DISPATCH":                      false,
-		"DOCUMENT":                      false,
-		"DOMAIN":                        false,
-		"DYNAMIC_FUNCTION":              false,
-		"DYNAMIC_​FUNCTION_​CODE":       false,
-		"ENCODING":                      false,
-		"ENFORCED":                      false,
-		"ERROR":                         false,
-		"EXCLUDE":                       false,
-		"EXCLUDING":                     false,
-		"EXPRESSION":                    false,
-		"FILE":                          false,
-		"FINAL":                         false,
-		"FINISH":                        false,
-		"FIRST":                         false,
-		"FLAG":                          false,
-		"FOLLOWING":                     false,
-		"FORMAT":                        false,
-		"FORTRAN":                       false,
-		"FOUND":                         false,
-		"FS":                            false,
-		"FULFILL":                       false,
-		"G":                             false,
-		"GENERAL":                       false,
-		"GENERATED":                     false,
-		"GO":                            false,
-		"GOTO":                          false,
-		"GRANTED":                       false,
-		"HEX":                           false,
-		"HIERARCHY":                     false,
-		"ID":                            false,
-		"IGNORE":                        false,
-		"IMMEDIATE":                     false,
-		"IMMEDIATELY":                   false,
-		"IMPLEMENTATION":                false,
-		"INCLUDING":                     false,
-		"INCREMENT":                     false,
-		"INDENT":                        false,
-		"INITIALLY":                     true,
-		"INPUT":                         false,
-		"INSTANCE":                      false,
-		"INSTANTIABLE":                  false,
-		"INSTEAD":                       false,
-		"INTEGRITY":                     false,
-		"INVOKER":                       false,
-		"ISOLATION":                     false,
-		"K":                             false,
-		"KEEP":                          false,
-		"KEY":                           false,
-		"KEY_MEMBER":                    false,
-		"KEY_TYPE":                      false,
-		"KEYS":                          false,
-		"LAST":                          false,
-		"LENGTH":                        false,
-		"LEVEL":                         false,
-		"LIBRARY":                       false,
-		"LIMIT":                         true,
-		"LINK":                          false,
-		"LOCATION":                      false,
-		"LOCATOR":                       false,
-		"M":                             false,
-		"MAP":                           false,
-		"MAPPING":                       false,
-		"MATCHED":                       false,
-		"MAXVALUE":                      false,
-		"MEASURES":                      false,
-		"MESSAGE_LENGTH":                false,
-		"MESSAGE_OCTET_LENGTH":          false,
-		"MESSAGE_TEXT":                  false,
-		"MINVALUE":                      false,
-		"MORE":                          false,
-		"MUMPS":                         false,
-		"NAME":                          false,
-		"NAMES":                         false,
-		"NAMESPACE":                     false,
-		"NESTED":                        false,
-		"NESTING":                       false,
-		"NEXT":                          false,
-		"NFC":                           false,
-		"NFD":                           false,
-		"NFKC":                          false,
-		"NFKD":                          false,
-		"NIL":                           false,
-		"NORMALIZED":                    false,
-		"NULL_ORDERING":                 false,
-		"NULLABLE":                      false,
-		"NULLS":                         false,
-		"NUMBER":                        false,
-		"OBJECT":                        false,
-		"OCCURRENCE":                    false,
-		"OCTETS":                        false,
-		"OFF":                           false,
-		"OPTION":                        false,
-		"OPTIONS":                       false,
-		"ORDERING":                      false,
-		"ORDINALITY":                    false,
-		"OTHERS":                        false,
-		"OUTPUT":                        false,
-		"OVERFLOW":                      false,
-		"OVERRIDING":                    false,
-		"P":                             false,
-		"PAD":                           false,
-		"PARAMETER_MODE":                false,
-		"PARAMETER_NAME":                false,
-		"PARAMETER_​ORDINAL_​POSITION":  false,
-		"PARAMETER_​SPECIFIC_​CATALOG":  false,
-		"PARAMETER_​SPECIFIC_​NAME":     false,
-		"PARAMETER_​SPECIFIC_​SCHEMA":   false,
-		"PARTIAL":                       false,
-		"PASCAL":                        false,
-		"PASS":                          false,
-		"PASSING":                       false,
-		"PASSTHROUGH":                   false,
-		"PAST":                          false,
-		"PATH":                          false,
-		"PERMISSION":                    false,
-		"PERMUTE":                       false,
-		"PIPE":                          false,
-		"PLACING":                       true,
-		"PLAN":                          false,
-		"PLI":                           false,
-		"PRECEDING":                     false,
-		"PRESERVE":                      false,
-		"PREV":                          false,
-		"PRIOR":                         false,
-		"PRIVATE":                       false,
-		"PRIVILEGES":                    false,
-		"PRUNE":                         false,
-		"PUBLIC":                        false,
-		"QUOTES":                        false,
-		"READ":                          false,
-		"RECOVERY":                      false,
-		"RELATIVE":                      false,
-		"REPEATABLE":                    false,
-		"REQUIRING":                     false,
-		"RESPECT":                       false,
-		"RESTART":                       false,
-		"RESTORE":                       false,
-		"RESTRICT":                      false,
-		"RETURNED_CARDINALITY":          false,
-		"RETURNED_LENGTH":               false,
-		"RETURNED_​OCTET_​LENGTH":       false,
-		"RETURNED_SQLSTATE":             false,
-		"RETURNING":                     true,
-		"ROLE":                          false,
-		"ROUTINE":                       false,
-		"ROUTINE_CATALOG":               false,
-		"ROUTINE_NAME":                  false,
-		"ROUTINE_SCHEMA":                false,
-		"ROW_COUNT":                     false,
-		"SCALAR":                        false,
-		"SCALE":                         false,
-		"SCHEMA":                        false,
-		"SCHEMA_NAME":                   false,
-		"SCOPE_CATALOG":                 false,
-		"SCOPE_NAME":                    false,
-		"SCOPE_SCHEMA":                  false,
-		"SECTION":                       false,
-		"SECURITY":                      false,
-		"SELECTIVE":                     false,
-		"SELF":                          false,
-		"SEMANTICS":                     false,
-		"SEQUENCE":                      false,
-		"SERIALIZABLE":                  false,
-		"SERVER":                        false,
-		"SERVER_NAME":                   false,
-		"SESSION":                       false,
-		"SETS":                          false,
-		"SIMPLE":                        false,
-		"SIZE":                          false,
-		"SORT_DIRECTION":                false,
-		"SOURCE":                        false,
-		"SPACE":                         false,
-		"SPECIFIC_NAME":                 false,
-		"STANDALONE":                    false,
-		"STATE":                         false,
-		"STATEMENT":                     false,
-		"STRING":                        false,
-		"STRIP":                         false,
-		"STRUCTURE":                     false,
-		"STYLE":                         false,
-		"SUBCLASS_ORIGIN":               false,
-		"T":                             false,
-		"TABLE_NAME":                    false,
-		"TEMPORARY":                     false,
-		"THROUGH":                       false,
-		"TIES":                          false,
-		"TOKEN":                         false,
-		"TOP_LEVEL_COUNT":               false,
-		"TRANSACTION":                   false,
-		"TRANSACTION_ACTIVE":            false,
-		"TRANSACTIONS_​COMMITTED":       false,
-		"TRANSACTIONS_​ROLLED_​BACK":    false,
-		"TRANSFORM":                     false,
-		"TRANSFORMS":                    false,
-		"TRIGGER_CATALOG":               false,
-		"TRIGGER_NAME":                  false,
-		"TRIGGER_SCHEMA":                false,
-		"TYPE":                          false,
-		"UNBOUNDED":                     false,
-		"UNCOMMITTED":                   false,
-		"UNCONDITIONAL":                 false,
-		"UNDER":                         false,
-		"UNLINK":                        false,
-		"UNMATCHED":                     false,
-		"UNNAMED":                       false,
-		"UNTYPED":                       false,
-		"URI":                           false,
-		"USAGE":                         false,
-		"USER_​DEFINED_​TYPE_​CATALOG":  false,
-		"USER_​DEFINED_​TYPE_​CODE":     false,
-		"USER_​DEFINED_​TYPE_​NAME":     false,
-		"USER_​DEFINED_​TYPE_​SCHEMA":   false,
-		"UTF16":                         false,
-		"UTF32":                         false,
-		"UTF8":                          false,
-		"VALID":                         false,
-		"VERSION":                       false,
-		"VIEW":                          false,
-		"WHITESPACE":                    false,
-		"WORK":                          false,
-		"WRAPPER":                       false,
-		"WRITE":                         false,
-		"XMLDECLARATION":                false,
-		"XMLSCHEMA":                     false,
-		"YES":                           false,
-		"ZONE":                          false,
-		"FALSE":                         true,
-		"TRUE":                          true,
-		"ABS":                           true,
-		"ABSENT":                        true,
-		"ACOS":                          true,
-		"ALL":                           true,
-		"ALLOCATE":                      true,
-		"ALTER":                         true,
-		"AND":                           true,
-		"ANY":                           true,
-		"ANY_VALUE":                     true,
-		"ARE":                           true,
-		"ARRAY":                         true,
-		"ARRAY_AGG":                     true,
-		"ARRAY_​MAX_​CARDINALITY":       true,
-		"AS":                            true,
-		"ASENSITIVE":                    true,
-		"ASIN":                          true,
-		"ASYMMETRIC":                    true,
-		"AT":                            true,
-		"ATAN":                          true,
-		"ATOMIC":                        true,
-		"AUTHORIZATION":                 true,
-		"AVG":                           true,
-		"BEGIN":                         true,
-		"BEGIN_FRAME":                   true,
-		"BEGIN_PARTITION":               true,
-		"BETWEEN":                       true,
-		"BIGINT":                        true,
-		"BINARY":                        true,
-		"BLOB":                          true,
-		"BOOLEAN":                       true,
-		"BOTH":                          true,
-		"BTRIM":                         true,
-		"BY":                            true,
-		"CALL":                          true,
-		"CALLED":                        true,
-		"CARDINALITY":                   true,
-		"CASCADED":                      true,
-		"CASE":                          true,
-		"CAST":                          true,
-		"CEIL":                          true,
-		"CEILING":                       true,
-		"CHAR":                          true,
-		"CHAR_LENGTH":                   true,
-		"CHARACTER":                     true,
-		"CHARACTER_LENGTH":              true,
-		"CHECK":                         true,
-		"CLASSIFIER":                    true,
-		"CLOB":                          true,
-		"CLOSE":                         true,
-		"COALESCE":                      true,
-		"COLLATE":                       true,
-		"COLLECT":                       true,
-		"COLUMN":                        true,
-		"COMMIT":                        true,
-		"CONDITION":                     true,
-		"CONNECT":                       true,
-		"CONSTRAINT":                    true,
-		"CONTAINS":                      true,
-		"CONVERT":                       true,
-		"COPY":                          true,
-		"CORR":                          true,
-		"CORRESPONDING":                 true,
-		"COS":                           true,
-		"COSH":                          true,
-		"COUNT":                         true,
-		"COVAR_POP":                     true,
-		"COVAR_SAMP":                    true,
-		"CREATE":                        true,
-		"CROSS":                         true,
-		"CUBE":                          true,
-		"CUME_DIST":                     true,
-		"CURRENT":                       true,
-		"CURRENT_CATALOG":               true,
-		"CURRENT_DATE":                  true,
-		"CURRENT_​DEFAULT_​TRANSFORM_​GROUP": true,
-		"CURRENT_PATH":      true,
-		"CURRENT_ROLE":      true,
-		"CURRENT_ROW":       true,
-		"CURRENT_SCHEMA":    true,
-		"CURRENT_TIME":      true,
-		"CURRENT_TIMESTAMP": true,
-		"CURRENT_​TRANSFORM_​GROUP_​FOR_​TYPE": true,
-		"CURRENT_USER":         true,
-		"CURSOR":               true,
-		"CYCLE":                true,
-		"DATALINK":             true,
-		"DATE":                 true,
-		"DAY":                  true,
-		"DEALLOCATE":           true,
-		"DEC":                  true,
-		"DECFLOAT":             true,
-		"DECIMAL":              true,
-		"DECLARE":              true,
-		"DEFAULT":              true,
-		"DEFINE":               true,
-		"DELETE":               true,
-		"DENSE_RANK":           true,
-		"DEREF":                true,
-		"DESCRIBE":             true,
-		"DETERMINISTIC":        true,
-		"DISCONNECT":           true,
-		"DISTINCT":             true,
-		"DLNEWCOPY":            true,
-		"DLPREVIOUSCOPY":       true,
-		"DLURLCOMPLETE":        true,
-		"DLURLCOMPLETEONLY":    true,
-		"DLURLCOMPLETEWRITE":   true,
-		"DLURLPATH":            true,
-		"DLURLPATHONLY":        true,
-		"DLURLPATHWRITE":       true,
-		"DLURLSCHEME":          true,
-		"DLURLSERVER":          true,
-		"DLVALUE":              true,
-		"DOUBLE":               true,
-		"DROP":                 true,
-		"DYNAMIC":              true,
-		"EACH":                 true,
-		"ELEMENT":              true,
-		"ELSE":                 true,
-		"EMPTY":                true,
-		"END":                  true,
-		"END_FRAME":            true,
-		"END_PARTITION":        true,
-		"END-EXEC":             true,
-		"EQUALS":               true,
-		"ESCAPE":               true,
-		"EVERY":                true,
-		"EXCEPT":               true,
-		"EXEC":                 true,
-		"EXECUTE":              true,
-		"EXISTS":               true,
-		"EXP":                  true,
-		"EXTERNAL":             true,
-		"EXTRACT":              true,
-		"FETCH":                true,
-		"FILTER":               true,
-		"FIRST_VALUE":          true,
-		"FLOAT":                true,
-		"FLOOR":                true,
-		"FOR":                  true,
-		"FOREIGN":              true,
-		"FRAME_ROW":            true,
-		"FREE":                 true,
-		"FROM":                 true,
-		"FULL":                 true,
-		"FUNCTION":             true,
-		"FUSION":               true,
-		"GET":                  true,
-		"GLOBAL":               true,
-		"GRANT":                true,
-		"GREATEST":             true,
-		"GROUP":                true,
-		"GROUPING":             true,
-		"GROUPS":               true,
-		"HAVING":               true,
-		"HOLD":                 true,
-		"HOUR":                 true,
-		"IDENTITY":             true,
-		"IMPORT":               true,
-		"IN":                   true,
-		"INDICATOR":            true,
-		"INITIAL":              true,
-		"INNER":                true,
-		"INOUT":                true,
-		"INSENSITIVE":          true,
-		"INSERT":               true,
-		"INT":                  true,
-		"INTEGER":              true,
-		"INTERSECT":            true,
-		"INTERSECTION":         true,
-		"INTERVAL":             true,
-		"INTO":                 true,
-		"IS":                   true,
-		"JOIN":                 true,
-		"JSON":                 true,
-		"JSON_ARRAY":           true,
-		"JSON_ARRAYAGG":        true,
-		"JSON_EXISTS":          true,
-		"JSON_OBJECT":          true,
-		"JSON_OBJECTAGG":       true,
-		"JSON_QUERY":           true,
-		"JSON_SCALAR":          true,
-		"JSON_SERIALIZE":       true,
-		"JSON_TABLE":           true,
-		"JSON_TABLE_PRIMITIVE": true,
-		"JSON_VALUE":           true,
-		"LAG":                  true,
-		"LANGUAGE":             true,
-		"LARGE":                true,
-		"LAST_VALUE":           true,
-		"LATERAL":              true,
-		"LEAD":                 true,
-		"LEADING":              true,
-		"LEAST":                true,
-		"LEFT":                 true,
-		"LIKE":                 true,
-		"LIKE_REGEX":           true,
-		"LISTAGG":              true,
-		"LN":                   true,
-		"LOCAL":                true,
-		"LOCALTIME":            true,
-		"LOCALTIMESTAMP":       true,
-		"LOG":                  true,
-		"LOG10":                true,
-		"LOWER":                true,
-		"LPAD":                 true,
-		"LTRIM":                true,
-		"MATCH":                true,
-		"MATCH_NUMBER":         true,
-		"MATCH_RECOGNIZE":      true,
-		"MATCHES":              true,
-		"MAX":                  true,
-		"MEMBER":               true,
-		"MERGE":                true,
-		"METHOD":               true,
-		"MIN":                  true,
-		"MINUTE":               true,
-		"MOD":                  true,
-		"MODIFIES":             true,
-		"MODULE":               true,
-		"MONTH":                true,
-		"MULTISET":             true,
-		"NATIONAL":             true,
-		"NATURAL":              true,
-		"NCHAR":                true,
-		"NCLOB":                true,
-		"NEW":                  true,
-		"NO":                   true,
-		"NONE":                 true,
-		"NORMALIZE":            true,
-		"NOT":                  true,
-		"NTH_VALUE":            true,
-		"NTILE":                true,
-		"NULL":                 true,
-		"NULLIF":               true,
-		"NUMERIC":              true,
-		"OCCURRENCES_REGEX":    true,
-		"OCTET_LENGTH":         true,
-		"OF":                   true,
-		"OFFSET":               true,
-		"OLD":                  true,
-		"OMIT":                 true,
-		"ON":                   true,
-		"ONE":                  true,
-		"ONLY":                 true,
-		"OPEN":                 true,
-		"OR":                   true,
-		"ORDER":                true,
-		"OUT":                  true,
-		"OUTER":                true,
-		"OVER":                 true,
-		"OVERLAPS":             true,
-		"OVERLAY":              true,
-		"PARAMETER":            true,
-		"PARTITION":            true,
-		"PATTERN":              true,
-		"PER":                  true,
-		"PERCENT":              true,
-		"PERCENT_RANK":         true,
-		"PERCENTILE_CONT":      true,
-		"PERCENTILE_DISC":      true,
-		"PERIOD":               true,
-		"PORTION":              true,
-		"POSITION":             true,
-		"POSITION_REGEX":       true,
-		"POWER":                true,
-		"PRECEDES":             true,
-		"PRECISION":            true,
-		"PREPARE":              true,
-		"PRIMARY":              true,
-		"PROCEDURE":            true,
-		"PTF":                  true,
-		"RANGE":                true,
-		"RANK":                 true,
-		"READS":                true,
-		"REAL":                 true,
-		"RECURSIVE":            true,
-		"REF":                  true,
-		"REFERENCES":           true,
-		"REFERENCING":          true,
-		"REGR_AVGX":            true,
-		"REGR_AVGY":            true,
-		"REGR_COUNT":           true,
-		"REGR_INTERCEPT":       true,
-		"REGR_R2":              true,
-		"REGR_SLOPE":           true,
-		"REGR_SXX":             true,
-		"REGR_SXY":             true,
-		"REGR_SYY":             true,
-		"RELEASE":              true,
-		"RESULT":               true,
-		"RETURN":               true,
-		"RETURNS":              true,
-		"REVOKE":               true,
-		"RIGHT":                true,
-		"ROLLBACK":             true,
-		"ROLLUP":               true,
-		"ROW":                  true,
-		"ROW_NUMBER":           true,
-		"ROWS":                 true,
-		"RPAD":                 true,
-		"RTRIM":                true,
-		"RUNNING":              true,
-		"SAVEPOINT":            true,
-		"SCOPE":                true,
-		"SCROLL":               true,
-		"SEARCH":               true,
-		"SECOND":               true,
-		"SEEK":                 true,
-		"SELECT":               true,
-		"SENSITIVE":            true,
-		"SESSION_USER":         true,
-		"SET":                  true,
-		"SHOW":                 true,
-		"SIMILAR":              true,
-		"SIN":                  true,
-		"SINH":                 true,
-		"SKIP":                 true,
-		"SMALLINT":             true,
-		"SOME":                 true,
-		"SPECIFIC":             true,
-		"SPECIFICTYPE":         true,
-		"SQL":                  true,
-		"SQLEXCEPTION":         true,
-		"SQLSTATE":             true,
-		"SQLWARNING":           true,
-		"SQRT":                 true,
-		"START":                true,
-		"STATIC":               true,
-		"STDDEV_POP":           true,
-		"STDDEV_SAMP":          true,
-		"SUBMULTISET":          true,
-		"SUBSET":               true,
-		"SUBSTRING":            true,
-		"SUBSTRING_REGEX":      true,
-		"SUCCEEDS":             true,
-		"SUM":                  true,
-		"SYMMETRIC":            true,
-		"SYSTEM":               true,
-		"SYSTEM_TIME":          true,
-		"SYSTEM_USER":          true,
-		"TABLE":                true,
-		"TABLESAMPLE":          true,
-		"TAN":                  true,
-		"TANH":                 true,
-		"THEN":                 true,
-		"TIME":                 true,
-		"TIMESTAMP":            true,
-		"TIMEZONE_HOUR":        true,
-		"TIMEZONE_MINUTE":      true,
-		"TO":                   true,
-		"TRAILING":             true,
-		"TRANSLATE":            true,
-		"TRANSLATE_REGEX":      true,
-		"TRANSLATION":          true,
-		"TREAT":                true,
-		"TRIGGER":              true,
-		"TRIM":                 true,
-		"TRIM_ARRAY":           true,
-		"TRUNCATE":             true,
-		"UESCAPE":              true,
-		"UNION":                true,
-		"UNIQUE":               true,
-		"UNKNOWN":              true,
-		"UNNEST":               true,
-		"UPDATE":               true,
-		"UPPER":                true,
-		"USER":                 true,
-		"USING":                true,
-		"VALUE":                true,
-		"VALUE_OF":             true,
-		"VALUES":               true,
-		"VAR_POP":              true,
-		"VAR_SAMP":             true,
-		"VARBINARY":            true,
-		"VARCHAR":              true,
-		"VARYING":              true,
-		"VERSIONING":           true,
-		"WHEN":                 true,
-		"WHENEVER":             true,
-		"WHERE":                true,
-		"WIDTH_BUCKET":         true,
-		"WINDOW":               true,
-		"WITH":                 true,
-		"WITHIN":               true,
-		"WITHOUT":              true,
-		"XML":                  true,
-		"XMLAGG":               true,
-		"XMLATTRIBUTES":        true,
-		"XMLBINARY":            true,
-		"XMLCAST":              true,
-		"XMLCOMMENT":           true,
-		"XMLCONCAT":            true,
-		"XMLDOCUMENT":          true,
-		"XMLELEMENT":           true,
-		"XMLEXISTS":            true,
-		"XMLFOREST":            true,
-		"XMLITERATE":           true,
-		"XMLNAMESPACES":        true,
-		"XMLPARSE":             true,
-		"XMLPI":                true,
-		"XMLQUERY":             true,
-		"XMLSERIALIZE":         true,
-		"XMLTABLE":             true,
-		"XMLTEXT":              true,
-		"XMLVALIDATE":          true,
-		"YEAR":                 true,
-		// additions not in ref
-		"ELSIF":   false,
-		"FOREACH": false,
-		"LOOP":    false,
+	if t.Name == "float" {
+		// float (1) to float (24) is real, otherwise double precision
+		c := Datatype{Schema: t.Schema, Name: "double precision", ArrayDims: t.ArrayDims}
+		if t.HasPrecision && t.Precision <= 24 {
+			c.Name = "real"
+		}
+		return c
 	}
 
+	return canonicalDatatype(d, pgDatatypeAliases, t)
+}
+
+/*
+   PostgreSQL keywords
+
+   https://www.postgresql.org/docs/current/sql-keywords-appendix.html
+
+*/
+
+// map[keyword]isReserved
+var pgKeywords = map[string]bool{
+	"ABORT":                         false,
+	"ACCESS":                        false,
+	"AGGREGATE":                     false,
+	"ALSO":                          false,
+	"ANALYSE":                       true,
+	"ANALYZE":                       true,
+	"ATTACH":                        false,
+	"BACKWARD":                      false,
+	"BIT":                           false,
+	"BIT_LENGTH":                    false,
+	"CACHE":                         false,
+	"CHECKPOINT":                    false,
+	"CLASS":                         false,
+	"CLUSTER":                       false,
+	"COMMENT":                       false,
+	"COMMENTS":                      false,
+	"COMPRESSION":                   false,
+	"CONCURRENTLY":                  true,
+	"CONFIGURATION":                 false,
+	"CONFLICT":                      false,
+	"CONVERSION":                    false,
+	"COST":                          false,
+	"CSV":                           false,
+	"DATABASE":                      false,
+	"DELIMITER":                     false,
+	"DELIMITERS":                    false,
+	"DEPENDS":                       false,
+	"DETACH":                        false,
+	"DICTIONARY":                    false,
+	"DISABLE":                       false,
+	"DISCARD":                       false,
+	"DO":                            true,
+	"ENABLE":                        false,
+	"ENCRYPTED":                     false,
+	"ENUM":                          false,
+	"EVENT":                         false,
+	"EXCEPTION":                     false,
+	"EXCLUSIVE":                     false,
+	"EXPLAIN":                       false,
+	"EXTENSION":                     false,
+	"FAMILY":                        false,
+	"FINALIZE":                      false,
+	"FORCE":                         false,
+	"FORWARD":                       false,
+	"FREEZE":                        true,
+	"FUNCTIONS":                     false,
+	"HANDLER":                       false,
+	"HEADER":                        false,
+	"IF":                            false,
+	"ILIKE":                         true,
+	"IMMUTABLE":                     false,
+	"IMPLICIT":                      false,
+	"INCLUDE":                       false,
+	"INDEX":                         false,
+	"INDEXES":                       false,
+	"INHERIT":                       false,
+	"INHERITS":                      false,
+	"INLINE":                        false,
+	"ISNULL":                        true,
+	"LABEL":                         false,
+	"LEAKPROOF":                     false,
+	"LISTEN":                        false,
+	"LOAD":                          false,
+	"LOCK":                          false,
+	"LOCKED":                        false,
+	"LOGGED":                        false,
+	"MATERIALIZED":                  false,
+	"MODE":                          false,
+	"MOVE":                          false,
+	"NOTHING":                       false,
+	"NOTIFY":                        false,
+	"NOTNULL":                       true,
+	"NOWAIT":                        false,
+	"OIDS":                          false,
+	"OPERATOR":                      false,
+	"OWNED":                         false,
+	"OWNER":                         false,
+	"PARALLEL":                      false,
+	"PARSER":                        false,
+	"PASSWORD":                      false,
+	"PLANS":                         false,
+	"POLICY":                        false,
+	"PREPARED":                      false,
+	"PROCEDURAL":                    false,
+	"PROCEDURES":                    false,
+	"PROGRAM":                       false,
+	"PUBLICATION":                   false,
+	"QUOTE":                         false,
+	"REASSIGN":                      false,
+	"RECHECK":                       false,
+	"REFRESH":                       false,
+	"REINDEX":                       false,
+	"RENAME":                        false,
+	"REPLACE":                       false,
+	"REPLICA":                       false,
+	"RESET":                         false,
+	"ROUTINES":                      false,
+	"RULE":                          false,
+	"SCHEMAS":                       false,
+	"SEQUENCES":                     false,
+	"SETOF":                         false,
+	"SHARE":                         false,
+	"SNAPSHOT":                      false,
+	"SQLCODE":                       false,
+	"SQLERROR":                      false,
+	"STABLE":                        false,
+	"STATISTICS":                    false,
+	"STDIN":                         false,
+	"STDOUT":                        false,
+	"STORAGE":                       false,
+	"STORED":                        false,
+	"STRICT":                        false,
+	"SUBSCRIPTION":                  false,
+	"SUPPORT":                       false,
+	"SYSID":                         false,
+	"TABLES":                        false,
+	"TABLESPACE":                    false,
+	"TEMP":                          false,
+	"TEMPLATE":                      false,
+	"TEXT":                          false,
+	"TRUSTED":                       false,
+	"TYPES":                         false,
+	"UNENCRYPTED":                   false,
+	"UNLISTEN":                      false,
+	"UNLOGGED":                      false,
+	"UNTIL":                         false,
+	"VACUUM":                        false,
+	"VALIDATE":                      false,
+	"VALIDATOR":                     false,
+	"VARIADIC":                      true,
+	"VERBOSE":                       true,
+	"VIEWS":                         false,
+	"VOLATILE":                      false,
+	"XMLROOT":                       false,
+	"A":                             false,
+	"ABSOLUTE":                      false,
+	"ACCORDING":                     false,
+	"ACTION":                        false,
+	"ADA":                           false,
+	"ADD":                           false,
+	"ADMIN":                         false,
+	"AFTER":                         false,
+	"ALWAYS":                        false,
+	"ASC":                           true,
+	"ASSERTION":                     false,
+	"ASSIGNMENT":                    false,
+	"ATTRIBUTE":                     false,
+	"ATTRIBUTES":                    false,
+	"BASE64":                        false,
+	"BEFORE":                        false,
+	"BERNOULLI":                     false,
+	"BLOCKED":                       false,
+	"BOM":                           false,
+	"BREADTH":                       false,
+	"C":                             false,
+	"CASCADE":                       false,
+	"CATALOG":                       false,
+	"CATALOG_NAME":                  false,
+	"CHAIN":                         false,
+	"CHAINING":                      false,
+	"CHARACTER_​SET_​CATALOG":       false,
+	"CHARACTER_SET_NAME":            false,
+	"CHARACTER_SET_SCHEMA":          false,
+	"CHARACTERISTICS":               false,
+	"CHARACTERS":                    false,
+	"CLASS_ORIGIN":                  false,
+	"COBOL":                         false,
+	"COLLATION":                     true,
+	"COLLATION_CATALOG":             false,
+	"COLLATION_NAME":                false,
+	"COLLATION_SCHEMA":              false,
+	"COLUMN_NAME":                   false,
+	"COLUMNS":                       false,
+	"COMMAND_FUNCTION":              false,
+	"COMMAND_​FUNCTION_​CODE":       false,
+	"COMMITTED":                     false,
+	"CONDITION_NUMBER":              false,
+	"CONDITIONAL":                   false,
+	"CONNECTION":                    false,
+	"CONNECTION_NAME":               false,
+	"CONSTRAINT_CATALOG":            false,
+	"CONSTRAINT_NAME":               false,
+	"CONSTRAINT_SCHEMA":             false,
+	"CONSTRAINTS":                   false,
+	"CONSTRUCTOR":                   false,
+	"CONTENT":                       false,
+	"CONTINUE":                      false,
+	"CONTROL":                       false,
+	"COPARTITION":                   false,
+	"CURSOR_NAME":                   false,
+	"DATA":                          false,
+	"DATETIME_​INTERVAL_​CODE":      false,
+	"DATETIME_​INTERVAL_​PRECISION": false,
+	"DB":                            false,
+	"DEFAULTS":                      false,
+	"DEFERRABLE":                    true,
+	"DEFERRED":                      false,
+	"DEFINED":                       false,
+	"DEFINER":                       false,
+	"DEGREE":                        false,
+	"DEPTH":                         false,
+	"DERIVED":                       false,
+	"DESC":                          true,
+	"DESCRIPTOR":                    false,
+	"DIAGNOSTICS":                   false,
+	"DISPATCH":                      false,
+	"DOCUMENT":                      false,
+	"DOMAIN":                        false,
+	"DYNAMIC_FUNCTION":              false,
+	"DYNAMIC_​FUNCTION_​CODE":       false,
+	"ENCODING":                      false,
+	"ENFORCED":                      false,
+	"ERROR":                         false,
+	"EXCLUDE":                       false,
+	"EXCLUDING":                     false,
+	"EXPRESSION":                    false,
+	"FILE":                          false,
+	"FINAL":                         false,
+	"FINISH":                        false,
+	"FIRST":                         false,
+	"FLAG":                          false,
+	"FOLLOWING":                     false,
+	"FORMAT":                        false,
+	"FORTRAN":                       false,
+	"FOUND":                         false,
+	"FS":                            false,
+	"FULFILL":                       false,
+	"G":                             false,
+	"GENERAL":                       false,
+	"GENERATED":                     false,
+	"GO":                            false,
+	"GOTO":                          false,
+	"GRANTED":                       false,
+	"HEX":                           false,
+	"HIERARCHY":                     false,
+	"ID":                            false,
+	"IGNORE":                        false,
+	"IMMEDIATE":                     false,
+	"IMMEDIATELY":                   false,
+	"IMPLEMENTATION":                false,
+	"INCLUDING":                     false,
+	"INCREMENT":                     false,
+	"INDENT":                        false,
+	"INITIALLY":                     true,
+	"INPUT":                         false,
+	"INSTANCE":                      false,
+	"INSTANTIABLE":                  false,
+	"INSTEAD":                       false,
+	"INTEGRITY":                     false,
+	"INVOKER":                       false,
+	"ISOLATION":                     false,
+	"K":                             false,
+	"KEEP":                          false,
+	"KEY":                           false,
+	"KEY_MEMBER":                    false,
+	"KEY_TYPE":                      false,
+	"KEYS":                          false,
+	"LAST":                          false,
+	"LENGTH":                        false,
+	"LEVEL":                         false,
+	"LIBRARY":                       false,
+	"LIMIT":                         true,
+	"LINK":                          false,
+	"LOCATION":                      false,
+	"LOCATOR":                       false,
+	"M":                             false,
+	"MAP":                           false,
+	"MAPPING":                       false,
+	"MATCHED":                       false,
+	"MAXVALUE":                      false,
+	"MEASURES":                      false,
+	"MESSAGE_LENGTH":                false,
+	"MESSAGE_OCTET_LENGTH":          false,
+	"MESSAGE_TEXT":                  false,
+	"MINVALUE":                      false,
+	"MORE":                          false,
+	"MUMPS":                         false,
+	"NAME":                          false,
+	"NAMES":                         false,
+	"NAMESPACE":                     false,
+	"NESTED":                        false,
+	"NESTING":                       false,
+	"NEXT":                          false,
+	"NFC":                           false,
+	"NFD":                           false,
+	"NFKC":                          false,
+	"NFKD":                          false,
+	"NIL":                           false,
+	"NORMALIZED":                    false,
+	"NULL_ORDERING":                 false,
+	"NULLABLE":                      false,
+	"NULLS":                         false,
+	"NUMBER":                        false,
+	"OBJECT":                        false,
+	"OCCURRENCE":                    false,
+	"OCTETS":                        false,
+	"OFF":                           false,
+	"OPTION":                        false,
+	"OPTIONS":                       false,
+	"ORDERING":                      false,
+	"ORDINALITY":                    false,
+	"OTHERS":                        false,
+	"OUTPUT":                        false,
+	"OVERFLOW":                      false,
+	"OVERRIDING":                    false,
+	"P":                             false,
+	"PAD":                           false,
+	"PARAMETER_MODE":                false,
+	"PARAMETER_NAME":                false,
+	"PARAMETER_​ORDINAL_​POSITION":  false,
+	"PARAMETER_​SPECIFIC_​CATALOG":  false,
+	"PARAMETER_​SPECIFIC_​NAME":     false,
+	"PARAMETER_​SPECIFIC_​SCHEMA":   false,
+	"PARTIAL":                       false,
+	"PASCAL":                        false,
+	"PASS":                          false,
+	"PASSING":                       false,
+	"PASSTHROUGH":                   false,
+	"PAST":                          false,
+	"PATH":                          false,
+	"PERMISSION":                    false,
+	"PERMUTE":                       false,
+	"PIPE":                          false,
+	"PLACING":                       true,
+	"PLAN":                          false,
+	"PLI":                           false,
+	"PRECEDING":                     false,
+	"PRESERVE":                      false,
+	"PREV":                          false,
+	"PRIOR":                         false,
+	"PRIVATE":                       false,
+	"PRIVILEGES":                    false,
+	"PRUNE":                         false,
+	"PUBLIC":                        false,
+	"QUOTES":                        false,
+	"READ":                          false,
+	"RECOVERY":                      false,
+	"RELATIVE":                      false,
+	"REPEATABLE":                    false,
+	"REQUIRING":                     false,
+	"RESPECT":                       false,
+	"RESTART":                       false,
+	"RESTORE":                       false,
+	"RESTRICT":                      false,
+	"RETURNED_CARDINALITY":          false,
+	"RETURNED_LENGTH":               false,
+	"RETURNED_​OCTET_​LENGTH":       false,
+	"RETURNED_SQLSTATE":             false,
+	"RETURNING":                     true,
+	"ROLE":                          false,
+	"ROUTINE":                       false,
+	"ROUTINE_CATALOG":               false,
+	"ROUTINE_NAME":                  false,
+	"ROUTINE_SCHEMA":                false,
+	"ROW_COUNT":                     false,
+	"SCALAR":                        false,
+	"SCALE":                         false,
+	"SCHEMA":                        false,
+	"SCHEMA_NAME":                   false,
+	"SCOPE_CATALOG":                 false,
+	"SCOPE_NAME":                    false,
+	"SCOPE_SCHEMA":                  false,
+	"SECTION":                       false,
+	"SECURITY":                      false,
+	"SELECTIVE":                     false,
+	"SELF":                          false,
+	"SEMANTICS":                     false,
+	"SEQUENCE":                      false,
+	"SERIALIZABLE":                  false,
+	"SERVER":                        false,
+	"SERVER_NAME":                   false,
+	"SESSION":                       false,
+	"SETS":                          false,
+	"SIMPLE":                        false,
+	"SIZE":                          false,
+	"SORT_DIRECTION":                false,
+	"SOURCE":                        false,
+	"SPACE":                         false,
+	"SPECIFIC_NAME":                 false,
+	"STANDALONE":                    false,
+	"STATE":                         false,
+	"STATEMENT":                     false,
+	"STRING":                        false,
+	"STRIP":                         false,
+	"STRUCTURE":                     false,
+	"STYLE":                         false,
+	"SUBCLASS_ORIGIN":               false,
+	"T":                             false,
+	"TABLE_NAME":                    false,
+	"TEMPORARY":                     false,
+	"THROUGH":                       false,
+	"TIES":                          false,
+	"TOKEN":                         false,
+	"TOP_LEVEL_COUNT":               false,
+	"TRANSACTION":                   false,
+	"TRANSACTION_ACTIVE":            false,
+	"TRANSACTIONS_​COMMITTED":       false,
+	"TRANSACTIONS_​ROLLED_​BACK":    false,
+	"TRANSFORM":                     false,
+	"TRANSFORMS":                    false,
+	"TRIGGER_CATALOG":               false,
+	"TRIGGER_NAME":                  false,
+	"TRIGGER_SCHEMA":                false,
+	"TYPE":                          false,
+	"UNBOUNDED":                     false,
+	"UNCOMMITTED":                   false,
+	"UNCONDITIONAL":                 false,
+	"UNDER":                         false,
+	"UNLINK":                        false,
+	"UNMATCHED":                     false,
+	"UNNAMED":                       false,
+	"UNTYPED":                       false,
+	"URI":                           false,
+	"USAGE":                         false,
+	"USER_​DEFINED_​TYPE_​CATALOG":  false,
+	"USER_​DEFINED_​TYPE_​CODE":     false,
+	"USER_​DEFINED_​TYPE_​NAME":     false,
+	"USER_​DEFINED_​TYPE_​SCHEMA":   false,
+	"UTF16":                         false,
+	"UTF32":                         false,
+	"UTF8":                          false,
+	"VALID":                         false,
+	"VERSION":                       false,
+	"VIEW":                          false,
+	"WHITESPACE":                    false,
+	"WORK":                          false,
+	"WRAPPER":                       false,
+	"WRITE":                         false,
+	"XMLDECLARATION":                false,
+	"XMLSCHEMA":                     false,
+	"YES":                           false,
+	"ZONE":                          false,
+	"FALSE":                         true,
+	"TRUE":                          true,
+	"ABS":                           true,
+	"ABSENT":                        true,
+	"ACOS":                          true,
+	"ALL":                           true,
+	"ALLOCATE":                      true,
+	"ALTER":                         true,
+	"AND":                           true,
+	"ANY":                           true,
+	"ANY_VALUE":                     true,
+	"ARE":                           true,
+	"ARRAY":                         true,
+	"ARRAY_AGG":                     true,
+	"ARRAY_​MAX_​CARDINALITY":       true,
+	"AS":                            true,
+	"ASENSITIVE":                    true,
+	"ASIN":                          true,
+	"ASYMMETRIC":                    true,
+	"AT":                            true,
+	"ATAN":                          true,
+	"ATOMIC":                        true,
+	"AUTHORIZATION":                 true,
+	"AVG":                           true,
+	"BEGIN":                         true,
+	"BEGIN_FRAME":                   true,
+	"BEGIN_PARTITION":               true,
+	"BETWEEN":                       true,
+	"BIGINT":                        true,
+	"BINARY":                        true,
+	"BLOB":                          true,
+	"BOOLEAN":                       true,
+	"BOTH":                          true,
+	"BTRIM":                         true,
+	"BY":                            true,
+	"CALL":                          true,
+	"CALLED":                        true,
+	"CARDINALITY":                   true,
+	"CASCADED":                      true,
+	"CASE":                          true,
+	"CAST":                          true,
+	"CEIL":                          true,
+	"CEILING":                       true,
+	"CHAR":                          true,
+	"CHAR_LENGTH":                   true,
+	"CHARACTER":                     true,
+	"CHARACTER_LENGTH":              true,
+	"CHECK":                         true,
+	"CLASSIFIER":                    true,
+	"CLOB":                          true,
+	"CLOSE":                         true,
+	"COALESCE":                      true,
+	"COLLATE":                       true,
+	"COLLECT":                       true,
+	"COLUMN":                        true,
+	"COMMIT":                        true,
+	"CONDITION":                     true,
+	"CONNECT":                       true,
+	"CONSTRAINT":                    true,
+	"CONTAINS":                      true,
+	"CONVERT":                       true,
+	"COPY":                          true,
+	"CORR":                          true,
+	"CORRESPONDING":                 true,
+	"COS":                           true,
+	"COSH":                          true,
+	"COUNT":                         true,
+	"COVAR_POP":                     true,
+	"COVAR_SAMP":                    true,
+	"CREATE":                        true,
+	"CROSS":                         true,
+	"CUBE":                          true,
+	"CUME_DIST":                     true,
+	"CURRENT":                       true,
+	"CURRENT_CATALOG":               true,
+	"CURRENT_DATE":                  true,
+	"CURRENT_​DEFAULT_​TRANSFORM_​GROUP": true,
+	"CURRENT_PATH":      true,
+	"CURRENT_ROLE":      true,
+	"CURRENT_ROW":       true,
+	"CURRENT_SCHEMA":    true,
+	"CURRENT_TIME":      true,
+	"CURRENT_TIMESTAMP": true,
+	"CURRENT_​TRANSFORM_​GROUP_​FOR_​TYPE": true,
+	"CURRENT_USER":         true,
+	"CURSOR":               true,
+	"CYCLE":                true,
+	"DATALINK":             true,
+	"DATE":                 true,
+	"DAY":                  true,
+	"DEALLOCATE":           true,
+	"DEC":                  true,
+	"DECFLOAT":             true,
+	"DECIMAL":              true,
+	"DECLARE":              true,
+	"DEFAULT":              true,
+	"DEFINE":               true,
+	"DELETE":               true,
+	"DENSE_RANK":           true,
+	"DEREF":                true,
+	"DESCRIBE":             true,
+	"DETERMINISTIC":        true,
+	"DISCONNECT":           true,
+	"DISTINCT":             true,
+	"DLNEWCOPY":            true,
+	"DLPREVIOUSCOPY":       true,
+	"DLURLCOMPLETE":        true,
+	"DLURLCOMPLETEONLY":    true,
+	"DLURLCOMPLETEWRITE":   true,
+	"DLURLPATH":            true,
+	"DLURLPATHONLY":        true,
+	"DLURLPATHWRITE":       true,
+	"DLURLSCHEME":          true,
+	"DLURLSERVER":          true,
+	"DLVALUE":              true,
+	"DOUBLE":               true,
+	"DROP":                 true,
+	"DYNAMIC":              true,
+	"EACH":                 true,
+	"ELEMENT":              true,
+	"ELSE":                 true,
+	"EMPTY":                true,
+	"END":                  true,
+	"END_FRAME":            true,
+	"END_PARTITION":        true,
+	"END-EXEC":             true,
+	"EQUALS":               true,
+	"ESCAPE":               true,
+	"EVERY":                true,
+	"EXCEPT":               true,
+	"EXEC":                 true,
+	"EXECUTE":              true,
+	"EXISTS":               true,
+	"EXP":                  true,
+	"EXTERNAL":             true,
+	"EXTRACT":              true,
+	"FETCH":                true,
+	"FILTER":               true,
+	"FIRST_VALUE":          true,
+	"FLOAT":                true,
+	"FLOOR":                true,
+	"FOR":                  true,
+	"FOREIGN":              true,
+	"FRAME_ROW":            true,
+	"FREE":                 true,
+	"FROM":                 true,
+	"FULL":                 true,
+	"FUNCTION":             true,
+	"FUSION":               true,
+	"GET":                  true,
+	"GLOBAL":               true,
+	"GRANT":                true,
+	"GREATEST":             true,
+	"GROUP":                true,
+	"GROUPING":             true,
+	"GROUPS":               true,
+	"HAVING":               true,
+	"HOLD":                 true,
+	"HOUR":                 true,
+	"IDENTITY":             true,
+	"IMPORT":               true,
+	"IN":                   true,
+	"INDICATOR":            true,
+	"INITIAL":              true,
+	"INNER":                true,
+	"INOUT":                true,
+	"INSENSITIVE":          true,
+	"INSERT":               true,
+	"INT":                  true,
+	"INTEGER":              true,
+	"INTERSECT":            true,
+	"INTERSECTION":         true,
+	"INTERVAL":             true,
+	"INTO":                 true,
+	"IS":                   true,
+	"JOIN":                 true,
+	"JSON":                 true,
+	"JSON_ARRAY":           true,
+	"JSON_ARRAYAGG":        true,
+	"JSON_EXISTS":          true,
+	"JSON_OBJECT":          true,
+	"JSON_OBJECTAGG":       true,
+	"JSON_QUERY":           true,
+	"JSON_SCALAR":          true,
+	"JSON_SERIALIZE":       true,
+	"JSON_TABLE":           true,
+	"JSON_TABLE_PRIMITIVE": true,
+	"JSON_VALUE":           true,
+	"LAG":                  true,
+	"LANGUAGE":             true,
+	"LARGE":                true,
+	"LAST_VALUE":           true,
+	"LATERAL":              true,
+	"LEAD":                 true,
+	"LEADING":              true,
+	"LEAST":                true,
+	"LEFT":                 true,
+	"LIKE":                 true,
+	"LIKE_REGEX":           true,
+	"LISTAGG":              true,
+	"LN":                   true,
+	"LOCAL":                true,
+	"LOCALTIME":            true,
+	"LOCALTIMESTAMP":       true,
+	"LOG":                  true,
+	"LOG10":                true,
+	"LOWER":                true,
+	"LPAD":                 true,
+	"LTRIM":                true,
+	"MATCH":                true,
+	"MATCH_NUMBER":         true,
+	"MATCH_RECOGNIZE":      true,
+	"MATCHES":              true,
+	"MAX":                  true,
+	"MEMBER":               true,
+	"MERGE":                true,
+	"METHOD":               true,
+	"MIN":                  true,
+	"MINUTE":               true,
+	"MOD":                  true,
+	"MODIFIES":             true,
+	"MODULE":               true,
+	"MONTH":                true,
+	"MULTISET":             true,
+	"NATIONAL":             true,
+	"NATURAL":              true,
+	"NCHAR":                true,
+	"NCLOB":                true,
+	"NEW":                  true,
+	"NO":                   true,
+	"NONE":                 true,
+	"NORMALIZE":            true,
+	"NOT":                  true,
+	"NTH_VALUE":            true,
+	"NTILE":                true,
+	"NULL":                 true,
+	"NULLIF":               true,
+	"NUMERIC":              true,
+	"OCCURRENCES_REGEX":    true,
+	"OCTET_LENGTH":         true,
+	"OF":                   true,
+	"OFFSET":               true,
+	"OLD":                  true,
+	"OMIT":                 true,
+	"ON":                   true,
+	"ONE":                  true,
+	"ONLY":                 true,
+	"OPEN":                 true,
+	"OR":                   true,
+	"ORDER":                true,
+	"OUT":                  true,
+	"OUTER":                true,
+	"OVER":                 true,
+	"OVERLAPS":             true,
+	"OVERLAY":              true,
+	"PARAMETER":            true,
+	"PARTITION":            true,
+	"PATTERN":              true,
+	"PER":                  true,
+	"PERCENT":              true,
+	"PERCENT_RANK":         true,
+	"PERCENTILE_CONT":      true,
+	"PERCENTILE_DISC":      true,
+	"PERIOD":               true,
+	"PORTION":              true,
+	"POSITION":             true,
+	"POSITION_REGEX":       true,
+	"POWER":                true,
+	"PRECEDES":             true,
+	"PRECISION":            true,
+	"PREPARE":              true,
+	"PRIMARY":              true,
+	"PROCEDURE":            true,
+	"PTF":                  true,
+	"RANGE":                true,
+	"RANK":                 true,
+	"READS":                true,
+	"REAL":                 true,
+	"RECURSIVE":            true,
+	"REF":                  true,
+	"REFERENCES":           true,
+	"REFERENCING":          true,
+	"REGR_AVGX":            true,
+	"REGR_AVGY":            true,
+	"REGR_COUNT":           true,
+	"REGR_INTERCEPT":       true,
+	"REGR_R2":              true,
+	"REGR_SLOPE":           true,
+	"REGR_SXX":             true,
+	"REGR_SXY":             true,
+	"REGR_SYY":             true,
+	"RELEASE":              true,
+	"RESULT":               true,
+	"RETURN":               true,
+	"RETURNS":              true,
+	"REVOKE":               true,
+	"RIGHT":                true,
+	"ROLLBACK":             true,
+	"ROLLUP":               true,
+	"ROW":                  true,
+	"ROW_NUMBER":           true,
+	"ROWS":                 true,
+	"RPAD":                 true,
+	"RTRIM":                true,
+	"RUNNING":              true,
+	"SAVEPOINT":            true,
+	"SCOPE":                true,
+	"SCROLL":               true,
+	"SEARCH":               true,
+	"SECOND":               true,
+	"SEEK":                 true,
+	"SELECT":               true,
+	"SENSITIVE":            true,
+	"SESSION_USER":         true,
+	"SET":                  true,
+	"SHOW":                 true,
+	"SIMILAR":              true,
+	"SIN":                  true,
+	"SINH":                 true,
+	"SKIP":                 true,
+	"SMALLINT":             true,
+	"SOME":                 true,
+	"SPECIFIC":             true,
+	"SPECIFICTYPE":         true,
+	"SQL":                  true,
+	"SQLEXCEPTION":         true,
+	"SQLSTATE":             true,
+	"SQLWARNING":           true,
+	"SQRT":                 true,
+	"START":                true,
+	"STATIC":               true,
+	"STDDEV_POP":           true,
+	"STDDEV_SAMP":          true,
+	"SUBMULTISET":          true,
+	"SUBSET":               true,
+	"SUBSTRING":            true,
+	"SUBSTRING_REGEX":      true,
+	"SUCCEEDS":             true,
+	"SUM":                  true,
+	"SYMMETRIC":            true,
+	"SYSTEM":               true,
+	"SYSTEM_TIME":          true,
+	"SYSTEM_USER":          true,
+	"TABLE":                true,
+	"TABLESAMPLE":          true,
+	"TAN":                  true,
+	"TANH":                 true,
+	"THEN":                 true,
+	"TIME":                 true,
+	"TIMESTAMP":            true,
+	"TIMEZONE_HOUR":        true,
+	"TIMEZONE_MINUTE":      true,
+	"TO":                   true,
+	"TRAILING":             true,
+	"TRANSLATE":            true,
+	"TRANSLATE_REGEX":      true,
+	"TRANSLATION":          true,
+	"TREAT":                true,
+	"TRIGGER":              true,
+	"TRIM":                 true,
+	"TRIM_ARRAY":           true,
+	"TRUNCATE":             true,
+	"UESCAPE":              true,
+	"UNION":                true,
+	"UNIQUE":               true,
+	"UNKNOWN":              true,
+	"UNNEST":               true,
+	"UPDATE":               true,
+	"UPPER":                true,
+	"USER":                 true,
+	"USING":                true,
+	"VALUE":                true,
+	"VALUE_OF":             true,
+	"VALUES":               true,
+	"VAR_POP":              true,
+	"VAR_SAMP":             true,
+	"VARBINARY":            true,
+	"VARCHAR":              true,
+	"VARYING":              true,
+	"VERSIONING":           true,
+	"WHEN":                 true,
+	"WHENEVER":             true,
+	"WHERE":                true,
+	"WIDTH_BUCKET":         true,
+	"WINDOW":               true,
+	"WITH":                 true,
+	"WITHIN":               true,
+	"WITHOUT":              true,
+	"XML":                  true,
+	"XMLAGG":               true,
+	"XMLATTRIBUTES":        true,
+	"XMLBINARY":            true,
+	"XMLCAST":              true,
+	"XMLCOMMENT":           true,
+	"XMLCONCAT":            true,
+	"XMLDOCUMENT":          true,
+	"XMLELEMENT":           true,
+	"XMLEXISTS":            true,
+	"XMLFOREST":            true,
+	"XMLITERATE":           true,
+	"XMLNAMESPACES":        true,
+	"XMLPARSE":             true,
+	"XMLPI":                true,
+	"XMLQUERY":             true,
+	"XMLSERIALIZE":         true,
+	"XMLTABLE":             true,
+	"XMLTEXT":              true,
+	"XMLVALIDATE":          true,
+	"YEAR":                 true,
+	// additions not in ref
+	"ELSIF":   false,
+	"FOREACH": false,
+	"LOOP":    false,
+}
+
+func (d PostgreSQLDialect) keyword(s string) (bool, bool) {
+
 	v, ok := pgKeywords[strings.ToUpper(s)]
 
 	return ok, v
@@ -1119,45 +1693,45 @@ func (d PostgreSQLDialect) IsReservedKeyword(s string) bool {
 	return false
 }
 
+var pgOperators = map[string]bool{
+	"^":   true,
+	"~":   true,
+	"~*":  true,
+	"<<":  true,
+	"<=":  true,
+	"<>":  true,
+	"<":   true,
+	"=":   true,
+	">=":  true,
+	">>":  true,
+	">":   true,
+	"||/": true,
+	"||":  true,
+	"|/":  true,
+	"|":   true,
+	"-":   true,
+	":=":  true,
+	"::":  true,
+	"!~":  true,
+	"!~*": true,
+	"!=":  true,
+	"!!":  true,
+	"!":   true,
+	"/":   true,
+	"@":   true,
+	"*":   true,
+	"&":   true,
+	"#":   true,
+	"%":   true,
+	"+":   true,
+	"=>":  true, // Added fat comma for function/procedure calls
+	"..":  true, // Added for loop ranges
+}
+
 // IsOperator returns a boolean indicating if the supplied string
 // is considered to be an operator in PostgreSQL
 func (d PostgreSQLDialect) IsOperator(s string) bool {
 
-	var pgOperators = map[string]bool{
-		"^":   true,
-		"~":   true,
-		"~*":  true,
-		"<<":  true,
-		"<=":  true,
-		"<>":  true,
-		"<":   true,
-		"=":   true,
-		">=":  true,
-		">>":  true,
-		">":   true,
-		"||/": true,
-		"||":  true,
-		"|/":  true,
-		"|":   true,
-		"-":   true,
-		":=":  true,
-		"::":  true,
-		"!~":  true,
-		"!~*": true,
-		"!=":  true,
-		"!!":  true,
-		"!":   true,
-		"/":   true,
-		"@":   true,
-		"*":   true,
-		"&":   true,
-		"#":   true,
-		"%":   true,
-		"+":   true,
-		"=>":  true, // Added fat comma for function/procedure calls
-		"..":  true, // Added for loop ranges
-	}
-
 	// For valid operators that come with the system
 	if _, ok := pgOperators[s]; ok {
 		return true
@@ -1279,3 +1853,15 @@ func (d PostgreSQLDialect) IsIdentifier(s string) bool {
 
 	return true
 }
+
+// PlaceholderStyles returns the bind parameter placeholder styles
+// supported by PostgreSQL, in order of preference
+func (d PostgreSQLDialect) PlaceholderStyles() []int {
+	return []int{DollarNumberPlaceholder}
+}
+
+// IsPlaceholder returns a boolean indicating if the supplied string
+// is considered to be a bind parameter placeholder in PostgreSQL
+func (d PostgreSQLDialect) IsPlaceholder(s string) bool {
+	return isPlaceholder(d, s)
+}
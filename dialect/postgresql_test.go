@@ -0,0 +1,122 @@
+package dialect
+
+import "testing"
+
+func TestPostgreSQLArrays(t *testing.T) {
+
+	tests := []struct {
+		in, want string
+		dims     []int
+	}{
+		{"int[]", "int[]", []int{0}},
+		{"text[][]", "text[][]", []int{0, 0}},
+		{"integer[3]", "integer[3]", []int{3}},
+		{"integer array", "integer[]", []int{0}},
+		{"integer array[3]", "integer[3]", []int{3}},
+		{"varchar(10)[]", "varchar(10)[]", []int{0}},
+		{"int4range", "int4range", nil},
+		{"pg_catalog.integer", "pg_catalog.integer", nil},
+	}
+
+	d := NewPostgreSQLDialect()
+	for _, tt := range tests {
+		dt, err := d.ParseDatatype(tt.in)
+		if err != nil || dt.String() != tt.want || !equalInts(dt.ArrayDims, tt.dims) {
+			t.Errorf("ParseDatatype(%q) = %q %v %v, expected %q %v", tt.in, dt.String(), dt.ArrayDims, err, tt.want, tt.dims)
+		}
+	}
+
+	for _, s := range []string{"int array[3][4]", "int[3] array", "public.int4", "my_enum"} {
+		if _, err := d.ParseDatatype(s); err == nil {
+			t.Errorf("ParseDatatype(%q) succeeded, expected an error", s)
+		}
+	}
+}
+
+func TestRegisterDatatype(t *testing.T) {
+
+	d := NewPostgreSQLDialect()
+	before := d
+
+	for _, s := range []string{"", "a b", "a.b.c", ".a", "a.", "int(3)", `""`, "select"} {
+		if err := d.RegisterDatatype(s, CharacterCategory); err == nil {
+			t.Errorf("RegisterDatatype(%q) succeeded, expected an error", s)
+		}
+	}
+
+	for _, s := range []string{"Mood", `public."Status"`} {
+		if err := d.RegisterDatatype(s, CharacterCategory); err != nil {
+			t.Errorf("RegisterDatatype(%q) = %v", s, err)
+		}
+	}
+
+	tests := []struct {
+		in, want string
+		dims     []int
+	}{
+		{"mood", "mood", nil},
+		{"MOOD[]", "mood[]", []int{0}},
+		{"public.mood", "public.mood", nil},
+		{`public . "Status"`, `public."Status"`, nil},
+		{`"Status"[3]`, `"Status"[3]`, []int{3}},
+	}
+
+	for _, tt := range tests {
+		dt, err := d.ParseDatatype(tt.in)
+		if err != nil || dt.String() != tt.want || !equalInts(dt.ArrayDims, tt.dims) {
+			t.Errorf("ParseDatatype(%q) = %q %v %v, expected %q %v", tt.in, dt.String(), dt.ArrayDims, err, tt.want, tt.dims)
+		}
+	}
+
+	for _, s := range []string{"status", `other."Status"`, "mood(3)", "other.mood", "other.mood[]"} {
+		if _, err := d.ParseDatatype(s); err == nil {
+			t.Errorf("ParseDatatype(%q) succeeded, expected an error", s)
+		}
+	}
+
+	if dt, n, err := MatchDatatype(d, []string{"mood", "[", "]", " ", "not"}); err != nil || n != 3 || dt.String() != "mood[]" {
+		t.Errorf("MatchDatatype = %q %d %v, expected \"mood[]\" 3", dt.String(), n, err)
+	}
+
+	i, ok := d.DatatypeInfo(`public."Status"`)
+	if !ok || i.Name != `public."Status"` || i.Category != CharacterCategory {
+		t.Errorf("DatatypeInfo = %+v %v, expected the registered datatype", i, ok)
+	}
+
+	found := 0
+	for _, i := range d.Datatypes() {
+		if i.Name == "mood" || i.Name == `public."Status"` {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Errorf("Datatypes() has %d of the registered datatypes, expected 2", found)
+	}
+
+	// the registry belongs to the value
+	if _, err := before.ParseDatatype("mood"); err == nil {
+		t.Error("a copy made before RegisterDatatype parsed \"mood\"")
+	}
+	var a, b DbDialect = before, NewPostgreSQLDialect()
+	if a != b {
+		t.Error("PostgreSQL dialects without registered datatypes are not equal")
+	}
+
+	// the dialect from NewDialect can register datatypes
+	nd := NewDialect("postgres")
+	r, ok := nd.(interface{ RegisterDatatype(string, int) error })
+	if !ok {
+		t.Fatalf("NewDialect returned a %T, expected a *PostgreSQLDialect", nd)
+	}
+	if err := r.RegisterDatatype("app.mood", CharacterCategory); err != nil {
+		t.Fatalf("RegisterDatatype(app.mood) = %v", err)
+	}
+	if dt, err := nd.ParseDatatype("app.mood"); err != nil || dt.String() != "app.mood" {
+		t.Errorf("ParseDatatype(app.mood) = %q %v after registering with NewDialect", dt.String(), err)
+	}
+	for _, s := range []string{"public.mood", "other.mood"} {
+		if _, err := nd.ParseDatatype(s); err == nil {
+			t.Errorf("ParseDatatype(%q) succeeded, expected an error", s)
+		}
+	}
+}
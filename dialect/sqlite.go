@@ -1,7 +1,6 @@
 package dialect
 
 import (
-	"regexp"
 	"strings"
 )
 
@@ -41,272 +40,496 @@ func (d SQLiteDialect) MaxOperatorLength() int {
 	return 2
 }
 
+// LineCommentChars returns the character sequences that start a
+// comment that runs to the end of the line
+func (d SQLiteDialect) LineCommentChars() []string {
+	return []string{"--"}
+}
+
+// BlockCommentChars returns the character sequences that start and
+// end a block comment
+func (d SQLiteDialect) BlockCommentChars() (string, string) {
+	return "/*", "*/"
+}
+
+// NestedComments returns a boolean indicating if block comments
+// may be nested in SQLite
+func (d SQLiteDialect) NestedComments() bool {
+	return false
+}
+
+// IsComment returns a boolean indicating if the supplied string
+// is considered to be a complete comment in SQLite
+func (d SQLiteDialect) IsComment(s string) bool {
+	return isComment(d, s)
+}
+
+// IsHint returns a boolean indicating if the supplied string
+// is considered to be an optimizer hint in SQLite
+func (d SQLiteDialect) IsHint(s string) bool {
+	return false
+}
+
+// IsExecutableComment returns a boolean indicating if the supplied
+// string is considered to be an executable comment in SQLite
+func (d SQLiteDialect) IsExecutableComment(s string) bool {
+	return false
+}
+
+// stringForms returns the forms of string literal supported by
+// SQLite
+func (d SQLiteDialect) stringForms() []stringForm {
+	return []stringForm{
+		{"", '\'', stringPlain},
+		{"X", '\'', stringHex},
+	}
+}
+
+// IsStringLiteral returns a boolean indicating if the supplied string
+// is considered to be a complete string literal in SQLite
+func (d SQLiteDialect) IsStringLiteral(s string) bool {
+	return isStringLiteral(d.stringForms(), s)
+}
+
+// UnquoteString returns the value of the supplied SQLite string literal
+func (d SQLiteDialect) UnquoteString(s string) (string, error) {
+	return unquoteString(d.stringForms(), s)
+}
+
+// QuoteString returns the supplied string quoted as a string literal
+// for SQLite with any special characters escaped
+func (d SQLiteDialect) QuoteString(s string) string {
+	return quotePlain(s)
+}
+
+// identQuotes returns the forms of quoted identifier supported by
+// SQLite, with the preferred form first
+func (d SQLiteDialect) identQuotes() []identQuote {
+	return []identQuote{
+		{'"', '"', true},
+		{'[', ']', false},
+		{'`', '`', true},
+	}
+}
+
+// QuoteIdentifier returns the supplied identifier quoted for SQLite
+// with any embedded quote characters escaped
+func (d SQLiteDialect) QuoteIdentifier(s string) (string, error) {
+	return quoteIdentifier(d.identQuotes()[0], s)
+}
+
+// UnquoteIdentifier returns the name of the supplied quoted SQLite
+// identifier
+func (d SQLiteDialect) UnquoteIdentifier(s string) (string, error) {
+	return unquoteIdentifier(d.identQuotes(), s)
+}
+
+// FormatIdentifier returns the supplied identifier as it should be
+// written in SQLite, quoting it only when needed
+func (d SQLiteDialect) FormatIdentifier(s string) (string, error) {
+
+	// SQLite does not indicate which keywords are reserved and requires
+	// keywords used as names to be quoted
+	if d.IsKeyword(s) {
+		return d.QuoteIdentifier(s)
+	}
+	return formatIdentifier(d, s)
+}
+
+// numberForm returns the forms of numeric literal supported by
+// SQLite
+func (d SQLiteDialect) numberForm() numberForm {
+
+	// Underscores as digit separators are supported as of SQLite 3.46
+	return numberForm{hex: true, underscores: true}
+}
+
+// IsNumericLiteral returns a boolean indicating if the supplied string
+// is considered to be a numeric literal in SQLite
+func (d SQLiteDialect) IsNumericLiteral(s string) bool {
+	return isNumericLiteral(d.numberForm(), s)
+}
+
+// ParseNumericLiteral returns the parsed form of the supplied SQLite
+// numeric literal
+func (d SQLiteDialect) ParseNumericLiteral(s string) (NumericLiteral, error) {
+	return parseNumericLiteral(d.numberForm(), s)
+}
+
+var sqliteDatatypes = map[string]bool{
+	"bigint":            true,
+	"blob":              true,
+	"boolean":           true,
+	"character":         true,
+	"clob":              true,
+	"date":              true,
+	"datetime":          true,
+	"decimal":           true,
+	"double":            true,
+	"double precision":  true,
+	"float":             true,
+	"int":               true,
+	"int2":              true,
+	"int8":              true,
+	"integer":           true,
+	"mediumint":         true,
+	"native character":  true,
+	"nchar":             true,
+	"numeric":           true,
+	"nvarchar":          true,
+	"real":              true,
+	"smallint":          true,
+	"text":              true,
+	"tinyint":           true,
+	"unsigned big int":  true,
+	"varchar":           true,
+	"varying character": true,
+}
+
+// sqliteLengthDatatypes is the set of SQLite datatypes whose
+// parameter is a length (as opposed to a precision)
+var sqliteLengthDatatypes = map[string]bool{
+	"blob":              true,
+	"character":         true,
+	"clob":              true,
+	"native character":  true,
+	"nchar":             true,
+	"nvarchar":          true,
+	"text":              true,
+	"varchar":           true,
+	"varying character": true,
+}
+
+// sqliteDatatypeParts is the set of words used in SQLite datatypes
+var sqliteDatatypeParts = datatypeParts(sqliteDatatypes)
+
+// sqliteIntegerInfo is the metadata for the SQLite integer types. All
+// integers are stored in 0 to 8 bytes depending on the value
+var sqliteIntegerInfo = DatatypeInfo{Category: ExactNumericCategory, MinValue: "-9223372036854775808", MaxValue: "9223372036854775807"}
+
+// sqliteDatatypeInfo is the metadata for the SQLite datatypes
+var sqliteDatatypeInfo = map[string]DatatypeInfo{
+	"bigint":            sqliteIntegerInfo,
+	"blob":              binaryInfo,
+	"boolean":           booleanInfo,
+	"character":         charInfo,
+	"clob":              lobInfo,
+	"date":              temporalInfo,
+	"datetime":          temporalInfo,
+	"decimal":           decimalInfo,
+	"double":            doubleInfo,
+	"double precision":  doubleInfo,
+	"float":             doubleInfo,
+	"int":               sqliteIntegerInfo,
+	"int2":              sqliteIntegerInfo,
+	"int8":              sqliteIntegerInfo,
+	"integer":           sqliteIntegerInfo,
+	"mediumint":         sqliteIntegerInfo,
+	"native character":  charInfo,
+	"nchar":             charInfo,
+	"numeric":           decimalInfo,
+	"nvarchar":          charInfo,
+	"real":              doubleInfo,
+	"smallint":          sqliteIntegerInfo,
+	"text":              charInfo,
+	"tinyint":           sqliteIntegerInfo,
+	"unsigned big int":  sqliteIntegerInfo,
+	"varchar":           charInfo,
+	"varying character": charInfo,
+}
+
+// sqliteDatatypeAliases maps the SQLite datatype synonyms to their
+// canonical form
+var sqliteDatatypeAliases = map[string]string{
+	"double":           "real",
+	"double precision": "real",
+	"float":            "real",
+}
+
 // IsDatatype returns a boolean indicating if the supplied string
 // is considered to be a datatype in SQLite
 func (d SQLiteDialect) IsDatatype(s ...string) bool {
+	_, err := d.ParseDatatype(s...)
+	return err == nil
+}
 
-	sqliteDatatypes := map[string]bool{
-		"bigint":            true,
-		"blob":              true,
-		"boolean":           true,
-		"character":         true,
-		"clob":              true,
-		"date":              true,
-		"datetime":          true,
-		"decimal":           true,
-		"double":            true,
-		"double precision":  true,
-		"float":             true,
-		"int":               true,
-		"int2":              true,
-		"int8":              true,
-		"integer":           true,
-		"mediumint":         true,
-		"native character":  true,
-		"nchar":             true,
-		"numeric":           true,
-		"nvarchar":          true,
-		"real":              true,
-		"smallint":          true,
-		"text":              true,
-		"tinyint":           true,
-		"unsigned big int":  true,
-		"varchar":           true,
-		"varying character": true,
-	}
+// ParseDatatype returns the parsed form of the supplied datatype
+// tokens, or an error if they are not a valid SQLite datatype
+func (d SQLiteDialect) ParseDatatype(tokens ...string) (Datatype, error) {
+	return parseDatatype(d, d.parseRules(), tokens)
+}
+
+// parseRules returns the rules for parsing SQLite datatypes
+func (d SQLiteDialect) parseRules() datatypeRules {
 
 	// NB column specifications can specify size, precision, or precision and
 	// scale though SQLite doesn't appear to to anything with the extra
 	// information or constrain the data to match the size, precision, or
 	// precision and scale. SQLite will even allow column specifications that
-	// make no sense (such as char(10,2) or number(-5)).
-
-	var z []string
-	rn := regexp.MustCompile(`^[0-9]+$`)
-	rns := regexp.MustCompile(`^[+\-][0-9]+$`)
-
-	for i, v := range s {
-		switch v {
-		case "(":
-			z = append(z, " "+v)
-		case ")", ",", "[", "]":
-			z = append(z, v)
-		default:
-			switch {
-			case rn.MatchString(v), rns.MatchString(v):
-				z = append(z, "n")
-			case i == 0:
-				z = append(z, v)
-			default:
-				z = append(z, " "+v)
-			}
-		}
+	// make no sense (such as char(10,2) or number(-5)). Any name is
+	// allowed as the type affinity is worked out from the name (see
+	// Affinity).
+	return datatypeRules{
+		types:     sqliteDatatypes,
+		signed:    true,
+		anyParams: true,
+		anyNames:  true,
+		lengths:   sqliteLengthDatatypes,
 	}
+}
 
-	k := strings.ToLower(strings.Join(z, ""))
+// IsDatatypePart returns a boolean indicating if the supplied string
+// is considered to be part of a datatype definition in SQLite
+func (d SQLiteDialect) IsDatatypePart(s string) bool {
 
-	if strings.Count(k, "(") == 1 {
-		ary := strings.Split(k, "(")
-		l := strings.TrimRight(ary[0], " ")
-		if _, ok := sqliteDatatypes[l]; ok {
-			return true
-		}
+	// Any name is allowed (see parseRules) so any identifier that
+	// isn't a keyword may be part of a datatype
+	if sqliteDatatypeParts[strings.ToLower(s)] {
+		return true
 	}
-
-	return false
+	return d.IsIdentifier(s) && !d.IsKeyword(s)
 }
 
-func (d SQLiteDialect) keyword(s string) (bool, bool) {
+// Affinity returns the type affinity (IntegerAffinity, TextAffinity,
+// BlobAffinity, RealAffinity or NumericAffinity) of the supplied
+// declared type (or type tokens), e.g. "floating point" has integer
+// affinity as it contains "INT"
+func (d SQLiteDialect) Affinity(s ...string) int {
+	return sqliteAffinity(strings.Join(s, " "))
+}
 
-	/*
-	   SQLite keywords
-
-	   Keywords from https://www.sqlite.org/lang_keywords.html
-
-	   The bool is set to false as there is no indication if the keywords are
-	   reserved or not.
-
-	   From the documentation:
-
-	   "If you want to use a keyword as a name, you need to quote it. There
-	       are four ways of quoting keywords in SQLite:
-
-	       * 'keyword'  A keyword in single quotes is a string literal.
-
-
-	       * "keyword"  A keyword in double-quotes is an identifier.
-
-	       * [keyword]  A keyword enclosed in square brackets is an
-	           identifier. This is not standard SQL. This quoting mechanism is
-	           used by MS Access and SQL Server and is included in SQLite for
-	           compatibility.
-
-	       * `keyword`  A keyword enclosed in grave accents (ASCII code 96) is
-	           an identifier. This is not standard SQL. This quoting mechanism is
-	           used by MySQL and is included in SQLite for compatibility.
-
-	   For resilience when confronted with historical SQL statements, SQLite
-	   will sometimes bend the quoting rules above:
-
-	   If a keyword in single quotes (ex: 'key' or 'glob') is used in
-	   a context where an identifier is allowed but where a string
-	   literal is not allowed, then the token is understood to be an
-	   identifier instead of a string literal.
-
-	   If a keyword in double quotes (ex: "key" or "glob") is used in
-	   a context where it cannot be resolved to an identifier but
-	   where a string literal is allowed, then the token is understood
-	   to be a string literal instead of an identifier."
-
-	*/
-
-	// map[keyword]isReserved
-	sqliteKeywords := map[string]bool{
-		"ABORT":             false,
-		"ACTION":            false,
-		"ADD":               false,
-		"AFTER":             false,
-		"ALL":               false,
-		"ALTER":             false,
-		"ANALYZE":           false,
-		"AND":               false,
-		"AS":                false,
-		"ASC":               false,
-		"ATTACH":            false,
-		"AUTOINCREMENT":     false,
-		"BEFORE":            false,
-		"BEGIN":             false,
-		"BETWEEN":           false,
-		"BY":                false,
-		"CASCADE":           false,
-		"CASE":              false,
-		"CAST":              false,
-		"CHECK":             false,
-		"COLLATE":           false,
-		"COLUMN":            false,
-		"COMMIT":            false,
-		"CONFLICT":          false,
-		"CONSTRAINT":        false,
-		"CREATE":            false,
-		"CROSS":             false,
-		"CURRENT":           false,
-		"CURRENT_DATE":      false,
-		"CURRENT_TIME":      false,
-		"CURRENT_TIMESTAMP": false,
-		"DATABASE":          false,
-		"DEFAULT":           false,
-		"DEFERRABLE":        false,
-		"DEFERRED":          false,
-		"DELETE":            false,
-		"DESC":              false,
-		"DETACH":            false,
-		"DISTINCT":          false,
-		"DO":                false,
-		"DROP":              false,
-		"EACH":              false,
-		"ELSE":              false,
-		"END":               false,
-		"ESCAPE":            false,
-		"EXCEPT":            false,
-		"EXCLUDE":           false,
-		"EXCLUSIVE":         false,
-		"EXISTS":            false,
-		"EXPLAIN":           false,
-		"FAIL":              false,
-		"FILTER":            false,
-		"FIRST":             false,
-		"FOLLOWING":         false,
-		"FOR":               false,
-		"FOREIGN":           false,
-		"FROM":              false,
-		"FULL":              false,
-		"GLOB":              false,
-		"GROUP":             false,
-		"GROUPS":            false,
-		"HAVING":            false,
-		"IF":                false,
-		"IGNORE":            false,
-		"IMMEDIATE":         false,
-		"IN":                false,
-		"INDEX":             false,
-		"INDEXED":           false,
-		"INITIALLY":         false,
-		"INNER":             false,
-		"INSERT":            false,
-		"INSTEAD":           false,
-		"INTERSECT":         false,
-		"INTO":              false,
-		"IS":                false,
-		"ISNULL":            false,
-		"JOIN":              false,
-		"KEY":               false,
-		"LAST":              false,
-		"LEFT":              false,
-		"LIKE":              false,
-		"LIMIT":             false,
-		"MATCH":             false,
-		"NATURAL":           false,
-		"NO":                false,
-		"NOT":               false,
-		"NOTHING":           false,
-		"NOTNULL":           false,
-		"NULL":              false,
-		"NULLS":             false,
-		"OF":                false,
-		"OFFSET":            false,
-		"ON":                false,
-		"OR":                false,
-		"ORDER":             false,
-		"OTHERS":            false,
-		"OUTER":             false,
-		"OVER":              false,
-		"PARTITION":         false,
-		"PLAN":              false,
-		"PRAGMA":            false,
-		"PRECEDING":         false,
-		"PRIMARY":           false,
-		"QUERY":             false,
-		"RAISE":             false,
-		"RANGE":             false,
-		"RECURSIVE":         false,
-		"REFERENCES":        false,
-		"REGEXP":            false,
-		"REINDEX":           false,
-		"RELEASE":           false,
-		"RENAME":            false,
-		"REPLACE":           false,
-		"RESTRICT":          false,
-		"RIGHT":             false,
-		"ROLLBACK":          false,
-		"ROW":               false,
-		"ROWS":              false,
-		"SAVEPOINT":         false,
-		"SELECT":            false,
-		"SET":               false,
-		"TABLE":             false,
-		"TEMP":              false,
-		"TEMPORARY":         false,
-		"THEN":              false,
-		"TIES":              false,
-		"TO":                false,
-		"TRANSACTION":       false,
-		"TRIGGER":           false,
-		"UNBOUNDED":         false,
-		"UNION":             false,
-		"UNIQUE":            false,
-		"UPDATE":            false,
-		"USING":             false,
-		"VACUUM":            false,
-		"VALUES":            false,
-		"VIEW":              false,
-		"VIRTUAL":           false,
-		"WHEN":              false,
-		"WHERE":             false,
-		"WINDOW":            false,
-		"WITH":              false,
-		"WITHOUT":           false,
+// sqliteAffinity returns the type affinity of the supplied declared
+// type. The rules are applied in order, per
+// https://www.sqlite.org/datatype3.html#determination_of_column_affinity
+func sqliteAffinity(s string) int {
+
+	t := strings.ToUpper(s)
+
+	switch {
+	case strings.Contains(t, "INT"):
+		return IntegerAffinity
+	case strings.Contains(t, "CHAR"), strings.Contains(t, "CLOB"), strings.Contains(t, "TEXT"):
+		return TextAffinity
+	case strings.Contains(t, "BLOB"), strings.TrimSpace(t) == "":
+		return BlobAffinity
+	case strings.Contains(t, "REAL"), strings.Contains(t, "FLOA"), strings.Contains(t, "DOUB"):
+		return RealAffinity
 	}
 
+	return NumericAffinity
+}
+
+// DatatypeInfo returns the metadata for the named SQLite datatype
+func (d SQLiteDialect) DatatypeInfo(name string) (DatatypeInfo, bool) {
+	return datatypeInfo(d, sqliteDatatypeInfo, name)
+}
+
+// Datatypes returns the metadata for all of the SQLite datatypes
+func (d SQLiteDialect) Datatypes() []DatatypeInfo {
+	return datatypeInfos(sqliteDatatypeInfo)
+}
+
+// CanonicalDatatype returns the supplied datatype with any synonym
+// replaced by the canonical SQLite form (e.g. double becomes real)
+func (d SQLiteDialect) CanonicalDatatype(t Datatype) Datatype {
+	return canonicalDatatype(d, sqliteDatatypeAliases, t)
+}
+
+/*
+   SQLite keywords
+
+   Keywords from https://www.sqlite.org/lang_keywords.html
+
+   The bool is set to false as there is no indication if the keywords are
+   reserved or not.
+
+   From the documentation:
+
+   "If you want to use a keyword as a name, you need to quote it. There
+       are four ways of quoting keywords in SQLite:
+
+       * 'keyword'  A keyword in single quotes is a string literal.
+
+
+       * "keyword"  A keyword in double-quotes is an identifier.
+
+       * [keyword]  A keyword enclosed in square brackets is an
+           identifier. This is not standard SQL. This quoting mechanism is
+           used by MS Access and SQL Server and is included in SQLite for
+           compatibility.
+
+       * `keyword`  A keyword enclosed in grave accents (ASCII code 96) is
+           an identifier. This is not standard SQL. This quoting mechanism is
+           used by MySQL and is included in SQLite for compatibility.
+
+   For resilience when confronted with historical SQL statements, SQLite
+   will sometimes bend the quoting rules above:
+
+   If a keyword in single quotes (ex: 'key' or 'glob') is used in
+   a context where an identifier is allowed but where a string
+   literal is not allowed, then the token is understood to be an
+   identifier instead of a string literal.
+
+   If a keyword in double quotes (ex: "key" or "glob") is used in
+   a context where it cannot be resolved to an identifier but
+   where a string literal is allowed, then the token is understood
+   to be a string literal instead of an identifier."
+
+*/
+
+// map[keyword]isReserved
+var sqliteKeywords = map[string]bool{
+	"ABORT":             false,
+	"ACTION":            false,
+	"ADD":               false,
+	"AFTER":             false,
+	"ALL":               false,
+	"ALTER":             false,
+	"ALWAYS":            false,
+	"ANALYZE":           false,
+	"AND":               false,
+	"AS":                false,
+	"ASC":               false,
+	"ATTACH":            false,
+	"AUTOINCREMENT":     false,
+	"BEFORE":            false,
+	"BEGIN":             false,
+	"BETWEEN":           false,
+	"BY":                false,
+	"CASCADE":           false,
+	"CASE":              false,
+	"CAST":              false,
+	"CHECK":             false,
+	"COLLATE":           false,
+	"COLUMN":            false,
+	"COMMIT":            false,
+	"CONFLICT":          false,
+	"CONSTRAINT":        false,
+	"CREATE":            false,
+	"CROSS":             false,
+	"CURRENT":           false,
+	"CURRENT_DATE":      false,
+	"CURRENT_TIME":      false,
+	"CURRENT_TIMESTAMP": false,
+	"DATABASE":          false,
+	"DEFAULT":           false,
+	"DEFERRABLE":        false,
+	"DEFERRED":          false,
+	"DELETE":            false,
+	"DESC":              false,
+	"DETACH":            false,
+	"DISTINCT":          false,
+	"DO":                false,
+	"DROP":              false,
+	"EACH":              false,
+	"ELSE":              false,
+	"END":               false,
+	"ESCAPE":            false,
+	"EXCEPT":            false,
+	"EXCLUDE":           false,
+	"EXCLUSIVE":         false,
+	"EXISTS":            false,
+	"EXPLAIN":           false,
+	"FAIL":              false,
+	"FILTER":            false,
+	"FIRST":             false,
+	"FOLLOWING":         false,
+	"FOR":               false,
+	"FOREIGN":           false,
+	"FROM":              false,
+	"FULL":              false,
+	"GENERATED":         false,
+	"GLOB":              false,
+	"GROUP":             false,
+	"GROUPS":            false,
+	"HAVING":            false,
+	"IF":                false,
+	"IGNORE":            false,
+	"IMMEDIATE":         false,
+	"IN":                false,
+	"INDEX":             false,
+	"INDEXED":           false,
+	"INITIALLY":         false,
+	"INNER":             false,
+	"INSERT":            false,
+	"INSTEAD":           false,
+	"INTERSECT":         false,
+	"INTO":              false,
+	"IS":                false,
+	"ISNULL":            false,
+	"JOIN":              false,
+	"KEY":               false,
+	"LAST":              false,
+	"LEFT":              false,
+	"LIKE":              false,
+	"LIMIT":             false,
+	"MATCH":             false,
+	"MATERIALIZED":      false,
+	"NATURAL":           false,
+	"NO":                false,
+	"NOT":               false,
+	"NOTHING":           false,
+	"NOTNULL":           false,
+	"NULL":              false,
+	"NULLS":             false,
+	"OF":                false,
+	"OFFSET":            false,
+	"ON":                false,
+	"OR":                false,
+	"ORDER":             false,
+	"OTHERS":            false,
+	"OUTER":             false,
+	"OVER":              false,
+	"PARTITION":         false,
+	"PLAN":              false,
+	"PRAGMA":            false,
+	"PRECEDING":         false,
+	"PRIMARY":           false,
+	"QUERY":             false,
+	"RAISE":             false,
+	"RANGE":             false,
+	"RECURSIVE":         false,
+	"REFERENCES":        false,
+	"REGEXP":            false,
+	"REINDEX":           false,
+	"RELEASE":           false,
+	"RENAME":            false,
+	"REPLACE":           false,
+	"RESTRICT":          false,
+	"RETURNING":         false,
+	"RIGHT":             false,
+	"ROLLBACK":          false,
+	"ROW":               false,
+	"ROWS":              false,
+	"SAVEPOINT":         false,
+	"SELECT":            false,
+	"SET":               false,
+	"TABLE":             false,
+	"TEMP":              false,
+	"TEMPORARY":         false,
+	"THEN":              false,
+	"TIES":              false,
+	"TO":                false,
+	"TRANSACTION":       false,
+	"TRIGGER":           false,
+	"UNBOUNDED":         false,
+	"UNION":             false,
+	"UNIQUE":            false,
+	"UPDATE":            false,
+	"USING":             false,
+	"VACUUM":            false,
+	"VALUES":            false,
+	"VIEW":              false,
+	"VIRTUAL":           false,
+	"WHEN":              false,
+	"WHERE":             false,
+	"WINDOW":            false,
+	"WITH":              false,
+	"WITHOUT":           false,
+}
+
+func (d SQLiteDialect) keyword(s string) (bool, bool) {
+
 	v, ok := sqliteKeywords[strings.ToUpper(s)]
 
 	return ok, v
@@ -330,32 +553,32 @@ func (d SQLiteDialect) IsReservedKeyword(s string) bool {
 	return false
 }
 
+var sqliteOperators = map[string]bool{
+	"~":  true,
+	"<":  true,
+	"<<": true,
+	"<=": true,
+	"<>": true,
+	"=":  true,
+	"==": true,
+	">":  true,
+	">=": true,
+	">>": true,
+	"|":  true,
+	"||": true,
+	"-":  true,
+	"!=": true,
+	"/":  true,
+	"*":  true,
+	"&":  true,
+	"%":  true,
+	"+":  true,
+}
+
 // IsOperator returns a boolean indicating if the supplied string
 // is considered to be an operator in SQLite
 func (d SQLiteDialect) IsOperator(s string) bool {
 
-	sqliteOperators := map[string]bool{
-		"~":  true,
-		"<":  true,
-		"<<": true,
-		"<=": true,
-		"<>": true,
-		"=":  true,
-		"==": true,
-		">":  true,
-		">=": true,
-		">>": true,
-		"|":  true,
-		"||": true,
-		"-":  true,
-		"!=": true,
-		"/":  true,
-		"*":  true,
-		"&":  true,
-		"%":  true,
-		"+":  true,
-	}
-
 	_, ok := sqliteOperators[s]
 	return ok
 }
@@ -399,3 +622,23 @@ func (d SQLiteDialect) IsIdentifier(s string) bool {
 
 	return true
 }
+
+// PlaceholderStyles returns the bind parameter placeholder styles
+// supported by SQLite, in order of preference
+func (d SQLiteDialect) PlaceholderStyles() []int {
+
+	// Per https://www.sqlite.org/lang_expr.html#varparam
+	return []int{
+		QuestionPlaceholder,
+		QuestionNumberPlaceholder,
+		ColonNamePlaceholder,
+		AtNamePlaceholder,
+		DollarNamePlaceholder,
+	}
+}
+
+// IsPlaceholder returns a boolean indicating if the supplied string
+// is considered to be a bind parameter placeholder in SQLite
+func (d SQLiteDialect) IsPlaceholder(s string) bool {
+	return isPlaceholder(d, s)
+}
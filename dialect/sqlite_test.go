@@ -0,0 +1,35 @@
+package dialect
+
+import "testing"
+
+func TestSQLiteAffinity(t *testing.T) {
+
+	tests := []struct {
+		in   string
+		want int
+	}{
+		{"int", IntegerAffinity},
+		{"BIGINT", IntegerAffinity},
+		{"varchar(10)", TextAffinity},
+		{"nchar", TextAffinity},
+		{"clob", TextAffinity},
+		{"blob", BlobAffinity},
+		{"", BlobAffinity},
+		{"real", RealAffinity},
+		{"double", RealAffinity},
+		{"float", RealAffinity},
+		{"floating point", IntegerAffinity},
+		{"numeric", NumericAffinity},
+		{"decimal(10,5)", NumericAffinity},
+		{"boolean", NumericAffinity},
+		{"date", NumericAffinity},
+		{"charint", IntegerAffinity},
+	}
+
+	d := NewSQLiteDialect()
+	for _, tt := range tests {
+		if got := d.Affinity(tt.in); got != tt.want {
+			t.Errorf("Affinity(%q) = %d, expected %d", tt.in, got, tt.want)
+		}
+	}
+}